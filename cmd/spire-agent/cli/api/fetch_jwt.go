@@ -9,6 +9,7 @@ import (
 	"github.com/mitchellh/cli"
 	"github.com/spiffe/go-spiffe/v2/proto/spiffe/workload"
 	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"google.golang.org/protobuf/proto"
 )
 
 func NewFetchJWTCommand() cli.Command {
@@ -22,6 +23,7 @@ func newFetchJWTCommand(env *common_cli.Env, clientMaker workloadClientMaker) cl
 type fetchJWTCommand struct {
 	audience common_cli.CommaStringsFlag
 	spiffeID string
+	printer  common_cli.Printer
 }
 
 func (c *fetchJWTCommand) name() string {
@@ -46,20 +48,23 @@ func (c *fetchJWTCommand) run(ctx context.Context, env *common_cli.Env, client *
 		return err
 	}
 
-	for _, svid := range svidResp.Svids {
-		fmt.Printf("token(%s):\n\t%s\n", svid.SpiffeId, svid.Svid)
-	}
+	return c.printer.PrintProtos(env, []proto.Message{svidResp, bundlesResp}, func() error {
+		for _, svid := range svidResp.Svids {
+			fmt.Printf("token(%s):\n\t%s\n", svid.SpiffeId, svid.Svid)
+		}
 
-	for trustDomainID, jwksJSON := range bundlesResp.Bundles {
-		fmt.Printf("bundle(%s):\n\t%s\n", trustDomainID, string(jwksJSON))
-	}
+		for trustDomainID, jwksJSON := range bundlesResp.Bundles {
+			fmt.Printf("bundle(%s):\n\t%s\n", trustDomainID, string(jwksJSON))
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func (c *fetchJWTCommand) appendFlags(fs *flag.FlagSet) {
 	fs.Var(&c.audience, "audience", "comma separated list of audience values")
 	fs.StringVar(&c.spiffeID, "spiffeID", "", "SPIFFE ID subject (optional)")
+	c.printer.AppendFlag(fs)
 }
 
 func (c *fetchJWTCommand) fetchJWTSVID(ctx context.Context, client *workloadClient) (*workload.JWTSVIDResponse, error) {
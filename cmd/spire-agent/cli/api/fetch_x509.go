@@ -18,6 +18,7 @@ import (
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/go-spiffe/v2/svid/x509svid"
 	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"google.golang.org/protobuf/proto"
 )
 
 func NewFetchX509Command() cli.Command {
@@ -31,6 +32,7 @@ func newFetchX509Command(env *common_cli.Env, clientMaker workloadClientMaker) c
 type fetchX509Command struct {
 	silent    bool
 	writePath string
+	printer   common_cli.Printer
 }
 
 func (*fetchX509Command) name() string {
@@ -55,7 +57,14 @@ func (c *fetchX509Command) run(ctx context.Context, env *common_cli.Env, client
 	}
 
 	if !c.silent {
-		printX509SVIDResponse(svids, respTime)
+		// The private keys are not printed; they are only written to disk
+		// with -write
+		if err := c.printer.PrintProto(env, withoutX509SVIDKeys(resp), func() error {
+			printX509SVIDResponse(svids, respTime)
+			return nil
+		}); err != nil {
+			return err
+		}
 	}
 
 	if c.writePath != "" {
@@ -70,6 +79,7 @@ func (c *fetchX509Command) run(ctx context.Context, env *common_cli.Env, client
 func (c *fetchX509Command) appendFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&c.silent, "silent", false, "Suppress stdout")
 	fs.StringVar(&c.writePath, "write", "", "Write SVID data to the specified path (optional)")
+	c.printer.AppendFlag(fs)
 }
 
 func (c *fetchX509Command) fetchX509SVID(ctx context.Context, client *workloadClient) (*workload.X509SVIDResponse, error) {
@@ -127,6 +137,15 @@ func (c *fetchX509Command) writeResponse(svids []*X509SVID) error {
 	return nil
 }
 
+// withoutX509SVIDKeys returns a copy of resp without the SVID private keys
+func withoutX509SVIDKeys(resp *workload.X509SVIDResponse) *workload.X509SVIDResponse {
+	resp = proto.Clone(resp).(*workload.X509SVIDResponse)
+	for _, svid := range resp.Svids {
+		svid.X509SvidKey = nil
+	}
+	return resp
+}
+
 // writeCerts takes a slice of data, which may contain multiple certificates,
 // and encodes them as PEM blocks, writing them to filename
 func (c *fetchX509Command) writeCerts(filename string, certs []*x509.Certificate) error {
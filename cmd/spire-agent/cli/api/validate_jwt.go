@@ -25,6 +25,7 @@ func newValidateJWTCommand(env *common_cli.Env, clientMaker workloadClientMaker)
 type validateJWTCommand struct {
 	audience string
 	svid     string
+	printer  common_cli.Printer
 }
 
 func (*validateJWTCommand) name() string {
@@ -38,6 +39,7 @@ func (*validateJWTCommand) synopsis() string {
 func (c *validateJWTCommand) appendFlags(fs *flag.FlagSet) {
 	fs.StringVar(&c.audience, "audience", "", "expected audience value")
 	fs.StringVar(&c.svid, "svid", "", "JWT SVID")
+	c.printer.AppendFlag(fs)
 }
 
 func (c *validateJWTCommand) run(ctx context.Context, env *common_cli.Env, client *workloadClient) error {
@@ -53,17 +55,19 @@ func (c *validateJWTCommand) run(ctx context.Context, env *common_cli.Env, clien
 		return err
 	}
 
-	if err := env.Println("SVID is valid."); err != nil {
-		return err
-	}
-	if err := env.Println("SPIFFE ID :", resp.SpiffeId); err != nil {
-		return err
-	}
-	claims, err := protojson.Marshal(resp.Claims)
-	if err != nil {
-		return fmt.Errorf("unable to unmarshal claims: %w", err)
-	}
-	return env.Println("Claims    :", string(claims))
+	return c.printer.PrintProto(env, resp, func() error {
+		if err := env.Println("SVID is valid."); err != nil {
+			return err
+		}
+		if err := env.Println("SPIFFE ID :", resp.SpiffeId); err != nil {
+			return err
+		}
+		claims, err := protojson.Marshal(resp.Claims)
+		if err != nil {
+			return fmt.Errorf("unable to unmarshal claims: %w", err)
+		}
+		return env.Println("Claims    :", string(claims))
+	})
 }
 
 func (c *validateJWTCommand) validateJWTSVID(ctx context.Context, client *workloadClient) (*workload.ValidateJWTSVIDResponse, error) {
@@ -182,6 +182,8 @@ func TestCountHelp(t *testing.T) {
 
 	test.client.Help()
 	require.Equal(t, `Usage of agent count:
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
   -socketPath string
     	Path to the SPIRE Server API socket (default "/tmp/spire-server/private/api.sock")
 `, test.stderr.String())
@@ -208,6 +210,13 @@ func TestCount(t *testing.T) {
 			expectedStdout:     "1 attested agent",
 			existentAgents:     testAgents,
 		},
+		{
+			name:               "json output",
+			args:               []string{"-output", "json"},
+			expectedReturnCode: 0,
+			expectedStdout:     "{\n  \"count\": 1\n}\n",
+			existentAgents:     testAgents,
+		},
 		{
 			name:               "server error",
 			expectedReturnCode: 1,
@@ -241,6 +250,8 @@ func TestListHelp(t *testing.T) {
 	require.Equal(t, `Usage of agent list:
   -matchSelectorsOn string
     	The match mode used when filtering by selectors. Options: exact, any, superset and subset (default "superset")
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
   -selector value
     	A colon-delimited type:value selector. Can be used more than once
   -socketPath string
@@ -269,6 +280,17 @@ func TestList(t *testing.T) {
 				PageSize: 1000,
 			},
 		},
+		{
+			name:               "yaml output",
+			args:               []string{"-output", "yaml"},
+			expectedReturnCode: 0,
+			existentAgents:     testAgents,
+			expectedStdout:     "agents:\n- id:\n    path: /spire/agent/agent1\n    trust_domain: example.org\n",
+			expectReq: &agentv1.ListAgentsRequest{
+				Filter:   &agentv1.ListAgentsRequest_Filter{},
+				PageSize: 1000,
+			},
+		},
 		{
 			name:               "no agents",
 			expectedReturnCode: 0,
@@ -416,6 +438,8 @@ func TestShowHelp(t *testing.T) {
 
 	test.client.Help()
 	require.Equal(t, `Usage of agent show:
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
   -socketPath string
     	Path to the SPIRE Server API socket (default "/tmp/spire-server/private/api.sock")
   -spiffeID string
@@ -440,6 +464,13 @@ func TestShow(t *testing.T) {
 			existentAgents:     testAgents,
 			expectedStdout:     "Found an attested agent given its SPIFFE ID\n\nSPIFFE ID         : spiffe://example.org/spire/agent/agent1",
 		},
+		{
+			name:               "json output",
+			args:               []string{"-spiffeID", "spiffe://example.org/spire/agent/agent1", "-output", "json"},
+			expectedReturnCode: 0,
+			existentAgents:     testAgents,
+			expectedStdout:     "{\n  \"id\": {\n    \"trust_domain\": \"example.org\",\n    \"path\": \"/spire/agent/agent1\"\n  }\n}\n",
+		},
 		{
 			name:               "no spiffe id",
 			expectedReturnCode: 1,
@@ -24,6 +24,8 @@ type listCommand struct {
 
 	// Match used when filtering agents by selectors
 	matchSelectorsOn string
+
+	printer common_cli.Printer
 }
 
 // NewListCommand creates a new "list" subcommand for "agent" command.
@@ -87,20 +89,23 @@ func (c *listCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 		}
 	}
 
-	if len(agents) == 0 {
-		return env.Printf("No attested agents found\n")
-	}
+	return c.printer.PrintProto(env, &agentv1.ListAgentsResponse{Agents: agents}, func() error {
+		if len(agents) == 0 {
+			return env.Printf("No attested agents found\n")
+		}
 
-	msg := fmt.Sprintf("Found %d attested ", len(agents))
-	msg = util.Pluralizer(msg, "agent", "agents", len(agents))
-	env.Printf(msg + ":\n\n")
+		msg := fmt.Sprintf("Found %d attested ", len(agents))
+		msg = util.Pluralizer(msg, "agent", "agents", len(agents))
+		env.Printf(msg + ":\n\n")
 
-	return printAgents(env, agents...)
+		return printAgents(env, agents...)
+	})
 }
 
 func (c *listCommand) AppendFlags(fs *flag.FlagSet) {
 	fs.StringVar(&c.matchSelectorsOn, "matchSelectorsOn", "superset", "The match mode used when filtering by selectors. Options: exact, any, superset and subset")
 	fs.Var(&c.selectors, "selector", "A colon-delimited type:value selector. Can be used more than once")
+	c.printer.AppendFlag(fs)
 }
 
 func printAgents(env *common_cli.Env, agents ...*types.Agent) error {
@@ -18,6 +18,8 @@ import (
 type showCommand struct {
 	// SPIFFE ID of the agent being showed
 	spiffeID string
+
+	printer common_cli.Printer
 }
 
 // NewShowCommand creates a new "show" subcommand for "agent" command.
@@ -56,18 +58,21 @@ func (c *showCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 		return err
 	}
 
-	env.Printf("Found an attested agent given its SPIFFE ID\n\n")
+	return c.printer.PrintProto(env, agent, func() error {
+		env.Printf("Found an attested agent given its SPIFFE ID\n\n")
 
-	if err := printAgents(env, agent); err != nil {
-		return err
-	}
+		if err := printAgents(env, agent); err != nil {
+			return err
+		}
 
-	for _, s := range agent.Selectors {
-		env.Printf("Selectors         : %s:%s\n", s.Type, s.Value)
-	}
-	return nil
+		for _, s := range agent.Selectors {
+			env.Printf("Selectors         : %s:%s\n", s.Type, s.Value)
+		}
+		return nil
+	})
 }
 
 func (c *showCommand) AppendFlags(fs *flag.FlagSet) {
 	fs.StringVar(&c.spiffeID, "spiffeID", "", "The SPIFFE ID of the agent to show (agent identity)")
+	c.printer.AppendFlag(fs)
 }
@@ -2,6 +2,7 @@ package bundle
 
 import (
 	"crypto/x509"
+	"encoding/base64"
 	"errors"
 	"os"
 	"path/filepath"
@@ -24,6 +25,8 @@ func TestShowHelp(t *testing.T) {
 	require.Equal(t, `Usage of bundle show:
   -format string
     	The format to show the bundle. Either "pem" or "spiffe". (default "pem")
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
   -socketPath string
     	Path to the SPIRE Server API socket (default "/tmp/spire-server/private/api.sock")
 `, test.stderr.String())
@@ -35,6 +38,9 @@ func TestShowSynopsis(t *testing.T) {
 }
 
 func TestShow(t *testing.T) {
+	cert1, err := pemutil.ParseCertificate([]byte(cert1PEM))
+	require.NoError(t, err)
+
 	for _, tt := range []struct {
 		name          string
 		args          []string
@@ -56,6 +62,11 @@ func TestShow(t *testing.T) {
 			args:        []string{"-format", util.FormatSPIFFE},
 			expectedOut: cert1JWKS,
 		},
+		{
+			name:        "yaml",
+			args:        []string{"-output", "yaml"},
+			expectedOut: "refresh_hint: \"60\"\ntrust_domain: spiffe://example.test\nx509_authorities:\n- asn1: " + base64.StdEncoding.EncodeToString(cert1.Raw) + "\n",
+		},
 		{
 			name:          "server fails",
 			serverErr:     errors.New("some error"),
@@ -97,6 +108,8 @@ func TestSetHelp(t *testing.T) {
     	The format of the bundle data. Either "pem" or "spiffe". (default "pem")
   -id string
     	SPIFFE ID of the trust domain
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
   -path string
     	Path to the bundle data
   -socketPath string
@@ -384,6 +397,8 @@ func TestCountHelp(t *testing.T) {
 	test.client.Help()
 
 	require.Equal(t, `Usage of bundle count:
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
   -socketPath string
     	Path to the SPIRE Server API socket (default "/tmp/spire-server/private/api.sock")
 `, test.stderr.String())
@@ -430,6 +445,12 @@ func TestCount(t *testing.T) {
 			count:          0,
 			expectedStdout: "0 bundles\n",
 		},
+		{
+			name:           "json output",
+			args:           []string{"-output", "json"},
+			count:          2,
+			expectedStdout: "{\n  \"count\": 2\n}\n",
+		},
 	} {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
@@ -478,6 +499,8 @@ func TestListHelp(t *testing.T) {
     	The format to list federated bundles. Either "pem" or "spiffe". (default "pem")
   -id string
     	SPIFFE ID of the trust domain
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
   -socketPath string
     	Path to the SPIRE Server API socket (default "/tmp/spire-server/private/api.sock")
 `, test.stderr.String())
@@ -597,6 +620,8 @@ func TestDeleteHelp(t *testing.T) {
     	SPIFFE ID of the trust domain
   -mode string
     	Deletion mode: one of restrict, delete, or dissociate (default "restrict")
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
   -socketPath string
     	Path to the SPIRE Server API socket (default "/tmp/spire-server/private/api.sock")
 `, test.stderr.String())
@@ -35,6 +35,8 @@ type deleteCommand struct {
 
 	// Deletion mode
 	mode string
+
+	printer common_cli.Printer
 }
 
 func (c *deleteCommand) Name() string {
@@ -48,6 +50,7 @@ func (c *deleteCommand) Synopsis() string {
 func (c *deleteCommand) AppendFlags(fs *flag.FlagSet) {
 	fs.StringVar(&c.id, "id", "", "SPIFFE ID of the trust domain")
 	fs.StringVar(&c.mode, "mode", deleteBundleRestrict, fmt.Sprintf("Deletion mode: one of %s, %s, or %s", deleteBundleRestrict, deleteBundleDelete, deleteBundleDissociate))
+	c.printer.AppendFlag(fs)
 }
 
 func (c *deleteCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
@@ -78,8 +81,9 @@ func (c *deleteCommand) Run(ctx context.Context, env *common_cli.Env, serverClie
 	result := resp.Results[0]
 	switch result.Status.Code {
 	case int32(codes.OK):
-		env.Println("bundle deleted.")
-		return nil
+		return c.printer.PrintProto(env, resp, func() error {
+			return env.Println("bundle deleted.")
+		})
 	default:
 		return fmt.Errorf("failed to delete federated bundle %q: %s", result.TrustDomain, result.Status.Message)
 	}
@@ -24,6 +24,8 @@ func newListCommand(env *common_cli.Env) cli.Command {
 type listCommand struct {
 	id     string // SPIFFE ID of the trust bundle
 	format string
+
+	printer common_cli.Printer
 }
 
 func (c *listCommand) Name() string {
@@ -37,6 +39,7 @@ func (c *listCommand) Synopsis() string {
 func (c *listCommand) AppendFlags(fs *flag.FlagSet) {
 	fs.StringVar(&c.id, "id", "", "SPIFFE ID of the trust domain")
 	fs.StringVar(&c.format, "format", util.FormatPEM, fmt.Sprintf("The format to list federated bundles. Either %q or %q.", util.FormatPEM, util.FormatSPIFFE))
+	c.printer.AppendFlag(fs)
 }
 
 func (c *listCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
@@ -52,7 +55,9 @@ func (c *listCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 		if err != nil {
 			return err
 		}
-		return printBundleWithFormat(env.Stdout, resp, c.format, false)
+		return c.printer.PrintProto(env, resp, func() error {
+			return printBundleWithFormat(env.Stdout, resp, c.format, false)
+		})
 	}
 
 	resp, err := bundleClient.ListFederatedBundles(ctx, &bundlev1.ListFederatedBundlesRequest{})
@@ -60,16 +65,18 @@ func (c *listCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 		return err
 	}
 
-	for i, b := range resp.Bundles {
-		if i != 0 {
-			if err := env.Println(); err != nil {
-				return err
+	return c.printer.PrintProto(env, resp, func() error {
+		for i, b := range resp.Bundles {
+			if i != 0 {
+				if err := env.Println(); err != nil {
+					return err
+				}
 			}
-		}
 
-		if err := printBundleWithFormat(env.Stdout, b, c.format, true); err != nil {
-			return err
+			if err := printBundleWithFormat(env.Stdout, b, c.format, true); err != nil {
+				return err
+			}
 		}
-	}
-	return nil
+		return nil
+	})
 }
@@ -32,6 +32,8 @@ type setCommand struct {
 	path string
 
 	format string
+
+	printer common_cli.Printer
 }
 
 func (c *setCommand) Name() string {
@@ -46,6 +48,7 @@ func (c *setCommand) AppendFlags(fs *flag.FlagSet) {
 	fs.StringVar(&c.id, "id", "", "SPIFFE ID of the trust domain")
 	fs.StringVar(&c.path, "path", "", "Path to the bundle data")
 	fs.StringVar(&c.format, "format", util.FormatPEM, fmt.Sprintf("The format of the bundle data. Either %q or %q.", util.FormatPEM, util.FormatSPIFFE))
+	c.printer.AppendFlag(fs)
 }
 
 func (c *setCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
@@ -84,8 +87,9 @@ func (c *setCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 	result := resp.Results[0]
 	switch result.Status.Code {
 	case int32(codes.OK):
-		env.Println("bundle set.")
-		return nil
+		return c.printer.PrintProto(env, resp, func() error {
+			return env.Println("bundle set.")
+		})
 	default:
 		return fmt.Errorf("failed to set federated bundle: %s", result.Status.Message)
 	}
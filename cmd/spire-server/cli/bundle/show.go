@@ -22,6 +22,8 @@ func newShowCommand(env *common_cli.Env) cli.Command {
 
 type showCommand struct {
 	format string
+
+	printer common_cli.Printer
 }
 
 func (c *showCommand) Name() string {
@@ -34,6 +36,7 @@ func (c *showCommand) Synopsis() string {
 
 func (c *showCommand) AppendFlags(fs *flag.FlagSet) {
 	fs.StringVar(&c.format, "format", util.FormatPEM, fmt.Sprintf("The format to show the bundle. Either %q or %q.", util.FormatPEM, util.FormatSPIFFE))
+	c.printer.AppendFlag(fs)
 }
 
 func (c *showCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
@@ -43,5 +46,7 @@ func (c *showCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 		return err
 	}
 
-	return printBundleWithFormat(env.Stdout, resp, c.format, false)
+	return c.printer.PrintProto(env, resp, func() error {
+		return printBundleWithFormat(env.Stdout, resp, c.format, false)
+	})
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/spiffe/spire/cmd/spire-server/cli/healthcheck"
 	"github.com/spiffe/spire/cmd/spire-server/cli/jwt"
 	"github.com/spiffe/spire/cmd/spire-server/cli/run"
+	"github.com/spiffe/spire/cmd/spire-server/cli/state"
 	"github.com/spiffe/spire/cmd/spire-server/cli/token"
 	"github.com/spiffe/spire/cmd/spire-server/cli/validate"
 	"github.com/spiffe/spire/cmd/spire-server/cli/x509"
@@ -59,6 +60,9 @@ func (cc *CLI) Run(args []string) int {
 		"bundle delete": func() (cli.Command, error) {
 			return bundle.NewDeleteCommand(), nil
 		},
+		"entry apply": func() (cli.Command, error) {
+			return entry.NewApplyCommand(), nil
+		},
 		"entry count": func() (cli.Command, error) {
 			return entry.NewCountCommand(), nil
 		},
@@ -71,6 +75,9 @@ func (cc *CLI) Run(args []string) int {
 		"entry delete": func() (cli.Command, error) {
 			return entry.NewDeleteCommand(), nil
 		},
+		"entry match": func() (cli.Command, error) {
+			return entry.NewMatchCommand(), nil
+		},
 		"entry show": func() (cli.Command, error) {
 			return entry.NewShowCommand(), nil
 		},
@@ -95,6 +102,12 @@ func (cc *CLI) Run(args []string) int {
 		"run": func() (cli.Command, error) {
 			return run.NewRunCommand(cc.LogOptions, cc.AllowUnknownConfig), nil
 		},
+		"state export": func() (cli.Command, error) {
+			return state.NewExportCommand(), nil
+		},
+		"state import": func() (cli.Command, error) {
+			return state.NewImportCommand(), nil
+		},
 		"token generate": func() (cli.Command, error) {
 			return token.NewGenerateCommand(), nil
 		},
@@ -0,0 +1,321 @@
+package entry
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/mitchellh/cli"
+	entryv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/entry/v1"
+	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
+	"github.com/spiffe/spire/cmd/spire-server/util"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/pkg/common/idutil"
+	"github.com/spiffe/spire/pkg/server/api"
+	"github.com/spiffe/spire/proto/spire/common"
+	"google.golang.org/grpc/codes"
+	"sigs.k8s.io/yaml"
+
+	"golang.org/x/net/context"
+)
+
+const (
+	// applyBatchSize is the maximum number of entries sent in a single
+	// batch request
+	applyBatchSize = 50
+
+	// applyPageSize is the page size used to list the existing entries
+	applyPageSize = 1000
+)
+
+// NewApplyCommand creates a new "apply" subcommand for "entry" command.
+func NewApplyCommand() cli.Command {
+	return newApplyCommand(common_cli.DefaultEnv)
+}
+
+func newApplyCommand(env *common_cli.Env) cli.Command {
+	return util.AdaptCommand(env, new(applyCommand))
+}
+
+type applyCommand struct {
+	// Path to the manifest file
+	path string
+
+	// Only the existing entries with this parent ID are considered
+	parentID string
+
+	// Only the existing entries with all these labels are considered,
+	// formatted as name=value
+	labels StringsFlag
+
+	// Whether or not to delete the existing entries missing from the manifest
+	prune bool
+
+	// Whether or not to only print the plan
+	dryRun bool
+}
+
+// applyPlan holds the changes needed to make the existing entries match
+// the manifest
+type applyPlan struct {
+	create    []*types.Entry
+	update    []*types.Entry
+	delete    []*types.Entry
+	unchanged int
+	// unmanaged counts the existing entries missing from the manifest that
+	// are kept since pruning is disabled
+	unmanaged int
+}
+
+func (*applyCommand) Name() string {
+	return "entry apply"
+}
+
+func (*applyCommand) Synopsis() string {
+	return "Makes registration entries match a manifest"
+}
+
+func (c *applyCommand) AppendFlags(f *flag.FlagSet) {
+	f.StringVar(&c.path, "f", "", "Path to a YAML or JSON manifest with the registration entries, in the same format as the create command data file. If set to '-', read the manifest from stdin.")
+	f.StringVar(&c.parentID, "parentID", "", "If set, only the existing entries with this parent ID are considered, and all the entries in the manifest must have it")
+	f.Var(&c.labels, "label", "A name=value label. If set, only the existing entries with all the given labels are considered, and all the entries in the manifest must have them. Can be used more than once")
+	f.BoolVar(&c.prune, "prune", false, "If set, the existing entries that are not in the manifest are deleted")
+	f.BoolVar(&c.dryRun, "dryRun", false, "If set, the plan is printed but not applied")
+}
+
+func (c *applyCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
+	if c.path == "" {
+		return errors.New("a manifest path is required")
+	}
+
+	var parentID *types.SPIFFEID
+	if c.parentID != "" {
+		id, err := idutil.NormalizeSpiffeID(c.parentID, idutil.AllowAny())
+		if err != nil {
+			return err
+		}
+		parentID, err = idStringToProto(id)
+		if err != nil {
+			return err
+		}
+	}
+
+	labels, err := parseLabels(c.labels)
+	if err != nil {
+		return err
+	}
+
+	entries, err := parseManifest(env, c.path)
+	if err != nil {
+		return err
+	}
+	if parentID != nil {
+		for _, e := range entries {
+			if protoToIDString(e.ParentId) != protoToIDString(parentID) {
+				return fmt.Errorf("entry %q has parent ID %q, expected %q", protoToIDString(e.SpiffeId), protoToIDString(e.ParentId), protoToIDString(parentID))
+			}
+		}
+	}
+	for _, e := range entries {
+		for name, value := range labels {
+			if v, ok := e.Labels[name]; !ok || v != value {
+				return fmt.Errorf("entry %q does not have the label %s=%s", protoToIDString(e.SpiffeId), name, value)
+			}
+		}
+	}
+
+	client := serverClient.NewEntryClient()
+	existing, err := listAllEntries(ctx, client, parentID, labels)
+	if err != nil {
+		return err
+	}
+
+	plan, err := makeApplyPlan(entries, existing, c.prune)
+	if err != nil {
+		return err
+	}
+	printPlan(plan, env)
+
+	if c.dryRun {
+		return nil
+	}
+	return applyEntryPlan(ctx, client, plan, env)
+}
+
+// parseManifest parses the registration entries from a YAML or JSON
+// manifest. If path is "-" the manifest is read from stdin.
+func parseManifest(env *common_cli.Env, path string) ([]*types.Entry, error) {
+	dat, err := readInput(env.Stdin, path)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON is a subset of YAML, so both are converted to JSON
+	dat, err = yaml.YAMLToJSON(dat)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	entries := &common.RegistrationEntries{}
+	if err := json.Unmarshal(dat, entries); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	return api.RegistrationEntriesToProto(entries.Entries)
+}
+
+func listAllEntries(ctx context.Context, client entryv1.EntryClient, parentID *types.SPIFFEID, labels map[string]string) ([]*types.Entry, error) {
+	req := &entryv1.ListEntriesRequest{
+		PageSize: applyPageSize,
+	}
+	if parentID != nil || len(labels) > 0 {
+		req.Filter = &entryv1.ListEntriesRequest_Filter{
+			ByParentId: parentID,
+			ByLabels:   labels,
+		}
+	}
+
+	var entries []*types.Entry
+	for {
+		resp, err := client.ListEntries(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching entries: %w", err)
+		}
+		entries = append(entries, resp.Entries...)
+		if resp.NextPageToken == "" {
+			return entries, nil
+		}
+		req.PageToken = resp.NextPageToken
+	}
+}
+
+// makeApplyPlan matches the manifest entries to the existing ones by parent
+// ID, SPIFFE ID and selectors, the same tuple the server uses to detect
+// similar entries.
+func makeApplyPlan(entries, existing []*types.Entry, prune bool) (*applyPlan, error) {
+	existingByKey := make(map[string]*types.Entry, len(existing))
+	for _, e := range existing {
+		existingByKey[util.EntryKey(e)] = e
+	}
+
+	plan := new(applyPlan)
+	seen := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		key := util.EntryKey(e)
+		if seen[key] {
+			return nil, fmt.Errorf("entry %q is in the manifest more than once", protoToIDString(e.SpiffeId))
+		}
+		seen[key] = true
+
+		current, ok := existingByKey[key]
+		switch {
+		case !ok:
+			plan.create = append(plan.create, e)
+		case util.EntryFieldsEqual(e, current):
+			plan.unchanged++
+		default:
+			e.Id = current.Id
+			plan.update = append(plan.update, e)
+		}
+	}
+
+	for _, e := range existing {
+		if seen[util.EntryKey(e)] {
+			continue
+		}
+		if prune {
+			plan.delete = append(plan.delete, e)
+		} else {
+			plan.unmanaged++
+		}
+	}
+	return plan, nil
+}
+
+func printPlan(plan *applyPlan, env *common_cli.Env) {
+	for _, e := range plan.create {
+		env.Printf("+ create %s (parent: %s)\n", protoToIDString(e.SpiffeId), protoToIDString(e.ParentId))
+	}
+	for _, e := range plan.update {
+		env.Printf("~ update %s %s (parent: %s)\n", e.Id, protoToIDString(e.SpiffeId), protoToIDString(e.ParentId))
+	}
+	for _, e := range plan.delete {
+		env.Printf("- delete %s %s (parent: %s)\n", e.Id, protoToIDString(e.SpiffeId), protoToIDString(e.ParentId))
+	}
+
+	env.Printf("Plan: %d to create, %d to update, %d to delete, %d unchanged\n",
+		len(plan.create), len(plan.update), len(plan.delete), plan.unchanged)
+	if plan.unmanaged > 0 {
+		msg := fmt.Sprintf("%d existing ", plan.unmanaged)
+		msg = util.Pluralizer(msg, "entry is", "entries are", plan.unmanaged)
+		env.Printf("%s not in the manifest and kept; use -prune to delete them\n", msg)
+	}
+}
+
+func applyEntryPlan(ctx context.Context, client entryv1.EntryClient, plan *applyPlan, env *common_cli.Env) error {
+	failed := 0
+
+	for _, batch := range batchEntries(plan.create) {
+		resp, err := client.BatchCreateEntry(ctx, &entryv1.BatchCreateEntryRequest{Entries: batch})
+		if err != nil {
+			return err
+		}
+		for i, r := range resp.Results {
+			if r.Status.Code != int32(codes.OK) {
+				failed++
+				env.ErrPrintf("Failed to create entry %s (code: %s, msg: %q)\n",
+					protoToIDString(batch[i].SpiffeId), codes.Code(r.Status.Code), r.Status.Message)
+			}
+		}
+	}
+
+	for _, batch := range batchEntries(plan.update) {
+		resp, err := client.BatchUpdateEntry(ctx, &entryv1.BatchUpdateEntryRequest{Entries: batch})
+		if err != nil {
+			return err
+		}
+		for i, r := range resp.Results {
+			if r.Status.Code != int32(codes.OK) {
+				failed++
+				env.ErrPrintf("Failed to update entry %s (code: %s, msg: %q)\n",
+					batch[i].Id, codes.Code(r.Status.Code), r.Status.Message)
+			}
+		}
+	}
+
+	for _, batch := range batchEntries(plan.delete) {
+		ids := make([]string, 0, len(batch))
+		for _, e := range batch {
+			ids = append(ids, e.Id)
+		}
+		resp, err := client.BatchDeleteEntry(ctx, &entryv1.BatchDeleteEntryRequest{Ids: ids})
+		if err != nil {
+			return err
+		}
+		for _, r := range resp.Results {
+			if r.Status.Code != int32(codes.OK) {
+				failed++
+				env.ErrPrintf("Failed to delete entry %s (code: %s, msg: %q)\n",
+					r.Id, codes.Code(r.Status.Code), r.Status.Message)
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to apply %d of %d changes", failed, len(plan.create)+len(plan.update)+len(plan.delete))
+	}
+	env.Println("Applied successfully")
+	return nil
+}
+
+func batchEntries(entries []*types.Entry) [][]*types.Entry {
+	var batches [][]*types.Entry
+	for len(entries) > applyBatchSize {
+		batches = append(batches, entries[:applyBatchSize])
+		entries = entries[applyBatchSize:]
+	}
+	if len(entries) > 0 {
+		batches = append(batches, entries)
+	}
+	return batches
+}
@@ -0,0 +1,271 @@
+package entry
+
+import (
+	"testing"
+
+	entryv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/entry/v1"
+	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+const applyManifest = `
+entries:
+- spiffe_id: spiffe://example.org/unchanged
+  parent_id: spiffe://example.org/agent
+  selectors:
+  - type: unix
+    value: uid:1
+  ttl: 60
+- spiffe_id: spiffe://example.org/changed
+  parent_id: spiffe://example.org/agent
+  selectors:
+  - type: unix
+    value: uid:2
+  ttl: 120
+- spiffe_id: spiffe://example.org/new
+  parent_id: spiffe://example.org/agent
+  selectors:
+  - type: unix
+    value: uid:3
+`
+
+func TestApplyHelp(t *testing.T) {
+	test := setupTest(t, newApplyCommand)
+	test.client.Help()
+
+	require.Equal(t, `Usage of entry apply:
+  -dryRun
+    	If set, the plan is printed but not applied
+  -f string
+    	Path to a YAML or JSON manifest with the registration entries, in the same format as the create command data file. If set to '-', read the manifest from stdin.
+  -label value
+    	A name=value label. If set, only the existing entries with all the given labels are considered, and all the entries in the manifest must have them. Can be used more than once
+  -parentID string
+    	If set, only the existing entries with this parent ID are considered, and all the entries in the manifest must have it
+  -prune
+    	If set, the existing entries that are not in the manifest are deleted
+  -socketPath string
+    	Path to the SPIRE Server API socket (default "/tmp/spire-server/private/api.sock")
+`, test.stderr.String())
+}
+
+func TestApplySynopsis(t *testing.T) {
+	test := setupTest(t, newApplyCommand)
+	require.Equal(t, "Makes registration entries match a manifest", test.client.Synopsis())
+}
+
+func TestApply(t *testing.T) {
+	agentID := &types.SPIFFEID{TrustDomain: "example.org", Path: "/agent"}
+	existing := []*types.Entry{
+		{
+			Id:        "unchanged-id",
+			SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/unchanged"},
+			ParentId:  agentID,
+			Selectors: []*types.Selector{{Type: "unix", Value: "uid:1"}},
+			Ttl:       60,
+		},
+		{
+			Id:        "changed-id",
+			SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/changed"},
+			ParentId:  agentID,
+			Selectors: []*types.Selector{{Type: "unix", Value: "uid:2"}},
+			Ttl:       60,
+		},
+		{
+			Id:        "stale-id",
+			SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/stale"},
+			ParentId:  agentID,
+			Selectors: []*types.Selector{{Type: "unix", Value: "uid:4"}},
+		},
+	}
+
+	createReq := &entryv1.BatchCreateEntryRequest{
+		Entries: []*types.Entry{
+			{
+				SpiffeId:      &types.SPIFFEID{TrustDomain: "example.org", Path: "/new"},
+				ParentId:      agentID,
+				Selectors:     []*types.Selector{{Type: "unix", Value: "uid:3"}},
+				FederatesWith: []string{},
+			},
+		},
+	}
+	updateReq := &entryv1.BatchUpdateEntryRequest{
+		Entries: []*types.Entry{
+			{
+				Id:            "changed-id",
+				SpiffeId:      &types.SPIFFEID{TrustDomain: "example.org", Path: "/changed"},
+				ParentId:      agentID,
+				Selectors:     []*types.Selector{{Type: "unix", Value: "uid:2"}},
+				Ttl:           120,
+				FederatesWith: []string{},
+			},
+		},
+	}
+	deleteReq := &entryv1.BatchDeleteEntryRequest{Ids: []string{"stale-id"}}
+
+	okStatus := &types.Status{Code: int32(codes.OK), Message: "OK"}
+	createResp := &entryv1.BatchCreateEntryResponse{
+		Results: []*entryv1.BatchCreateEntryResponse_Result{{Status: okStatus}},
+	}
+	updateResp := &entryv1.BatchUpdateEntryResponse{
+		Results: []*entryv1.BatchUpdateEntryResponse_Result{{Status: okStatus}},
+	}
+	deleteResp := &entryv1.BatchDeleteEntryResponse{
+		Results: []*entryv1.BatchDeleteEntryResponse_Result{{Id: "stale-id", Status: okStatus}},
+	}
+
+	const plan = `+ create spiffe://example.org/new (parent: spiffe://example.org/agent)
+~ update changed-id spiffe://example.org/changed (parent: spiffe://example.org/agent)
+`
+
+	for _, tt := range []struct {
+		name     string
+		args     []string
+		manifest string
+
+		expListReq *entryv1.ListEntriesRequest
+		expCreate  *entryv1.BatchCreateEntryRequest
+		expUpdate  *entryv1.BatchUpdateEntryRequest
+		expDelete  *entryv1.BatchDeleteEntryRequest
+		createResp *entryv1.BatchCreateEntryResponse
+		updateResp *entryv1.BatchUpdateEntryResponse
+		deleteResp *entryv1.BatchDeleteEntryResponse
+
+		expOut string
+		expErr string
+	}{
+		{
+			name:   "Missing manifest",
+			expErr: "Error: a manifest path is required\n",
+		},
+		{
+			name:     "Invalid manifest",
+			args:     []string{"-f", "-"},
+			manifest: "entries: [",
+			expErr:   "Error: failed to parse manifest: yaml: line 1: did not find expected node content\n",
+		},
+		{
+			name:     "Entry out of parent ID scope",
+			args:     []string{"-f", "-", "-parentID", "spiffe://example.org/other"},
+			manifest: applyManifest,
+			expErr:   "Error: entry \"spiffe://example.org/unchanged\" has parent ID \"spiffe://example.org/agent\", expected \"spiffe://example.org/other\"\n",
+		},
+		{
+			name:     "Entry out of label scope",
+			args:     []string{"-f", "-", "-label", "team=payments"},
+			manifest: applyManifest,
+			expErr:   "Error: entry \"spiffe://example.org/unchanged\" does not have the label team=payments\n",
+		},
+		{
+			name:     "Malformed label",
+			args:     []string{"-f", "-", "-label", "team"},
+			manifest: applyManifest,
+			expErr:   "Error: label \"team\" must be formatted as name=value\n",
+		},
+		{
+			name: "Duplicated entry",
+			args: []string{"-f", "-"},
+			manifest: `{"entries": [
+				{"spiffe_id": "spiffe://example.org/new", "parent_id": "spiffe://example.org/agent", "selectors": [{"type": "unix", "value": "uid:3"}]},
+				{"spiffe_id": "spiffe://example.org/new", "parent_id": "spiffe://example.org/agent", "selectors": [{"type": "unix", "value": "uid:3"}]}
+			]}`,
+			expListReq: &entryv1.ListEntriesRequest{PageSize: applyPageSize},
+			expErr:     "Error: entry \"spiffe://example.org/new\" is in the manifest more than once\n",
+		},
+		{
+			name:       "Dry run",
+			args:       []string{"-f", "-", "-dryRun"},
+			manifest:   applyManifest,
+			expListReq: &entryv1.ListEntriesRequest{PageSize: applyPageSize},
+			expOut: plan + `Plan: 1 to create, 1 to update, 0 to delete, 1 unchanged
+1 existing entry is not in the manifest and kept; use -prune to delete them
+`,
+		},
+		{
+			name:     "Dry run with prune and parent ID scope",
+			args:     []string{"-f", "-", "-dryRun", "-prune", "-parentID", "spiffe://example.org/agent"},
+			manifest: applyManifest,
+			expListReq: &entryv1.ListEntriesRequest{
+				PageSize: applyPageSize,
+				Filter:   &entryv1.ListEntriesRequest_Filter{ByParentId: agentID},
+			},
+			expOut: plan + `- delete stale-id spiffe://example.org/stale (parent: spiffe://example.org/agent)
+Plan: 1 to create, 1 to update, 1 to delete, 1 unchanged
+`,
+		},
+		{
+			name: "Dry run with label scope",
+			args: []string{"-f", "-", "-dryRun", "-label", "team=payments"},
+			manifest: `{"entries": [
+				{"spiffe_id": "spiffe://example.org/unchanged", "parent_id": "spiffe://example.org/agent", "selectors": [{"type": "unix", "value": "uid:1"}], "ttl": 60, "labels": {"team": "payments"}}
+			]}`,
+			expListReq: &entryv1.ListEntriesRequest{
+				PageSize: applyPageSize,
+				Filter:   &entryv1.ListEntriesRequest_Filter{ByLabels: map[string]string{"team": "payments"}},
+			},
+			expOut: `~ update unchanged-id spiffe://example.org/unchanged (parent: spiffe://example.org/agent)
+Plan: 0 to create, 1 to update, 0 to delete, 0 unchanged
+2 existing entries are not in the manifest and kept; use -prune to delete them
+`,
+		},
+		{
+			name:       "Apply succeeds",
+			args:       []string{"-f", "-", "-prune"},
+			manifest:   applyManifest,
+			expListReq: &entryv1.ListEntriesRequest{PageSize: applyPageSize},
+			expCreate:  createReq,
+			expUpdate:  updateReq,
+			expDelete:  deleteReq,
+			createResp: createResp,
+			updateResp: updateResp,
+			deleteResp: deleteResp,
+			expOut: plan + `- delete stale-id spiffe://example.org/stale (parent: spiffe://example.org/agent)
+Plan: 1 to create, 1 to update, 1 to delete, 1 unchanged
+Applied successfully
+`,
+		},
+		{
+			name:       "Apply fails",
+			args:       []string{"-f", "-"},
+			manifest:   applyManifest,
+			expListReq: &entryv1.ListEntriesRequest{PageSize: applyPageSize},
+			expCreate:  createReq,
+			expUpdate:  updateReq,
+			createResp: &entryv1.BatchCreateEntryResponse{
+				Results: []*entryv1.BatchCreateEntryResponse_Result{
+					{Status: &types.Status{Code: int32(codes.InvalidArgument), Message: "failed to create entry"}},
+				},
+			},
+			updateResp: updateResp,
+			expErr: `Failed to create entry spiffe://example.org/new (code: InvalidArgument, msg: "failed to create entry")
+Error: failed to apply 1 of 2 changes
+`,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupTest(t, newApplyCommand)
+			test.server.expListEntriesReq = tt.expListReq
+			test.server.listEntriesResp = &entryv1.ListEntriesResponse{Entries: existing}
+			test.server.expBatchCreateEntryReq = tt.expCreate
+			test.server.expBatchUpdateEntryReq = tt.expUpdate
+			test.server.expBatchDeleteEntryReq = tt.expDelete
+			test.server.batchCreateEntryResp = tt.createResp
+			test.server.batchUpdateEntryResp = tt.updateResp
+			test.server.batchDeleteEntryResp = tt.deleteResp
+			test.stdin.WriteString(tt.manifest)
+
+			args := append(test.args, tt.args...)
+			rc := test.client.Run(args)
+			if tt.expErr != "" {
+				require.Equal(t, 1, rc)
+				require.Equal(t, tt.expErr, test.stderr.String())
+				return
+			}
+
+			require.Equal(t, 0, rc)
+			require.Equal(t, tt.expOut, test.stdout.String())
+		})
+	}
+}
@@ -13,7 +13,9 @@ import (
 	"golang.org/x/net/context"
 )
 
-type countCommand struct{}
+type countCommand struct {
+	printer common_cli.Printer
+}
 
 // NewCountCommand creates a new "count" subcommand for "entry" command.
 func NewCountCommand() cli.Command {
@@ -42,13 +44,14 @@ func (c *countCommand) Run(ctx context.Context, env *common_cli.Env, serverClien
 		return err
 	}
 
-	count := int(countResponse.Count)
-	msg := fmt.Sprintf("%d registration ", count)
-	msg = util.Pluralizer(msg, "entry", "entries", count)
-	env.Println(msg)
-
-	return nil
+	return c.printer.PrintProto(env, countResponse, func() error {
+		count := int(countResponse.Count)
+		msg := fmt.Sprintf("%d registration ", count)
+		msg = util.Pluralizer(msg, "entry", "entries", count)
+		return env.Println(msg)
+	})
 }
 
 func (c *countCommand) AppendFlags(fs *flag.FlagSet) {
+	c.printer.AppendFlag(fs)
 }
@@ -14,6 +14,8 @@ func TestCountHelp(t *testing.T) {
 	test.client.Help()
 
 	require.Equal(t, `Usage of entry count:
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
   -socketPath string
     	Path to the SPIRE Server API socket (default "/tmp/spire-server/private/api.sock")
 `, test.stderr.String())
@@ -58,6 +60,12 @@ func TestCount(t *testing.T) {
 			fakeCountResp: fakeResp0,
 			expOut:        "0 registration entries\n",
 		},
+		{
+			name:          "JSON output",
+			args:          []string{"-output", "json"},
+			fakeCountResp: fakeResp4,
+			expOut:        "{\n  \"count\": 4\n}\n",
+		},
 		{
 			name:      "Server error",
 			serverErr: status.Error(codes.Internal, "internal server error"),
@@ -10,6 +10,7 @@ import (
 	"github.com/spiffe/spire/cmd/spire-server/util"
 	common_cli "github.com/spiffe/spire/pkg/common/cli"
 	"github.com/spiffe/spire/pkg/common/idutil"
+	"github.com/spiffe/spire/pkg/server/api"
 	"google.golang.org/grpc/codes"
 
 	"golang.org/x/net/context"
@@ -42,6 +43,9 @@ type createCommand struct {
 	// TTL for certificates issued to this workload
 	ttl int
 
+	// TTL for JWT-SVIDs issued to this workload
+	jwtSVIDTTL int
+
 	// List of SPIFFE IDs of trust domains the registration entry is federated with
 	federatesWith StringsFlag
 
@@ -62,6 +66,14 @@ type createCommand struct {
 
 	// storeSVID determines if the issued SVID must be stored through an SVIDStore plugin
 	storeSVID bool
+
+	// Labels to attach to the entry, formatted as name=value
+	labels StringsFlag
+
+	// Whether or not to only report what would be created
+	dryRun bool
+
+	printer common_cli.Printer
 }
 
 func (*createCommand) Name() string {
@@ -76,6 +88,7 @@ func (c *createCommand) AppendFlags(f *flag.FlagSet) {
 	f.StringVar(&c.parentID, "parentID", "", "The SPIFFE ID of this record's parent")
 	f.StringVar(&c.spiffeID, "spiffeID", "", "The SPIFFE ID that this record represents")
 	f.IntVar(&c.ttl, "ttl", 0, "The lifetime, in seconds, for SVIDs issued based on this registration entry")
+	f.IntVar(&c.jwtSVIDTTL, "jwtSVIDTTL", 0, "The lifetime, in seconds, for JWT-SVIDs issued based on this registration entry. The ttl is used if unset")
 	f.StringVar(&c.path, "data", "", "Path to a file containing registration JSON (optional). If set to '-', read the JSON from stdin.")
 	f.Var(&c.selectors, "selector", "A colon-delimited type:value selector. Can be used more than once")
 	f.Var(&c.federatesWith, "federatesWith", "SPIFFE ID of a trust domain to federate with. Can be used more than once")
@@ -85,6 +98,9 @@ func (c *createCommand) AppendFlags(f *flag.FlagSet) {
 	f.BoolVar(&c.downstream, "downstream", false, "A boolean value that, when set, indicates that the entry describes a downstream SPIRE server")
 	f.Int64Var(&c.entryExpiry, "entryExpiry", 0, "An expiry, from epoch in seconds, for the resulting registration entry to be pruned")
 	f.Var(&c.dnsNames, "dns", "A DNS name that will be included in SVIDs issued based on this entry, where appropriate. Can be used more than once")
+	f.Var(&c.labels, "label", "A name=value label to attach to the entry. Can be used more than once")
+	f.BoolVar(&c.dryRun, "dryRun", false, "If set, the entry is validated and the agents that would receive it are reported, but it is not created")
+	c.printer.AppendFlag(f)
 }
 
 func (c *createCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
@@ -103,22 +119,35 @@ func (c *createCommand) Run(ctx context.Context, env *common_cli.Env, serverClie
 		return err
 	}
 
-	succeeded, failed, err := createEntries(ctx, serverClient.NewEntryClient(), entries)
+	if c.dryRun {
+		ctx = api.WithDryRun(ctx)
+	}
+
+	resp, succeeded, failed, err := createEntries(ctx, serverClient.NewEntryClient(), entries)
 	if err != nil {
 		return err
 	}
 
-	// Print entries that succeeded to be created
-	for _, r := range succeeded {
-		printEntry(r.Entry, env.Printf)
-	}
+	if err := c.printer.PrintProto(env, resp, func() error {
+		// Print entries that succeeded to be created
+		for _, r := range succeeded {
+			if c.dryRun {
+				env.Printf("Would create the following entry (%s):\n", r.Status.Message)
+			}
+			printEntry(r.Entry, env.Printf)
+		}
+
+		// Print entries that failed to be created
+		for _, r := range failed {
+			env.ErrPrintf("Failed to create the following entry (code: %s, msg: %q):\n",
+				codes.Code(r.Status.Code),
+				r.Status.Message)
+			printEntry(r.Entry, env.ErrPrintf)
+		}
 
-	// Print entries that failed to be created
-	for _, r := range failed {
-		env.ErrPrintf("Failed to create the following entry (code: %s, msg: %q):\n",
-			codes.Code(r.Status.Code),
-			r.Status.Message)
-		printEntry(r.Entry, env.ErrPrintf)
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	if len(failed) > 0 {
@@ -156,6 +185,10 @@ func (c *createCommand) validate() (err error) {
 		return errors.New("a positive TTL is required")
 	}
 
+	if c.jwtSVIDTTL < 0 {
+		return errors.New("a positive JWT-SVID TTL is required")
+	}
+
 	// make sure all SPIFFE ID's are well formed
 	c.spiffeID, err = idutil.NormalizeSpiffeID(c.spiffeID, idutil.AllowAny())
 	if err != nil {
@@ -195,6 +228,7 @@ func (c *createCommand) parseConfig() ([]*types.Entry, error) {
 		ParentId:   parentID,
 		SpiffeId:   spiffeID,
 		Ttl:        int32(c.ttl),
+		JwtSvidTtl: int32(c.jwtSVIDTTL),
 		Downstream: c.downstream,
 		ExpiresAt:  c.entryExpiry,
 		DnsNames:   c.dnsNames,
@@ -211,16 +245,22 @@ func (c *createCommand) parseConfig() ([]*types.Entry, error) {
 		selectors = append(selectors, cs)
 	}
 
+	labels, err := parseLabels(c.labels)
+	if err != nil {
+		return nil, err
+	}
+
 	e.Selectors = selectors
+	e.Labels = labels
 	e.FederatesWith = c.federatesWith
 	e.Admin = c.admin
 	return []*types.Entry{e}, nil
 }
 
-func createEntries(ctx context.Context, c entryv1.EntryClient, entries []*types.Entry) (succeeded, failed []*entryv1.BatchCreateEntryResponse_Result, err error) {
-	resp, err := c.BatchCreateEntry(ctx, &entryv1.BatchCreateEntryRequest{Entries: entries})
+func createEntries(ctx context.Context, c entryv1.EntryClient, entries []*types.Entry) (resp *entryv1.BatchCreateEntryResponse, succeeded, failed []*entryv1.BatchCreateEntryResponse_Result, err error) {
+	resp, err = c.BatchCreateEntry(ctx, &entryv1.BatchCreateEntryRequest{Entries: entries})
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	for i, r := range resp.Results {
@@ -235,7 +275,7 @@ func createEntries(ctx context.Context, c entryv1.EntryClient, entries []*types.
 		}
 	}
 
-	return succeeded, failed, nil
+	return resp, succeeded, failed, nil
 }
 
 func getParentID(config *createCommand, td string) (*types.SPIFFEID, error) {
@@ -25,12 +25,20 @@ func TestCreateHelp(t *testing.T) {
     	A DNS name that will be included in SVIDs issued based on this entry, where appropriate. Can be used more than once
   -downstream
     	A boolean value that, when set, indicates that the entry describes a downstream SPIRE server
+  -dryRun
+    	If set, the entry is validated and the agents that would receive it are reported, but it is not created
   -entryExpiry int
     	An expiry, from epoch in seconds, for the resulting registration entry to be pruned
   -federatesWith value
     	SPIFFE ID of a trust domain to federate with. Can be used more than once
+  -jwtSVIDTTL int
+    	The lifetime, in seconds, for JWT-SVIDs issued based on this registration entry. The ttl is used if unset
+  -label value
+    	A name=value label to attach to the entry. Can be used more than once
   -node
     	If set, this entry will be applied to matching nodes rather than workloads
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
   -parentID string
     	The SPIFFE ID of this record's parent
   -selector value
@@ -64,10 +72,12 @@ func TestCreate(t *testing.T) {
 						{Type: "alpha", Value: "alpha:2000"},
 					},
 					Ttl:           60,
+					JwtSvidTtl:    30,
 					FederatesWith: []string{"spiffe://domaina.test", "spiffe://domainb.test"},
 					Admin:         true,
 					ExpiresAt:     1552410266,
 					DnsNames:      []string{"unu1000", "ung1000"},
+					Labels:        map[string]string{"team": "payments", "env": "prod"},
 					Downstream:    true,
 					StoreSvid:     true,
 				},
@@ -147,8 +157,9 @@ func TestCreate(t *testing.T) {
 		fakeResp  *entryv1.BatchCreateEntryResponse
 		serverErr error
 
-		expOut string
-		expErr string
+		expOut    string
+		expErr    string
+		expDryRun bool
 	}{
 		{
 			name:   "Missing selectors",
@@ -184,6 +195,16 @@ func TestCreate(t *testing.T) {
 			args:   []string{"-selector", "unix", "-parentID", "spiffe://example.org/parent", "-spiffeID", "spiffe://example.org/workload", "-ttl", "-10"},
 			expErr: "Error: a positive TTL is required\n",
 		},
+		{
+			name:   "Negative JWT-SVID TTL",
+			args:   []string{"-selector", "unix", "-parentID", "spiffe://example.org/parent", "-spiffeID", "spiffe://example.org/workload", "-jwtSVIDTTL", "-10"},
+			expErr: "Error: a positive JWT-SVID TTL is required\n",
+		},
+		{
+			name:   "Malformed label",
+			args:   []string{"-selector", "unix:uid:1", "-parentID", "spiffe://example.org/parent", "-spiffeID", "spiffe://example.org/workload", "-label", "team"},
+			expErr: "Error: label \"team\" must be formatted as name=value\n",
+		},
 		{
 			name:   "Federated node entries",
 			args:   []string{"-selector", "unix", "-spiffeID", "spiffe://example.org/workload", "-node", "-federatesWith", "spiffe://another.org"},
@@ -215,12 +236,15 @@ func TestCreate(t *testing.T) {
 				"-selector", "zebra:zebra:2000",
 				"-selector", "alpha:alpha:2000",
 				"-ttl", "60",
+				"-jwtSVIDTTL", "30",
 				"-federatesWith", "spiffe://domainA.test",
 				"-federatesWith", "spiffe://domainB.test",
 				"-admin",
 				"-entryExpiry", "1552410266",
 				"-dns", "unu1000",
 				"-dns", "ung1000",
+				"-label", "team=payments",
+				"-label", "env=prod",
 				"-downstream",
 				"-storeSVID",
 			},
@@ -234,10 +258,12 @@ func TestCreate(t *testing.T) {
 							{Type: "alpha", Value: "alpha:2000"},
 						},
 						Ttl:           60,
+						JwtSvidTtl:    30,
 						FederatesWith: []string{"spiffe://domaina.test", "spiffe://domainb.test"},
 						Admin:         true,
 						ExpiresAt:     1552410266,
 						DnsNames:      []string{"unu1000", "ung1000"},
+						Labels:        map[string]string{"team": "payments", "env": "prod"},
 						Downstream:    true,
 						StoreSvid:     true,
 					},
@@ -250,6 +276,7 @@ Parent ID        : spiffe://example.org/parent
 Revision         : 0
 Downstream       : true
 TTL              : 60
+JWT-SVID TTL     : 30
 Expiration time  : %s
 Selector         : zebra:zebra:2000
 Selector         : alpha:alpha:2000
@@ -257,6 +284,8 @@ FederatesWith    : spiffe://domaina.test
 FederatesWith    : spiffe://domainb.test
 DNS name         : unu1000
 DNS name         : ung1000
+Label            : env=prod
+Label            : team=payments
 Admin            : true
 StoreSvid        : true
 
@@ -319,6 +348,93 @@ Selector         : type:key1:value
 Selector         : type:key2:value
 StoreSvid        : true
 
+`,
+		},
+		{
+			name: "Dry run",
+			args: []string{"-spiffeID", "spiffe://example.org/workload", "-parentID", "spiffe://example.org/parent", "-selector", "unix:uid:1", "-dryRun"},
+			expReq: &entryv1.BatchCreateEntryRequest{Entries: []*types.Entry{
+				{
+					SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/workload"},
+					ParentId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/parent"},
+					Selectors: []*types.Selector{{Type: "unix", Value: "uid:1"}},
+				},
+			}},
+			fakeResp: &entryv1.BatchCreateEntryResponse{
+				Results: []*entryv1.BatchCreateEntryResponse_Result{
+					{
+						Entry: &types.Entry{
+							SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/workload"},
+							ParentId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/parent"},
+							Selectors: []*types.Selector{{Type: "unix", Value: "uid:1"}},
+						},
+						Status: &types.Status{
+							Code:    int32(codes.OK),
+							Message: "dry run; receiving agents: spiffe://example.org/parent",
+						},
+					},
+				},
+			},
+			expDryRun: true,
+			expOut: `Would create the following entry (dry run; receiving agents: spiffe://example.org/parent):
+Entry ID         : (none)
+SPIFFE ID        : spiffe://example.org/workload
+Parent ID        : spiffe://example.org/parent
+Revision         : 0
+TTL              : default
+Selector         : unix:uid:1
+
+`,
+		},
+		{
+			name: "Create succeeds with JSON output",
+			args: []string{"-spiffeID", "spiffe://example.org/workload", "-parentID", "spiffe://example.org/parent", "-selector", "unix:uid:1", "-output", "json"},
+			expReq: &entryv1.BatchCreateEntryRequest{Entries: []*types.Entry{
+				{
+					SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/workload"},
+					ParentId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/parent"},
+					Selectors: []*types.Selector{{Type: "unix", Value: "uid:1"}},
+				},
+			}},
+			fakeResp: &entryv1.BatchCreateEntryResponse{
+				Results: []*entryv1.BatchCreateEntryResponse_Result{
+					{
+						Entry: &types.Entry{
+							Id:        "entry-id",
+							SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/workload"},
+							ParentId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/parent"},
+							Selectors: []*types.Selector{{Type: "unix", Value: "uid:1"}},
+						},
+						Status: &types.Status{Code: int32(codes.OK), Message: "OK"},
+					},
+				},
+			},
+			expOut: `{
+  "results": [
+    {
+      "status": {
+        "message": "OK"
+      },
+      "entry": {
+        "id": "entry-id",
+        "spiffe_id": {
+          "trust_domain": "example.org",
+          "path": "/workload"
+        },
+        "parent_id": {
+          "trust_domain": "example.org",
+          "path": "/parent"
+        },
+        "selectors": [
+          {
+            "type": "unix",
+            "value": "uid:1"
+          }
+        ]
+      }
+    }
+  ]
+}
 `,
 		},
 		{
@@ -350,6 +466,7 @@ Error: failed to create one or more entries
 			test.server.err = tt.serverErr
 			test.server.expBatchCreateEntryReq = tt.expReq
 			test.server.batchCreateEntryResp = tt.fakeResp
+			test.server.expDryRun = tt.expDryRun
 
 			args := append(test.args, tt.args...)
 			rc := test.client.Run(args)
@@ -9,6 +9,7 @@ import (
 	entryv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/entry/v1"
 	"github.com/spiffe/spire/cmd/spire-server/util"
 	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/pkg/server/api"
 	"google.golang.org/grpc/codes"
 
 	"golang.org/x/net/context"
@@ -26,6 +27,11 @@ func newDeleteCommand(env *common_cli.Env) cli.Command {
 type deleteCommand struct {
 	// ID of the record to delete
 	entryID string
+
+	// Whether or not to only report what would be deleted
+	dryRun bool
+
+	printer common_cli.Printer
 }
 
 func (*deleteCommand) Name() string {
@@ -38,6 +44,8 @@ func (*deleteCommand) Synopsis() string {
 
 func (c *deleteCommand) AppendFlags(f *flag.FlagSet) {
 	f.StringVar(&c.entryID, "entryID", "", "The Registration Entry ID of the record to delete")
+	f.BoolVar(&c.dryRun, "dryRun", false, "If set, the agents that would lose the entry are reported, but it is not deleted")
+	c.printer.AppendFlag(f)
 }
 
 func (c *deleteCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
@@ -45,6 +53,10 @@ func (c *deleteCommand) Run(ctx context.Context, env *common_cli.Env, serverClie
 		return err
 	}
 
+	if c.dryRun {
+		ctx = api.WithDryRun(ctx)
+	}
+
 	req := &entryv1.BatchDeleteEntryRequest{Ids: []string{c.entryID}}
 	resp, err := serverClient.NewEntryClient().BatchDeleteEntry(ctx, req)
 	if err != nil {
@@ -54,8 +66,12 @@ func (c *deleteCommand) Run(ctx context.Context, env *common_cli.Env, serverClie
 	sts := resp.Results[0].Status
 	switch sts.Code {
 	case int32(codes.OK):
-		env.Printf("Deleted entry with ID: %s\n", c.entryID)
-		return nil
+		return c.printer.PrintProto(env, resp, func() error {
+			if c.dryRun {
+				return env.Printf("Would delete entry with ID: %s (%s)\n", c.entryID, sts.Message)
+			}
+			return env.Printf("Deleted entry with ID: %s\n", c.entryID)
+		})
 	default:
 		return fmt.Errorf("failed to delete entry: %s", sts.Message)
 	}
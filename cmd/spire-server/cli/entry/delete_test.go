@@ -15,8 +15,12 @@ func TestDeleteHelp(t *testing.T) {
 	test.client.Help()
 
 	require.Equal(t, `Usage of entry delete:
+  -dryRun
+    	If set, the agents that would lose the entry are reported, but it is not deleted
   -entryID string
     	The Registration Entry ID of the record to delete
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
   -socketPath string
     	Path to the SPIRE Server API socket (default "/tmp/spire-server/private/api.sock")
 `, test.stderr.String())
@@ -60,8 +64,9 @@ func TestDelete(t *testing.T) {
 		fakeResp  *entryv1.BatchDeleteEntryResponse
 		serverErr error
 
-		expOut string
-		expErr string
+		expOut    string
+		expErr    string
+		expDryRun bool
 	}{
 		{
 			name:   "Empty entry ID",
@@ -88,6 +93,24 @@ func TestDelete(t *testing.T) {
 			fakeResp: fakeRespOK,
 			expOut:   "Deleted entry with ID: entry-id\n",
 		},
+		{
+			name:   "Dry run",
+			args:   []string{"-entryID", "entry-id", "-dryRun"},
+			expReq: &entryv1.BatchDeleteEntryRequest{Ids: []string{"entry-id"}},
+			fakeResp: &entryv1.BatchDeleteEntryResponse{
+				Results: []*entryv1.BatchDeleteEntryResponse_Result{
+					{
+						Id: "entry-id",
+						Status: &types.Status{
+							Code:    int32(codes.OK),
+							Message: "dry run; losing agents: spiffe://example.org/agent",
+						},
+					},
+				},
+			},
+			expDryRun: true,
+			expOut:    "Would delete entry with ID: entry-id (dry run; losing agents: spiffe://example.org/agent)\n",
+		},
 	} {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
@@ -95,6 +118,7 @@ func TestDelete(t *testing.T) {
 			test.server.err = tt.serverErr
 			test.server.expBatchDeleteEntryReq = tt.expReq
 			test.server.batchDeleteEntryResp = tt.fakeResp
+			test.server.expDryRun = tt.expDryRun
 
 			args := append(test.args, tt.args...)
 			rc := test.client.Run(args)
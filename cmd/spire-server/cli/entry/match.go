@@ -0,0 +1,89 @@
+package entry
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/mitchellh/cli"
+	entryv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/entry/v1"
+	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
+	"github.com/spiffe/spire/cmd/spire-server/util"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	commonutil "github.com/spiffe/spire/pkg/common/util"
+
+	"golang.org/x/net/context"
+)
+
+// NewMatchCommand creates a new "match" subcommand for "entry" command.
+func NewMatchCommand() cli.Command {
+	return newMatchCommand(common_cli.DefaultEnv)
+}
+
+func newMatchCommand(env *common_cli.Env) cli.Command {
+	return util.AdaptCommand(env, new(matchCommand))
+}
+
+type matchCommand struct {
+	// SPIFFE ID of the agent the workload is running on
+	agentID string
+
+	// Selectors of the workload. Type and value are delimited by a colon (:)
+	// ex. "unix:uid:1000" or "k8s:ns:default"
+	selectors StringsFlag
+
+	printer common_cli.Printer
+}
+
+func (*matchCommand) Name() string {
+	return "entry match"
+}
+
+func (*matchCommand) Synopsis() string {
+	return "Displays the registration entries a workload would receive from an agent"
+}
+
+func (c *matchCommand) AppendFlags(f *flag.FlagSet) {
+	f.StringVar(&c.agentID, "agentID", "", "The SPIFFE ID of the agent the workload is running on")
+	f.Var(&c.selectors, "selector", "A colon-delimited type:value selector of the workload. Can be used more than once")
+	c.printer.AppendFlag(f)
+}
+
+// Run executes all logic associated with a single invocation of the
+// `spire-server entry match` CLI command
+func (c *matchCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
+	if c.agentID == "" {
+		return errors.New("an agent ID is required")
+	}
+	if len(c.selectors) == 0 {
+		return errors.New("at least one selector is required")
+	}
+
+	agentID, err := idStringToProto(c.agentID)
+	if err != nil {
+		return fmt.Errorf("error parsing agent ID %q: %w", c.agentID, err)
+	}
+
+	selectors := make([]*types.Selector, 0, len(c.selectors))
+	for _, s := range c.selectors {
+		selector, err := util.ParseSelector(s)
+		if err != nil {
+			return fmt.Errorf("error parsing selectors: %w", err)
+		}
+		selectors = append(selectors, selector)
+	}
+
+	resp, err := serverClient.NewEntryClient().GetMatchingEntries(ctx, &entryv1.GetMatchingEntriesRequest{
+		AgentId:   agentID,
+		Selectors: selectors,
+	})
+	if err != nil {
+		return fmt.Errorf("error fetching matching entries: %w", err)
+	}
+
+	commonutil.SortTypesEntries(resp.Entries)
+	return c.printer.PrintProto(env, resp, func() error {
+		printEntries(resp.Entries, env)
+		return nil
+	})
+}
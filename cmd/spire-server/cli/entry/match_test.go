@@ -0,0 +1,127 @@
+package entry
+
+import (
+	"fmt"
+	"testing"
+
+	entryv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/entry/v1"
+	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMatchHelp(t *testing.T) {
+	test := setupTest(t, newMatchCommand)
+	test.client.Help()
+
+	require.Equal(t, `Usage of entry match:
+  -agentID string
+    	The SPIFFE ID of the agent the workload is running on
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
+  -selector value
+    	A colon-delimited type:value selector of the workload. Can be used more than once
+  -socketPath string
+    	Path to the SPIRE Server API socket (default "/tmp/spire-server/private/api.sock")
+`, test.stderr.String())
+}
+
+func TestMatchSynopsis(t *testing.T) {
+	test := setupTest(t, newMatchCommand)
+	require.Equal(t, "Displays the registration entries a workload would receive from an agent", test.client.Synopsis())
+}
+
+func TestMatch(t *testing.T) {
+	agentID := &types.SPIFFEID{TrustDomain: "example.org", Path: "/spire/agent/foo"}
+
+	for _, tt := range []struct {
+		name string
+		args []string
+
+		expReq    *entryv1.GetMatchingEntriesRequest
+		fakeResp  *entryv1.GetMatchingEntriesResponse
+		serverErr error
+
+		expOut string
+		expErr string
+	}{
+		{
+			name:   "Missing agent ID",
+			args:   []string{"-selector", "foo:bar"},
+			expErr: "Error: an agent ID is required\n",
+		},
+		{
+			name:   "Missing selectors",
+			args:   []string{"-agentID", "spiffe://example.org/spire/agent/foo"},
+			expErr: "Error: at least one selector is required\n",
+		},
+		{
+			name:   "Invalid agent ID",
+			args:   []string{"-agentID", "invalid-id", "-selector", "foo:bar"},
+			expErr: "Error: error parsing agent ID \"invalid-id\": spiffeid: invalid scheme\n",
+		},
+		{
+			name:   "Invalid selector",
+			args:   []string{"-agentID", "spiffe://example.org/spire/agent/foo", "-selector", "foo"},
+			expErr: "Error: error parsing selectors: selector \"foo\" must be formatted as type:value\n",
+		},
+		{
+			name: "Server error",
+			args: []string{"-agentID", "spiffe://example.org/spire/agent/foo", "-selector", "foo:bar"},
+			expReq: &entryv1.GetMatchingEntriesRequest{
+				AgentId:   agentID,
+				Selectors: []*types.Selector{{Type: "foo", Value: "bar"}},
+			},
+			serverErr: status.Error(codes.Internal, "internal server error"),
+			expErr:    "Error: error fetching matching entries: rpc error: code = Internal desc = internal server error\n",
+		},
+		{
+			name: "No matching entries",
+			args: []string{"-agentID", "spiffe://example.org/spire/agent/foo", "-selector", "foo:bar"},
+			expReq: &entryv1.GetMatchingEntriesRequest{
+				AgentId:   agentID,
+				Selectors: []*types.Selector{{Type: "foo", Value: "bar"}},
+			},
+			fakeResp: &entryv1.GetMatchingEntriesResponse{},
+			expOut:   "Found 0 entries\n",
+		},
+		{
+			name: "Matching entries",
+			args: []string{"-agentID", "spiffe://example.org/spire/agent/foo", "-selector", "foo:bar", "-selector", "bar:baz"},
+			expReq: &entryv1.GetMatchingEntriesRequest{
+				AgentId: agentID,
+				Selectors: []*types.Selector{
+					{Type: "foo", Value: "bar"},
+					{Type: "bar", Value: "baz"},
+				},
+			},
+			fakeResp: &entryv1.GetMatchingEntriesResponse{
+				Entries: getEntries(2),
+			},
+			expOut: fmt.Sprintf("Found 2 entries\n%s%s",
+				getPrintedEntry(1),
+				getPrintedEntry(0),
+			),
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupTest(t, newMatchCommand)
+			test.server.err = tt.serverErr
+			test.server.expGetMatchingEntriesReq = tt.expReq
+			test.server.getMatchingEntriesResp = tt.fakeResp
+
+			args := append(test.args, tt.args...)
+			rc := test.client.Run(args)
+			if tt.expErr != "" {
+				require.Equal(t, 1, rc)
+				require.Equal(t, tt.expErr, test.stderr.String())
+				return
+			}
+
+			require.Equal(t, 0, rc)
+			require.Equal(t, tt.expOut, test.stdout.String())
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"time"
 
 	"github.com/mitchellh/cli"
 	entryv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/entry/v1"
@@ -49,6 +50,14 @@ type showCommand struct {
 
 	// Match used when filtering by selectors
 	matchSelectorsOn string
+
+	// Only show the entries that expire within this duration
+	expiringWithin time.Duration
+
+	// Only show the entries that have all these labels, formatted as name=value
+	labels StringsFlag
+
+	printer common_cli.Printer
 }
 
 func (c *showCommand) Name() string {
@@ -61,13 +70,16 @@ func (*showCommand) Synopsis() string {
 
 func (c *showCommand) AppendFlags(f *flag.FlagSet) {
 	f.StringVar(&c.entryID, "entryID", "", "The Entry ID of the records to show")
+	f.DurationVar(&c.expiringWithin, "expiringWithin", 0, "Only show the entries that expire within the given duration (e.g. 24h). Entries that already expired are included")
 	f.StringVar(&c.parentID, "parentID", "", "The Parent ID of the records to show")
 	f.StringVar(&c.spiffeID, "spiffeID", "", "The SPIFFE ID of the records to show")
 	f.BoolVar(&c.downstream, "downstream", false, "A boolean value that, when set, indicates that the entry describes a downstream SPIRE server")
 	f.Var(&c.selectors, "selector", "A colon-delimited type:value selector. Can be used more than once")
 	f.Var(&c.federatesWith, "federatesWith", "SPIFFE ID of a trust domain an entry is federate with. Can be used more than once")
+	f.Var(&c.labels, "label", "A name=value label the entries must have. Can be used more than once")
 	f.StringVar(&c.matchFederatesWithOn, "matchFederatesWithOn", "superset", "The match mode used when filtering by federates with. Options: exact, any, superset and subset")
 	f.StringVar(&c.matchSelectorsOn, "matchSelectorsOn", "superset", "The match mode used when filtering by selectors. Options: exact, any, superset and subset")
+	c.printer.AppendFlag(f)
 }
 
 // Run executes all logic associated with a single invocation of the
@@ -82,20 +94,30 @@ func (c *showCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 		return err
 	}
 
+	if c.expiringWithin > 0 {
+		entries = filterByExpiresBefore(entries, time.Now().Add(c.expiringWithin))
+	}
+
 	commonutil.SortTypesEntries(entries)
-	printEntries(entries, env)
-	return nil
+	return c.printer.PrintProto(env, &entryv1.ListEntriesResponse{Entries: entries}, func() error {
+		printEntries(entries, env)
+		return nil
+	})
 }
 
 // validate ensures that the values in showCommand are valid
 func (c *showCommand) validate() error {
 	// If entryID is given, it should be the only constraint
 	if c.entryID != "" {
-		if c.parentID != "" || c.spiffeID != "" || len(c.selectors) > 0 {
+		if c.parentID != "" || c.spiffeID != "" || len(c.selectors) > 0 || len(c.labels) > 0 || c.expiringWithin != 0 {
 			return errors.New("the -entryID flag can't be combined with others")
 		}
 	}
 
+	if c.expiringWithin < 0 {
+		return errors.New("the -expiringWithin flag must be a positive duration")
+	}
+
 	return nil
 }
 
@@ -158,6 +180,14 @@ func (c *showCommand) fetchEntries(ctx context.Context, client entryv1.EntryClie
 		}
 	}
 
+	if len(c.labels) > 0 {
+		labels, err := parseLabels(c.labels)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing labels: %w", err)
+		}
+		filter.ByLabels = labels
+	}
+
 	resp, err := client.ListEntries(ctx, &entryv1.ListEntriesRequest{
 		Filter: filter,
 	})
@@ -178,6 +208,19 @@ func (c *showCommand) fetchByEntryID(ctx context.Context, id string, client entr
 	return entry, nil
 }
 
+// filterByExpiresBefore returns the entries that have an expiry before the
+// given time. The entry API has no filter for it, so it is applied on the
+// listed entries.
+func filterByExpiresBefore(entries []*types.Entry, expiresBefore time.Time) []*types.Entry {
+	var filtered []*types.Entry
+	for _, entry := range entries {
+		if entry.ExpiresAt != 0 && entry.ExpiresAt < expiresBefore.Unix() {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
+
 func printEntries(entries []*types.Entry, env *common_cli.Env) {
 	msg := fmt.Sprintf("Found %v ", len(entries))
 	msg = util.Pluralizer(msg, "entry", "entries", len(entries))
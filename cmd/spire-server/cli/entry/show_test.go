@@ -21,12 +21,18 @@ func TestShowHelp(t *testing.T) {
     	A boolean value that, when set, indicates that the entry describes a downstream SPIRE server
   -entryID string
     	The Entry ID of the records to show
+  -expiringWithin duration
+    	Only show the entries that expire within the given duration (e.g. 24h). Entries that already expired are included
   -federatesWith value
     	SPIFFE ID of a trust domain an entry is federate with. Can be used more than once
+  -label value
+    	A name=value label the entries must have. Can be used more than once
   -matchFederatesWithOn string
     	The match mode used when filtering by federates with. Options: exact, any, superset and subset (default "superset")
   -matchSelectorsOn string
     	The match mode used when filtering by selectors. Options: exact, any, superset and subset (default "superset")
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
   -parentID string
     	The Parent ID of the records to show
   -selector value
@@ -95,6 +101,24 @@ func TestShow(t *testing.T) {
 			fakeGetResp: getEntries(1)[0],
 			expOut:      fmt.Sprintf("Found 1 entry\n%s", getPrintedEntry(0)),
 		},
+		{
+			name:        "List by entry ID with yaml output",
+			args:        []string{"-entryID", getEntries(1)[0].Id, "-output", "yaml"},
+			expGetReq:   &entryv1.GetEntryRequest{Id: getEntries(1)[0].Id},
+			fakeGetResp: getEntries(1)[0],
+			expOut: `entries:
+- id: 00000000-0000-0000-0000-000000000000
+  parent_id:
+    path: /father
+    trust_domain: example.org
+  selectors:
+  - type: foo
+    value: bar
+  spiffe_id:
+    path: /son
+    trust_domain: example.org
+`,
+		},
 		{
 			name:      "List by entry ID not found",
 			args:      []string{"-entryID", "non-existent-id"},
@@ -145,6 +169,29 @@ func TestShow(t *testing.T) {
 			args:   []string{"-spiffeID", "invalid-id"},
 			expErr: "Error: error parsing SPIFFE ID \"invalid-id\": spiffeid: invalid scheme\n",
 		},
+		{
+			name: "List by labels",
+			args: []string{"-label", "team=payments", "-label", "env=prod"},
+			expListReq: &entryv1.ListEntriesRequest{
+				Filter: &entryv1.ListEntriesRequest_Filter{
+					ByLabels: map[string]string{"team": "payments", "env": "prod"},
+				},
+			},
+			fakeListResp: fakeRespFatherDaughter,
+			expOut: fmt.Sprintf("Found 1 entry\n%s",
+				getPrintedEntry(1),
+			),
+		},
+		{
+			name:   "List by malformed label",
+			args:   []string{"-label", "team"},
+			expErr: "Error: error parsing labels: label \"team\" must be formatted as name=value\n",
+		},
+		{
+			name:   "List by entry ID and labels",
+			args:   []string{"-entryID", "entry-id", "-label", "team=payments"},
+			expErr: "Error: the -entryID flag can't be combined with others\n",
+		},
 		{
 			name: "List by selectors: default matcher",
 			args: []string{"-selector", "foo:bar", "-selector", "bar:baz"},
@@ -341,6 +388,27 @@ func TestShow(t *testing.T) {
 				getPrintedEntry(2),
 			),
 		},
+		{
+			name: "List by expiring within",
+			args: []string{"-expiringWithin", "24h"},
+			expListReq: &entryv1.ListEntriesRequest{
+				Filter: &entryv1.ListEntriesRequest_Filter{},
+			},
+			fakeListResp: fakeRespAll,
+			expOut: fmt.Sprintf("Found 1 entry\n%s",
+				getPrintedEntry(3),
+			),
+		},
+		{
+			name:   "List by expiring within using negative duration",
+			args:   []string{"-expiringWithin", "-1h"},
+			expErr: "Error: the -expiringWithin flag must be a positive duration\n",
+		},
+		{
+			name:   "List by entry ID and expiring within",
+			args:   []string{"-entryID", "entry-id", "-expiringWithin", "24h"},
+			expErr: "Error: the -entryID flag can't be combined with others\n",
+		},
 		{
 			name:   "List by Federates With: Invalid matcher",
 			args:   []string{"-federatesWith", "spiffe://domain.test", "-matchFederatesWithOn", "NO-MATCHER"},
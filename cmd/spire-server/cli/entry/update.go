@@ -10,6 +10,7 @@ import (
 	"github.com/spiffe/spire/cmd/spire-server/util"
 	common_cli "github.com/spiffe/spire/pkg/common/cli"
 	"github.com/spiffe/spire/pkg/common/idutil"
+	"github.com/spiffe/spire/pkg/server/api"
 	"google.golang.org/grpc/codes"
 
 	"golang.org/x/net/context"
@@ -48,6 +49,9 @@ type updateCommand struct {
 	// TTL for certificates issued to this workload
 	ttl int
 
+	// TTL for JWT-SVIDs issued to this workload
+	jwtSVIDTTL int
+
 	// List of SPIFFE IDs of trust domains the registration entry is federated with
 	federatesWith StringsFlag
 
@@ -62,6 +66,17 @@ type updateCommand struct {
 
 	// storeSVID determines if the issued SVID must be stored through an SVIDStore plugin
 	storeSVID bool
+
+	// Labels to attach to the entry, formatted as name=value
+	labels StringsFlag
+
+	// Revision number the entry must have for the update to succeed
+	expectedRevision Int64Flag
+
+	// Whether or not to only report what would be updated
+	dryRun bool
+
+	printer common_cli.Printer
 }
 
 func (*updateCommand) Name() string {
@@ -77,6 +92,7 @@ func (c *updateCommand) AppendFlags(f *flag.FlagSet) {
 	f.StringVar(&c.parentID, "parentID", "", "The SPIFFE ID of this record's parent")
 	f.StringVar(&c.spiffeID, "spiffeID", "", "The SPIFFE ID that this record represents")
 	f.IntVar(&c.ttl, "ttl", 0, "The lifetime, in seconds, for SVIDs issued based on this registration entry")
+	f.IntVar(&c.jwtSVIDTTL, "jwtSVIDTTL", 0, "The lifetime, in seconds, for JWT-SVIDs issued based on this registration entry. The ttl is used if unset")
 	f.StringVar(&c.path, "data", "", "Path to a file containing registration JSON (optional). If set to '-', read the JSON from stdin.")
 	f.Var(&c.selectors, "selector", "A colon-delimited type:value selector. Can be used more than once")
 	f.Var(&c.federatesWith, "federatesWith", "SPIFFE ID of a trust domain to federate with. Can be used more than once")
@@ -85,6 +101,10 @@ func (c *updateCommand) AppendFlags(f *flag.FlagSet) {
 	f.BoolVar(&c.storeSVID, "storeSVID", false, "A boolean value that, when set, indicates that the resulting issued SVID from this entry must be stored through an SVIDStore plugin")
 	f.Int64Var(&c.entryExpiry, "entryExpiry", 0, "An expiry, from epoch in seconds, for the resulting registration entry to be pruned")
 	f.Var(&c.dnsNames, "dns", "A DNS name that will be included in SVIDs issued based on this entry, where appropriate. Can be used more than once")
+	f.Var(&c.labels, "label", "A name=value label to attach to the entry. Can be used more than once")
+	f.Var(&c.expectedRevision, "expectedRevision", "The revision number the entry is expected to have. If set, the entry is only updated if it has not changed since that revision")
+	f.BoolVar(&c.dryRun, "dryRun", false, "If set, the update is validated and the agents that would receive or lose the entry are reported, but it is not updated")
+	c.printer.AppendFlag(f)
 }
 
 func (c *updateCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
@@ -103,22 +123,40 @@ func (c *updateCommand) Run(ctx context.Context, env *common_cli.Env, serverClie
 		return err
 	}
 
-	succeeded, failed, err := updateEntries(ctx, serverClient.NewEntryClient(), entries)
+	if c.dryRun {
+		ctx = api.WithDryRun(ctx)
+	}
+
+	var expectedRevisionNumbers map[string]int64
+	if c.expectedRevision.IsSet {
+		expectedRevisionNumbers = map[string]int64{c.entryID: c.expectedRevision.Value}
+	}
+
+	resp, succeeded, failed, err := updateEntries(ctx, serverClient.NewEntryClient(), entries, expectedRevisionNumbers)
 	if err != nil {
 		return err
 	}
 
-	// Print entries that succeeded to be updated
-	for _, e := range succeeded {
-		printEntry(e.Entry, env.Printf)
-	}
+	if err := c.printer.PrintProto(env, resp, func() error {
+		// Print entries that succeeded to be updated
+		for _, e := range succeeded {
+			if c.dryRun {
+				env.Printf("Would update the following entry (%s):\n", e.Status.Message)
+			}
+			printEntry(e.Entry, env.Printf)
+		}
 
-	// Print entries that failed to be updated
-	for _, r := range failed {
-		env.ErrPrintf("Failed to update the following entry (code: %s, msg: %q):\n",
-			codes.Code(r.Status.Code),
-			r.Status.Message)
-		printEntry(r.Entry, env.ErrPrintf)
+		// Print entries that failed to be updated
+		for _, r := range failed {
+			env.ErrPrintf("Failed to update the following entry (code: %s, msg: %q):\n",
+				codes.Code(r.Status.Code),
+				r.Status.Message)
+			printEntry(r.Entry, env.ErrPrintf)
+		}
+
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	if len(failed) > 0 {
@@ -133,6 +171,9 @@ func (c *updateCommand) Run(ctx context.Context, env *common_cli.Env, serverClie
 func (c *updateCommand) validate() (err error) {
 	// If a path is set, we have all we need
 	if c.path != "" {
+		if c.expectedRevision.IsSet {
+			return errors.New("an expected revision cannot be used with a data file")
+		}
 		return nil
 	}
 
@@ -156,6 +197,10 @@ func (c *updateCommand) validate() (err error) {
 		return errors.New("a positive TTL is required")
 	}
 
+	if c.jwtSVIDTTL < 0 {
+		return errors.New("a positive JWT-SVID TTL is required")
+	}
+
 	// make sure all SPIFFE ID's are well formed
 	c.spiffeID, err = idutil.NormalizeSpiffeID(c.spiffeID, idutil.AllowAny())
 	if err != nil {
@@ -191,6 +236,7 @@ func (c *updateCommand) parseConfig() ([]*types.Entry, error) {
 		ParentId:   parentID,
 		SpiffeId:   spiffeID,
 		Ttl:        int32(c.ttl),
+		JwtSvidTtl: int32(c.jwtSVIDTTL),
 		Downstream: c.downstream,
 		ExpiresAt:  c.entryExpiry,
 		DnsNames:   c.dnsNames,
@@ -206,19 +252,26 @@ func (c *updateCommand) parseConfig() ([]*types.Entry, error) {
 		selectors = append(selectors, cs)
 	}
 
+	labels, err := parseLabels(c.labels)
+	if err != nil {
+		return nil, err
+	}
+
 	e.Selectors = selectors
+	e.Labels = labels
 	e.FederatesWith = c.federatesWith
 	e.Admin = c.admin
 	e.StoreSvid = c.storeSVID
 	return []*types.Entry{e}, nil
 }
 
-func updateEntries(ctx context.Context, c entryv1.EntryClient, entries []*types.Entry) (succeeded, failed []*entryv1.BatchUpdateEntryResponse_Result, err error) {
-	resp, err := c.BatchUpdateEntry(ctx, &entryv1.BatchUpdateEntryRequest{
-		Entries: entries,
+func updateEntries(ctx context.Context, c entryv1.EntryClient, entries []*types.Entry, expectedRevisionNumbers map[string]int64) (resp *entryv1.BatchUpdateEntryResponse, succeeded, failed []*entryv1.BatchUpdateEntryResponse_Result, err error) {
+	resp, err = c.BatchUpdateEntry(ctx, &entryv1.BatchUpdateEntryRequest{
+		Entries:                 entries,
+		ExpectedRevisionNumbers: expectedRevisionNumbers,
 	})
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	for i, r := range resp.Results {
@@ -233,5 +286,5 @@ func updateEntries(ctx context.Context, c entryv1.EntryClient, entries []*types.
 		}
 	}
 
-	return succeeded, failed, nil
+	return resp, succeeded, failed, nil
 }
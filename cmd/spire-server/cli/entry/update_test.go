@@ -25,12 +25,22 @@ func TestUpdateHelp(t *testing.T) {
     	A DNS name that will be included in SVIDs issued based on this entry, where appropriate. Can be used more than once
   -downstream
     	A boolean value that, when set, indicates that the entry describes a downstream SPIRE server
+  -dryRun
+    	If set, the update is validated and the agents that would receive or lose the entry are reported, but it is not updated
   -entryExpiry int
     	An expiry, from epoch in seconds, for the resulting registration entry to be pruned
   -entryID string
     	The Registration Entry ID of the record to update
+  -expectedRevision value
+    	The revision number the entry is expected to have. If set, the entry is only updated if it has not changed since that revision
   -federatesWith value
     	SPIFFE ID of a trust domain to federate with. Can be used more than once
+  -jwtSVIDTTL int
+    	The lifetime, in seconds, for JWT-SVIDs issued based on this registration entry. The ttl is used if unset
+  -label value
+    	A name=value label to attach to the entry. Can be used more than once
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
   -parentID string
     	The SPIFFE ID of this record's parent
   -selector value
@@ -61,10 +71,12 @@ func TestUpdate(t *testing.T) {
 			{Type: "alpha", Value: "alpha:2000"},
 		},
 		Ttl:           60,
+		JwtSvidTtl:    30,
 		FederatesWith: []string{"spiffe://domaina.test", "spiffe://domainb.test"},
 		Admin:         true,
 		ExpiresAt:     1552410266,
 		DnsNames:      []string{"unu1000", "ung1000"},
+		Labels:        map[string]string{"team": "payments", "env": "prod"},
 		Downstream:    true,
 	}
 
@@ -159,8 +171,9 @@ func TestUpdate(t *testing.T) {
 		fakeResp  *entryv1.BatchUpdateEntryResponse
 		serverErr error
 
-		expOut string
-		expErr string
+		expOut    string
+		expErr    string
+		expDryRun bool
 	}{
 		{
 			name:   "Missing Entry ID",
@@ -201,6 +214,21 @@ func TestUpdate(t *testing.T) {
 			args:   []string{"-entryID", "entry-id", "-selector", "unix", "-parentID", "spiffe://example.org/parent", "-spiffeID", "spiffe://example.org/workload", "-ttl", "-10"},
 			expErr: "Error: a positive TTL is required\n",
 		},
+		{
+			name:   "Negative JWT-SVID TTL",
+			args:   []string{"-entryID", "entry-id", "-selector", "unix", "-parentID", "spiffe://example.org/parent", "-spiffeID", "spiffe://example.org/workload", "-jwtSVIDTTL", "-10"},
+			expErr: "Error: a positive JWT-SVID TTL is required\n",
+		},
+		{
+			name:   "Malformed label",
+			args:   []string{"-entryID", "entry-id", "-selector", "unix:uid:1", "-parentID", "spiffe://example.org/parent", "-spiffeID", "spiffe://example.org/workload", "-label", "team"},
+			expErr: "Error: label \"team\" must be formatted as name=value\n",
+		},
+		{
+			name:   "Expected revision with data file",
+			args:   []string{"-data", "../../../../test/fixture/registration/good-for-update.json", "-expectedRevision", "1"},
+			expErr: "Error: an expected revision cannot be used with a data file\n",
+		},
 		{
 			name:   "Wrong federated trust domain",
 			args:   []string{"-entryID", "entry-id", "-selector", "unix", "-spiffeID", "spiffe://example.org/workload", "-parentID", "spiffe://example.org/parent", "-federatesWith", "invalid-id"},
@@ -229,12 +257,15 @@ func TestUpdate(t *testing.T) {
 				"-selector", "zebra:zebra:2000",
 				"-selector", "alpha:alpha:2000",
 				"-ttl", "60",
+				"-jwtSVIDTTL", "30",
 				"-federatesWith", "spiffe://domainA.test",
 				"-federatesWith", "spiffe://domainB.test",
 				"-admin",
 				"-entryExpiry", "1552410266",
 				"-dns", "unu1000",
 				"-dns", "ung1000",
+				"-label", "team=payments",
+				"-label", "env=prod",
 				"-downstream",
 			},
 			expReq: &entryv1.BatchUpdateEntryRequest{
@@ -247,6 +278,7 @@ Parent ID        : spiffe://example.org/parent
 Revision         : 0
 Downstream       : true
 TTL              : 60
+JWT-SVID TTL     : 30
 Expiration time  : %s
 Selector         : zebra:zebra:2000
 Selector         : alpha:alpha:2000
@@ -254,9 +286,57 @@ FederatesWith    : spiffe://domaina.test
 FederatesWith    : spiffe://domainb.test
 DNS name         : unu1000
 DNS name         : ung1000
+Label            : env=prod
+Label            : team=payments
 Admin            : true
 
 `, time.Unix(1552410266, 0).UTC()),
+		},
+		{
+			name: "Dry run",
+			args: []string{
+				"-entryID", "entry-id",
+				"-spiffeID", "spiffe://example.org/workload",
+				"-parentID", "spiffe://example.org/parent",
+				"-selector", "unix:uid:1",
+				"-dryRun",
+			},
+			expReq: &entryv1.BatchUpdateEntryRequest{
+				Entries: []*types.Entry{
+					{
+						Id:        "entry-id",
+						SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/workload"},
+						ParentId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/parent"},
+						Selectors: []*types.Selector{{Type: "unix", Value: "uid:1"}},
+					},
+				},
+			},
+			fakeResp: &entryv1.BatchUpdateEntryResponse{
+				Results: []*entryv1.BatchUpdateEntryResponse_Result{
+					{
+						Entry: &types.Entry{
+							Id:        "entry-id",
+							SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/workload"},
+							ParentId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/parent"},
+							Selectors: []*types.Selector{{Type: "unix", Value: "uid:1"}},
+						},
+						Status: &types.Status{
+							Code:    int32(codes.OK),
+							Message: "dry run; receiving agents: spiffe://example.org/parent; losing agents: spiffe://example.org/old-parent",
+						},
+					},
+				},
+			},
+			expDryRun: true,
+			expOut: `Would update the following entry (dry run; receiving agents: spiffe://example.org/parent; losing agents: spiffe://example.org/old-parent):
+Entry ID         : entry-id
+SPIFFE ID        : spiffe://example.org/workload
+Parent ID        : spiffe://example.org/parent
+Revision         : 0
+TTL              : default
+Selector         : unix:uid:1
+
+`,
 		},
 		{
 			name: "Update succeeds using command line arguments Store Svid",
@@ -337,6 +417,41 @@ Selector         : type:key1:value
 Selector         : type:key2:value
 StoreSvid        : true
 
+`,
+		},
+		{
+			name: "Entry was updated concurrently",
+			args: []string{"-entryID", "entry-id", "-spiffeID", "spiffe://example.org/workload", "-parentID", "spiffe://example.org/parent", "-selector", "unix:uid:1", "-expectedRevision", "0"},
+			expReq: &entryv1.BatchUpdateEntryRequest{
+				Entries: []*types.Entry{
+					{
+						Id:        "entry-id",
+						SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/workload"},
+						ParentId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/parent"},
+						Selectors: []*types.Selector{{Type: "unix", Value: "uid:1"}},
+					},
+				},
+				ExpectedRevisionNumbers: map[string]int64{"entry-id": 0},
+			},
+			fakeResp: &entryv1.BatchUpdateEntryResponse{
+				Results: []*entryv1.BatchUpdateEntryResponse_Result{
+					{
+						Status: &types.Status{
+							Code:    int32(codes.Aborted),
+							Message: "entry was updated concurrently: expected 0, found 1",
+						},
+					},
+				},
+			},
+			expErr: `Failed to update the following entry (code: Aborted, msg: "entry was updated concurrently: expected 0, found 1"):
+Entry ID         : entry-id
+SPIFFE ID        : spiffe://example.org/workload
+Parent ID        : spiffe://example.org/parent
+Revision         : 0
+TTL              : default
+Selector         : unix:uid:1
+
+Error: failed to update one or more entries
 `,
 		},
 		{
@@ -369,6 +484,7 @@ Error: failed to update one or more entries
 			test.server.err = tt.serverErr
 			test.server.expBatchUpdateEntryReq = tt.expReq
 			test.server.batchUpdateEntryResp = tt.fakeResp
+			test.server.expDryRun = tt.expDryRun
 
 			args := append(test.args, tt.args...)
 			rc := test.client.Run(args)
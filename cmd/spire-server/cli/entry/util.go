@@ -2,9 +2,13 @@ package entry
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
@@ -29,6 +33,10 @@ func printEntry(e *types.Entry, printf func(string, ...interface{}) error) {
 		_ = printf("TTL              : %d\n", e.Ttl)
 	}
 
+	if e.JwtSvidTtl != 0 {
+		_ = printf("JWT-SVID TTL     : %d\n", e.JwtSvidTtl)
+	}
+
 	if e.ExpiresAt != 0 {
 		_ = printf("Expiration time  : %s\n", time.Unix(e.ExpiresAt, 0).UTC())
 	}
@@ -43,6 +51,15 @@ func printEntry(e *types.Entry, printf func(string, ...interface{}) error) {
 		_ = printf("DNS name         : %s\n", dnsName)
 	}
 
+	labelNames := make([]string, 0, len(e.Labels))
+	for name := range e.Labels {
+		labelNames = append(labelNames, name)
+	}
+	sort.Strings(labelNames)
+	for _, name := range labelNames {
+		_ = printf("Label            : %s=%s\n", name, e.Labels[name])
+	}
+
 	// admin is rare, so only show admin if true to keep
 	// from muddying the output.
 	if e.Admin {
@@ -92,17 +109,7 @@ func parseFile(path string) ([]*types.Entry, error) {
 func parseEntryJSON(in io.Reader, path string) ([]*types.Entry, error) {
 	entries := &common.RegistrationEntries{}
 
-	r := in
-	if path != "-" {
-		f, err := os.Open(path)
-		if err != nil {
-			return nil, err
-		}
-		defer f.Close()
-		r = f
-	}
-
-	dat, err := io.ReadAll(r)
+	dat, err := readInput(in, path)
 	if err != nil {
 		return nil, err
 	}
@@ -113,6 +120,34 @@ func parseEntryJSON(in io.Reader, path string) ([]*types.Entry, error) {
 	return api.RegistrationEntriesToProto(entries.Entries)
 }
 
+// readInput reads the file at path, or in if path is "-"
+func readInput(in io.Reader, path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(in)
+	}
+	return os.ReadFile(path)
+}
+
+// parseLabels parses the given name=value pairs into a label map
+func parseLabels(pairs []string) (map[string]string, error) {
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+
+	labels := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("label %q must be formatted as name=value", pair)
+		}
+		if parts[0] == "" {
+			return nil, errors.New("label name cannot be empty")
+		}
+		labels[parts[0]] = parts[1]
+	}
+	return labels, nil
+}
+
 // StringsFlag defines a custom type for string lists. Doing
 // this allows us to support repeatable string flags.
 type StringsFlag []string
@@ -127,3 +162,29 @@ func (s *StringsFlag) Set(val string) error {
 	*s = append(*s, val)
 	return nil
 }
+
+// Int64Flag defines a custom type for int64 flags that records
+// whether it was set, since zero is a meaningful value.
+type Int64Flag struct {
+	Value int64
+	IsSet bool
+}
+
+// String returns the int64 flag.
+func (f *Int64Flag) String() string {
+	if !f.IsSet {
+		return ""
+	}
+	return strconv.FormatInt(f.Value, 10)
+}
+
+// Set parses and sets the int64 flag.
+func (f *Int64Flag) Set(val string) error {
+	v, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return err
+	}
+	f.Value = v
+	f.IsSet = true
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	entryv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/entry/v1"
 	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
 	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/pkg/server/api"
 	"github.com/spiffe/spire/test/spiretest"
 	"github.com/spiffe/spire/test/util"
 	"github.com/stretchr/testify/assert"
@@ -150,8 +151,9 @@ func (e *entryTest) afterTest(t *testing.T) {
 type fakeEntryServer struct {
 	*entryv1.UnimplementedEntryServer
 
-	t   *testing.T
-	err error
+	t         *testing.T
+	err       error
+	expDryRun bool
 
 	expGetEntryReq         *entryv1.GetEntryRequest
 	expListEntriesReq      *entryv1.ListEntriesRequest
@@ -159,12 +161,16 @@ type fakeEntryServer struct {
 	expBatchCreateEntryReq *entryv1.BatchCreateEntryRequest
 	expBatchUpdateEntryReq *entryv1.BatchUpdateEntryRequest
 
+	expGetMatchingEntriesReq *entryv1.GetMatchingEntriesRequest
+
 	getEntryResp         *types.Entry
 	countEntriesResp     *entryv1.CountEntriesResponse
 	listEntriesResp      *entryv1.ListEntriesResponse
 	batchDeleteEntryResp *entryv1.BatchDeleteEntryResponse
 	batchCreateEntryResp *entryv1.BatchCreateEntryResponse
 	batchUpdateEntryResp *entryv1.BatchUpdateEntryResponse
+
+	getMatchingEntriesResp *entryv1.GetMatchingEntriesResponse
 }
 
 func (f fakeEntryServer) CountEntries(ctx context.Context, req *entryv1.CountEntriesRequest) (*entryv1.CountEntriesResponse, error) {
@@ -195,6 +201,7 @@ func (f fakeEntryServer) BatchDeleteEntry(ctx context.Context, req *entryv1.Batc
 		return nil, f.err
 	}
 	spiretest.AssertProtoEqual(f.t, f.expBatchDeleteEntryReq, req)
+	assert.Equal(f.t, f.expDryRun, api.IsDryRun(ctx))
 	return f.batchDeleteEntryResp, nil
 }
 
@@ -203,6 +210,7 @@ func (f fakeEntryServer) BatchCreateEntry(ctx context.Context, req *entryv1.Batc
 		return nil, f.err
 	}
 	spiretest.AssertProtoEqual(f.t, f.expBatchCreateEntryReq, req)
+	assert.Equal(f.t, f.expDryRun, api.IsDryRun(ctx))
 	return f.batchCreateEntryResp, nil
 }
 
@@ -211,9 +219,18 @@ func (f fakeEntryServer) BatchUpdateEntry(ctx context.Context, req *entryv1.Batc
 		return nil, f.err
 	}
 	spiretest.AssertProtoEqual(f.t, f.expBatchUpdateEntryReq, req)
+	assert.Equal(f.t, f.expDryRun, api.IsDryRun(ctx))
 	return f.batchUpdateEntryResp, nil
 }
 
+func (f fakeEntryServer) GetMatchingEntries(ctx context.Context, req *entryv1.GetMatchingEntriesRequest) (*entryv1.GetMatchingEntriesResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	spiretest.AssertProtoEqual(f.t, f.expGetMatchingEntriesReq, req)
+	return f.getMatchingEntriesResp, nil
+}
+
 func setupTest(t *testing.T, newClient func(*common_cli.Env) cli.Command) *entryTest {
 	stdin := new(bytes.Buffer)
 	stdout := new(bytes.Buffer)
@@ -25,6 +25,8 @@ func newDeleteCommand(env *common_cli.Env) cli.Command {
 type deleteCommand struct {
 	// SPIFFE ID of the trust domain to delete
 	id string
+
+	printer common_cli.Printer
 }
 
 func (c *deleteCommand) Name() string {
@@ -37,6 +39,7 @@ func (c *deleteCommand) Synopsis() string {
 
 func (c *deleteCommand) AppendFlags(fs *flag.FlagSet) {
 	fs.StringVar(&c.id, "id", "", "SPIFFE ID of the trust domain")
+	c.printer.AppendFlag(fs)
 }
 
 func (c *deleteCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
@@ -60,8 +63,9 @@ func (c *deleteCommand) Run(ctx context.Context, env *common_cli.Env, serverClie
 	result := resp.Results[0]
 	switch result.Status.Code {
 	case int32(codes.OK):
-		env.Println("federation relationship deleted.")
-		return nil
+		return c.printer.PrintProto(env, resp, func() error {
+			return env.Println("federation relationship deleted.")
+		})
 	default:
 		return fmt.Errorf("failed to delete federation relationship %q: %s", result.TrustDomain, result.Status.Message)
 	}
@@ -17,6 +17,8 @@ func TestDeleteHelp(t *testing.T) {
 	require.Equal(t, `Usage of federation delete:
   -id string
     	SPIFFE ID of the trust domain
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
   -socketPath string
     	Path to the SPIRE Server API socket (default "/tmp/spire-server/private/api.sock")
 `, test.stderr.String())
@@ -20,6 +20,7 @@ func newListCommand(env *common_cli.Env) cli.Command {
 }
 
 type listCommand struct {
+	printer common_cli.Printer
 }
 
 func (c *listCommand) Name() string {
@@ -31,6 +32,7 @@ func (c *listCommand) Synopsis() string {
 }
 
 func (c *listCommand) AppendFlags(fs *flag.FlagSet) {
+	c.printer.AppendFlag(fs)
 }
 
 func (c *listCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
@@ -41,14 +43,16 @@ func (c *listCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 		return fmt.Errorf("error listing federation relationship: %w", err)
 	}
 
-	msg := fmt.Sprintf("Found %v ", len(resp.FederationRelationships))
-	msg = util.Pluralizer(msg, "federation relationship", "federation relationships", len(resp.FederationRelationships))
+	return c.printer.PrintProto(env, resp, func() error {
+		msg := fmt.Sprintf("Found %v ", len(resp.FederationRelationships))
+		msg = util.Pluralizer(msg, "federation relationship", "federation relationships", len(resp.FederationRelationships))
 
-	env.Println(msg)
-	for _, fr := range resp.FederationRelationships {
-		env.Println()
-		printFederationRelationship(fr, env.Printf)
-	}
+		env.Println(msg)
+		for _, fr := range resp.FederationRelationships {
+			env.Println()
+			printFederationRelationship(fr, env.Printf)
+		}
 
-	return nil
+		return nil
+	})
 }
@@ -15,6 +15,8 @@ func TestListHelp(t *testing.T) {
 	test.client.Help()
 
 	require.Equal(t, `Usage of federation list:
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
   -socketPath string
     	Path to the SPIRE Server API socket (default "/tmp/spire-server/private/api.sock")
 `, test.stderr.String())
@@ -70,6 +72,19 @@ func TestList(t *testing.T) {
 			listResp:      &trustdomainv1.ListFederationRelationshipsResponse{},
 			expectOut:     "Found 0 federation relationships\n",
 		},
+		{
+			name:          "yaml output",
+			arg:           []string{"-output", "yaml"},
+			expectListReq: &trustdomainv1.ListFederationRelationshipsRequest{},
+			listResp: &trustdomainv1.ListFederationRelationshipsResponse{
+				FederationRelationships: []*types.FederationRelationship{federation1},
+			},
+			expectOut: `federation_relationships:
+- bundle_endpoint_url: https://foo.test/endpoint
+  https_web: {}
+  trust_domain: foh.test
+`,
+		},
 		{
 			name:          "single federation",
 			expectListReq: &trustdomainv1.ListFederationRelationshipsRequest{},
@@ -23,6 +23,8 @@ func newShowCommand(env *common_cli.Env) cli.Command {
 type showCommand struct {
 	// Trust domain name of the federation relationship to show
 	trustDomain string
+
+	printer common_cli.Printer
 }
 
 func (c *showCommand) Name() string {
@@ -35,6 +37,7 @@ func (c *showCommand) Synopsis() string {
 
 func (c *showCommand) AppendFlags(f *flag.FlagSet) {
 	f.StringVar(&c.trustDomain, "trustDomain", "", "The trust domain name of the federation relationship to show")
+	c.printer.AppendFlag(f)
 }
 
 func (c *showCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
@@ -51,8 +54,9 @@ func (c *showCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 		return fmt.Errorf("error showing federation relationship: %w", err)
 	}
 
-	env.Printf("Found a federation relationship with trust domain %s:\n\n", c.trustDomain)
-	printFederationRelationship(fr, env.Printf)
-
-	return nil
+	return c.printer.PrintProto(env, fr, func() error {
+		env.Printf("Found a federation relationship with trust domain %s:\n\n", c.trustDomain)
+		printFederationRelationship(fr, env.Printf)
+		return nil
+	})
 }
@@ -15,6 +15,8 @@ func TestShowHelp(t *testing.T) {
 	test.client.Help()
 
 	require.Equal(t, `Usage of federation show:
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
   -socketPath string
     	Path to the SPIRE Server API socket (default "/tmp/spire-server/private/api.sock")
   -trustDomain string
@@ -25,6 +25,8 @@ func newUpdateCommand(env *common_cli.Env) cli.Command {
 type updateCommand struct {
 	path   string
 	config *federationRelationshipConfig
+
+	printer common_cli.Printer
 }
 
 func (*updateCommand) Name() string {
@@ -39,6 +41,7 @@ func (c *updateCommand) AppendFlags(f *flag.FlagSet) {
 	f.StringVar(&c.path, "data", "", "Path to a file containing federation relationships in JSON format (optional). If set to '-', read the JSON from stdin.")
 	c.config = &federationRelationshipConfig{}
 	appendConfigFlags(c.config, f)
+	c.printer.AppendFlag(f)
 }
 
 func (c *updateCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
@@ -71,19 +74,24 @@ func (c *updateCommand) Run(ctx context.Context, env *common_cli.Env, serverClie
 		}
 	}
 
-	// Print federation relationships that succeeded to be updated
-	for _, r := range succeeded {
-		env.Println()
-		printFederationRelationship(r.FederationRelationship, env.Printf)
-	}
+	if err := c.printer.PrintProto(env, resp, func() error {
+		// Print federation relationships that succeeded to be updated
+		for _, r := range succeeded {
+			env.Println()
+			printFederationRelationship(r.FederationRelationship, env.Printf)
+		}
 
-	// Print federation relationships that failed to be updated
-	for _, r := range failed {
-		env.Println()
-		env.ErrPrintf("Failed to update the following federation relationship (code: %s, msg: %q):\n",
-			codes.Code(r.Status.Code),
-			r.Status.Message)
-		printFederationRelationship(r.FederationRelationship, env.ErrPrintf)
+		// Print federation relationships that failed to be updated
+		for _, r := range failed {
+			env.Println()
+			env.ErrPrintf("Failed to update the following federation relationship (code: %s, msg: %q):\n",
+				codes.Code(r.Status.Code),
+				r.Status.Message)
+			printFederationRelationship(r.FederationRelationship, env.ErrPrintf)
+		}
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	if len(failed) > 0 {
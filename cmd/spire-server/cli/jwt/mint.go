@@ -30,6 +30,8 @@ type mintCommand struct {
 	ttl      time.Duration
 	audience common_cli.StringsFlag
 	write    string
+
+	printer common_cli.Printer
 }
 
 func (c *mintCommand) Name() string {
@@ -44,6 +46,7 @@ func (c *mintCommand) AppendFlags(fs *flag.FlagSet) {
 	fs.DurationVar(&c.ttl, "ttl", 0, "TTL of the JWT-SVID")
 	fs.Var(&c.audience, "audience", "Audience claim that will be included in the SVID. Can be used more than once.")
 	fs.StringVar(&c.write, "write", "", "File to write token to instead of stdout")
+	c.printer.AppendFlag(fs)
 }
 
 func (c *mintCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
@@ -76,7 +79,9 @@ func (c *mintCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 
 	// Print in stdout
 	if c.write == "" {
-		return env.Println(token)
+		return c.printer.PrintProto(env, resp, func() error {
+			return env.Println(token)
+		})
 	}
 
 	// Save in file
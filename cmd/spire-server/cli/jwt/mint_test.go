@@ -37,6 +37,8 @@ const (
 	expectedUsage = `Usage of jwt mint:
   -audience value
     	Audience claim that will be included in the SVID. Can be used more than once.
+  -output format
+    	Desired output format of the results: text, json or yaml (default text)
   -socketPath string
     	Path to the SPIRE Server API socket (default "/tmp/spire-server/private/api.sock")
   -spiffeID string
@@ -0,0 +1,322 @@
+package state
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	agentv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/agent/v1"
+	bundlev1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/bundle/v1"
+	entryv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/entry/v1"
+	trustdomainv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/trustdomain/v1"
+	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
+	"github.com/spiffe/spire/cmd/spire-server/util"
+	"github.com/spiffe/spire/pkg/common/pemutil"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/cryptosigner"
+)
+
+const (
+	// archiveVersion is the version of the archive format written by
+	// export. Import rejects archives with any other version.
+	archiveVersion = 1
+
+	// archiveType is the value of the "typ" header of the signed archive
+	archiveType = "spire-state+json"
+
+	// pageSize is the page size used to list the server state
+	pageSize = 1000
+
+	// batchSize is the maximum number of items sent in a single batch
+	// request
+	batchSize = 50
+)
+
+// serverState is the datastore content that is exported and imported
+// through the server APIs
+type serverState struct {
+	bundle                  *types.Bundle
+	federatedBundles        []*types.Bundle
+	federationRelationships []*types.FederationRelationship
+	agents                  []*types.Agent
+	entries                 []*types.Entry
+}
+
+// archive is the signed payload of an exported state. The API messages
+// are stored in their protojson representation.
+type archive struct {
+	Version                 int               `json:"version"`
+	TrustDomain             string            `json:"trust_domain"`
+	ExportedAt              time.Time         `json:"exported_at"`
+	Bundle                  json.RawMessage   `json:"bundle"`
+	FederatedBundles        []json.RawMessage `json:"federated_bundles"`
+	FederationRelationships []json.RawMessage `json:"federation_relationships"`
+	Agents                  []json.RawMessage `json:"agents"`
+	Entries                 []json.RawMessage `json:"entries"`
+}
+
+// fetchState lists the current state of the server
+func fetchState(ctx context.Context, serverClient util.ServerClient) (*serverState, error) {
+	state := new(serverState)
+
+	bundleClient := serverClient.NewBundleClient()
+	bundle, err := bundleClient.GetBundle(ctx, &bundlev1.GetBundleRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching bundle: %w", err)
+	}
+	state.bundle = bundle
+
+	bundlesReq := &bundlev1.ListFederatedBundlesRequest{PageSize: pageSize}
+	for {
+		resp, err := bundleClient.ListFederatedBundles(ctx, bundlesReq)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching federated bundles: %w", err)
+		}
+		state.federatedBundles = append(state.federatedBundles, resp.Bundles...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		bundlesReq.PageToken = resp.NextPageToken
+	}
+
+	relationshipsReq := &trustdomainv1.ListFederationRelationshipsRequest{PageSize: pageSize}
+	for {
+		resp, err := serverClient.NewTrustDomainClient().ListFederationRelationships(ctx, relationshipsReq)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching federation relationships: %w", err)
+		}
+		state.federationRelationships = append(state.federationRelationships, resp.FederationRelationships...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		relationshipsReq.PageToken = resp.NextPageToken
+	}
+
+	agentsReq := &agentv1.ListAgentsRequest{PageSize: pageSize}
+	for {
+		resp, err := serverClient.NewAgentClient().ListAgents(ctx, agentsReq)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching agents: %w", err)
+		}
+		state.agents = append(state.agents, resp.Agents...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		agentsReq.PageToken = resp.NextPageToken
+	}
+
+	entriesReq := &entryv1.ListEntriesRequest{PageSize: pageSize}
+	for {
+		resp, err := serverClient.NewEntryClient().ListEntries(ctx, entriesReq)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching entries: %w", err)
+		}
+		state.entries = append(state.entries, resp.Entries...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		entriesReq.PageToken = resp.NextPageToken
+	}
+
+	return state, nil
+}
+
+// encodeArchive serializes the state and signs it with the given signer.
+// The result is a JWS in the JSON serialization.
+func encodeArchive(state *serverState, signer crypto.Signer, exportedAt time.Time) ([]byte, error) {
+	a := &archive{
+		Version:     archiveVersion,
+		TrustDomain: state.bundle.TrustDomain,
+		ExportedAt:  exportedAt.UTC(),
+	}
+
+	var err error
+	if a.Bundle, err = marshalMessage(state.bundle); err != nil {
+		return nil, err
+	}
+	for _, b := range state.federatedBundles {
+		if a.FederatedBundles, err = appendMessage(a.FederatedBundles, b); err != nil {
+			return nil, err
+		}
+	}
+	for _, fr := range state.federationRelationships {
+		if a.FederationRelationships, err = appendMessage(a.FederationRelationships, fr); err != nil {
+			return nil, err
+		}
+	}
+	for _, agent := range state.agents {
+		if a.Agents, err = appendMessage(a.Agents, agent); err != nil {
+			return nil, err
+		}
+	}
+	for _, e := range state.entries {
+		if a.Entries, err = appendMessage(a.Entries, e); err != nil {
+			return nil, err
+		}
+	}
+
+	payload, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+
+	alg, err := signatureAlgorithm(signer.Public())
+	if err != nil {
+		return nil, err
+	}
+	jwsSigner, err := jose.NewSigner(
+		jose.SigningKey{
+			Algorithm: alg,
+			Key:       cryptosigner.Opaque(signer),
+		},
+		new(jose.SignerOptions).WithType(archiveType),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signer: %w", err)
+	}
+
+	jws, err := jwsSigner.Sign(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign archive: %w", err)
+	}
+	return []byte(jws.FullSerialize() + "\n"), nil
+}
+
+// decodeArchive verifies the archive signature with the given public key
+// and parses the state it holds
+func decodeArchive(data []byte, publicKey crypto.PublicKey) (*archive, *serverState, error) {
+	jws, err := jose.ParseSigned(string(data))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse archive: %w", err)
+	}
+	if len(jws.Signatures) != 1 {
+		return nil, nil, fmt.Errorf("archive must have exactly one signature, found %d", len(jws.Signatures))
+	}
+
+	alg, err := signatureAlgorithm(publicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if header := jws.Signatures[0].Protected; header.Algorithm != string(alg) {
+		return nil, nil, fmt.Errorf("archive is signed with %q, expected %q", header.Algorithm, alg)
+	}
+
+	payload, err := jws.Verify(publicKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to verify archive signature: %w", err)
+	}
+
+	a := new(archive)
+	if err := json.Unmarshal(payload, a); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse archive: %w", err)
+	}
+	if a.Version != archiveVersion {
+		return nil, nil, fmt.Errorf("unsupported archive version %d; expected %d", a.Version, archiveVersion)
+	}
+
+	if len(a.Bundle) == 0 {
+		return nil, nil, errors.New("archive is missing the server bundle")
+	}
+	state := &serverState{bundle: new(types.Bundle)}
+	if err := unmarshalMessage(a.Bundle, state.bundle); err != nil {
+		return nil, nil, err
+	}
+	for _, raw := range a.FederatedBundles {
+		b := new(types.Bundle)
+		if err := unmarshalMessage(raw, b); err != nil {
+			return nil, nil, err
+		}
+		state.federatedBundles = append(state.federatedBundles, b)
+	}
+	for _, raw := range a.FederationRelationships {
+		fr := new(types.FederationRelationship)
+		if err := unmarshalMessage(raw, fr); err != nil {
+			return nil, nil, err
+		}
+		state.federationRelationships = append(state.federationRelationships, fr)
+	}
+	for _, raw := range a.Agents {
+		agent := new(types.Agent)
+		if err := unmarshalMessage(raw, agent); err != nil {
+			return nil, nil, err
+		}
+		state.agents = append(state.agents, agent)
+	}
+	for _, raw := range a.Entries {
+		e := new(types.Entry)
+		if err := unmarshalMessage(raw, e); err != nil {
+			return nil, nil, err
+		}
+		state.entries = append(state.entries, e)
+	}
+
+	return a, state, nil
+}
+
+// signatureAlgorithm determines the signature algorithm for a key, in the
+// same way JWT-SVIDs are signed
+func signatureAlgorithm(publicKey crypto.PublicKey) (jose.SignatureAlgorithm, error) {
+	switch publicKey := publicKey.(type) {
+	case *rsa.PublicKey:
+		// Prevent the use of keys smaller than 2048 bits
+		if publicKey.Size() < 256 {
+			return "", fmt.Errorf("unsupported RSA key size: %d", publicKey.Size())
+		}
+		return jose.RS256, nil
+	case *ecdsa.PublicKey:
+		switch publicKey.Params().BitSize {
+		case 256:
+			return jose.ES256, nil
+		case 384:
+			return jose.ES384, nil
+		default:
+			return "", fmt.Errorf("unable to determine signature algorithm for EC public key size %d", publicKey.Params().BitSize)
+		}
+	default:
+		return "", fmt.Errorf("unable to determine signature algorithm for public key type %T", publicKey)
+	}
+}
+
+// loadVerificationKey loads a PEM encoded public key, or the public key of
+// a PEM encoded certificate
+func loadVerificationKey(path string) (crypto.PublicKey, error) {
+	publicKey, keyErr := pemutil.LoadPublicKey(path)
+	if keyErr == nil {
+		return publicKey, nil
+	}
+	cert, certErr := pemutil.LoadCertificate(path)
+	if certErr == nil {
+		return cert.PublicKey, nil
+	}
+	return nil, fmt.Errorf("failed to load verification key: %v", keyErr)
+}
+
+func marshalMessage(msg proto.Message) (json.RawMessage, error) {
+	out, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", msg.ProtoReflect().Descriptor().Name(), err)
+	}
+	return out, nil
+}
+
+func appendMessage(raws []json.RawMessage, msg proto.Message) ([]json.RawMessage, error) {
+	raw, err := marshalMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+	return append(raws, raw), nil
+}
+
+func unmarshalMessage(raw json.RawMessage, msg proto.Message) error {
+	if err := protojson.Unmarshal(raw, msg); err != nil {
+		return fmt.Errorf("failed to parse %s from archive: %w", msg.ProtoReflect().Descriptor().Name(), err)
+	}
+	return nil
+}
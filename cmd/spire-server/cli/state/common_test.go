@@ -0,0 +1,255 @@
+package state
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	agentv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/agent/v1"
+	bundlev1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/bundle/v1"
+	entryv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/entry/v1"
+	trustdomainv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/trustdomain/v1"
+	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/pkg/common/pemutil"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+type stateTest struct {
+	stdin  *bytes.Buffer
+	stdout *bytes.Buffer
+	stderr *bytes.Buffer
+
+	args   []string
+	server *fakeServer
+
+	client cli.Command
+}
+
+func (s *stateTest) afterTest(t *testing.T) {
+	t.Logf("TEST:%s", t.Name())
+	t.Logf("STDOUT:\n%s", s.stdout.String())
+	t.Logf("STDIN:\n%s", s.stdin.String())
+	t.Logf("STDERR:\n%s", s.stderr.String())
+}
+
+// fakeServer keeps an in-memory server state, so the state exported from
+// one server can be imported into another
+type fakeServer struct {
+	agentv1.UnimplementedAgentServer
+	bundlev1.UnimplementedBundleServer
+	entryv1.UnimplementedEntryServer
+	trustdomainv1.UnimplementedTrustDomainServer
+
+	state  serverState
+	nextID int
+
+	// failEntries makes the entry batch operations fail for every entry
+	failEntries bool
+}
+
+func (f *fakeServer) ListAgents(ctx context.Context, req *agentv1.ListAgentsRequest) (*agentv1.ListAgentsResponse, error) {
+	return &agentv1.ListAgentsResponse{Agents: f.state.agents}, nil
+}
+
+func (f *fakeServer) GetBundle(ctx context.Context, req *bundlev1.GetBundleRequest) (*types.Bundle, error) {
+	return f.state.bundle, nil
+}
+
+func (f *fakeServer) AppendBundle(ctx context.Context, req *bundlev1.AppendBundleRequest) (*types.Bundle, error) {
+	f.state.bundle.X509Authorities = append(f.state.bundle.X509Authorities, req.X509Authorities...)
+	f.state.bundle.JwtAuthorities = append(f.state.bundle.JwtAuthorities, req.JwtAuthorities...)
+	f.state.bundle.SequenceNumber++
+	return f.state.bundle, nil
+}
+
+func (f *fakeServer) ListFederatedBundles(ctx context.Context, req *bundlev1.ListFederatedBundlesRequest) (*bundlev1.ListFederatedBundlesResponse, error) {
+	// Paginate one bundle at a time to exercise the paging of the commands
+	if len(f.state.federatedBundles) == 0 {
+		return &bundlev1.ListFederatedBundlesResponse{}, nil
+	}
+	var i int
+	if req.PageToken != "" {
+		if _, err := fmt.Sscan(req.PageToken, &i); err != nil {
+			return nil, err
+		}
+	}
+	resp := &bundlev1.ListFederatedBundlesResponse{Bundles: f.state.federatedBundles[i : i+1]}
+	if i+1 < len(f.state.federatedBundles) {
+		resp.NextPageToken = fmt.Sprint(i + 1)
+	}
+	return resp, nil
+}
+
+func (f *fakeServer) BatchCreateFederatedBundle(ctx context.Context, req *bundlev1.BatchCreateFederatedBundleRequest) (*bundlev1.BatchCreateFederatedBundleResponse, error) {
+	resp := new(bundlev1.BatchCreateFederatedBundleResponse)
+	for _, b := range req.Bundle {
+		b = proto.Clone(b).(*types.Bundle)
+		b.SequenceNumber = 1
+		f.state.federatedBundles = append(f.state.federatedBundles, b)
+		resp.Results = append(resp.Results, &bundlev1.BatchCreateFederatedBundleResponse_Result{Status: &types.Status{}, Bundle: b})
+	}
+	return resp, nil
+}
+
+func (f *fakeServer) BatchUpdateFederatedBundle(ctx context.Context, req *bundlev1.BatchUpdateFederatedBundleRequest) (*bundlev1.BatchUpdateFederatedBundleResponse, error) {
+	resp := new(bundlev1.BatchUpdateFederatedBundleResponse)
+	for _, b := range req.Bundle {
+		for i, cur := range f.state.federatedBundles {
+			if cur.TrustDomain == b.TrustDomain {
+				b = proto.Clone(b).(*types.Bundle)
+				b.SequenceNumber = cur.SequenceNumber + 1
+				f.state.federatedBundles[i] = b
+			}
+		}
+		resp.Results = append(resp.Results, &bundlev1.BatchUpdateFederatedBundleResponse_Result{Status: &types.Status{}, Bundle: b})
+	}
+	return resp, nil
+}
+
+func (f *fakeServer) ListFederationRelationships(ctx context.Context, req *trustdomainv1.ListFederationRelationshipsRequest) (*trustdomainv1.ListFederationRelationshipsResponse, error) {
+	return &trustdomainv1.ListFederationRelationshipsResponse{FederationRelationships: f.state.federationRelationships}, nil
+}
+
+func (f *fakeServer) BatchCreateFederationRelationship(ctx context.Context, req *trustdomainv1.BatchCreateFederationRelationshipRequest) (*trustdomainv1.BatchCreateFederationRelationshipResponse, error) {
+	resp := new(trustdomainv1.BatchCreateFederationRelationshipResponse)
+	for _, fr := range req.FederationRelationships {
+		f.state.federationRelationships = append(f.state.federationRelationships, fr)
+		resp.Results = append(resp.Results, &trustdomainv1.BatchCreateFederationRelationshipResponse_Result{Status: &types.Status{}, FederationRelationship: fr})
+	}
+	return resp, nil
+}
+
+func (f *fakeServer) BatchUpdateFederationRelationship(ctx context.Context, req *trustdomainv1.BatchUpdateFederationRelationshipRequest) (*trustdomainv1.BatchUpdateFederationRelationshipResponse, error) {
+	resp := new(trustdomainv1.BatchUpdateFederationRelationshipResponse)
+	for _, fr := range req.FederationRelationships {
+		for _, cur := range f.state.federationRelationships {
+			if cur.TrustDomain == fr.TrustDomain {
+				cur.BundleEndpointUrl = fr.BundleEndpointUrl
+				cur.BundleEndpointProfile = fr.BundleEndpointProfile
+			}
+		}
+		resp.Results = append(resp.Results, &trustdomainv1.BatchUpdateFederationRelationshipResponse_Result{Status: &types.Status{}, FederationRelationship: fr})
+	}
+	return resp, nil
+}
+
+func (f *fakeServer) ListEntries(ctx context.Context, req *entryv1.ListEntriesRequest) (*entryv1.ListEntriesResponse, error) {
+	return &entryv1.ListEntriesResponse{Entries: f.state.entries}, nil
+}
+
+func (f *fakeServer) BatchCreateEntry(ctx context.Context, req *entryv1.BatchCreateEntryRequest) (*entryv1.BatchCreateEntryResponse, error) {
+	resp := new(entryv1.BatchCreateEntryResponse)
+	for _, e := range req.Entries {
+		if f.failEntries {
+			resp.Results = append(resp.Results, &entryv1.BatchCreateEntryResponse_Result{
+				Status: &types.Status{Code: int32(codes.Internal), Message: "oh no"},
+			})
+			continue
+		}
+		f.nextID++
+		e = proto.Clone(e).(*types.Entry)
+		e.Id = fmt.Sprintf("imported-%d", f.nextID)
+		f.state.entries = append(f.state.entries, e)
+		resp.Results = append(resp.Results, &entryv1.BatchCreateEntryResponse_Result{Status: &types.Status{}, Entry: e})
+	}
+	return resp, nil
+}
+
+func (f *fakeServer) BatchUpdateEntry(ctx context.Context, req *entryv1.BatchUpdateEntryRequest) (*entryv1.BatchUpdateEntryResponse, error) {
+	resp := new(entryv1.BatchUpdateEntryResponse)
+	for _, e := range req.Entries {
+		for i, cur := range f.state.entries {
+			if cur.Id == e.Id {
+				f.state.entries[i] = e
+			}
+		}
+		resp.Results = append(resp.Results, &entryv1.BatchUpdateEntryResponse_Result{Status: &types.Status{}, Entry: e})
+	}
+	return resp, nil
+}
+
+func setupTest(t *testing.T, newClient func(*common_cli.Env) cli.Command) *stateTest {
+	stdin := new(bytes.Buffer)
+	stdout := new(bytes.Buffer)
+	stderr := new(bytes.Buffer)
+
+	client := newClient(&common_cli.Env{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+	})
+
+	server := &fakeServer{
+		state: serverState{
+			bundle: &types.Bundle{TrustDomain: "example.org"},
+		},
+	}
+	socketPath := spiretest.StartGRPCSocketServerOnTempSocket(t, func(s *grpc.Server) {
+		agentv1.RegisterAgentServer(s, server)
+		bundlev1.RegisterBundleServer(s, server)
+		entryv1.RegisterEntryServer(s, server)
+		trustdomainv1.RegisterTrustDomainServer(s, server)
+	})
+
+	test := &stateTest{
+		stdin:  stdin,
+		stdout: stdout,
+		stderr: stderr,
+		args:   []string{"-socketPath", socketPath},
+		server: server,
+		client: client,
+	}
+
+	t.Cleanup(func() {
+		test.afterTest(t)
+	})
+
+	return test
+}
+
+// writeKeys writes the PEM encoded signing key and public key to a
+// temporary directory and returns their paths
+func writeKeys(t *testing.T, signer crypto.Signer) (string, string) {
+	dir := t.TempDir()
+	signingKeyPath := filepath.Join(dir, "key.pem")
+	publicKeyPath := filepath.Join(dir, "key.pub")
+
+	keyPEM, err := pemutil.EncodePKCS8PrivateKey(signer)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(signingKeyPath, keyPEM, 0600))
+
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(signer.Public())
+	require.NoError(t, err)
+	publicKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER})
+	require.NoError(t, os.WriteFile(publicKeyPath, publicKeyPEM, 0600))
+
+	return signingKeyPath, publicKeyPath
+}
+
+// sortedEntries returns the entries sorted by key, for comparing states
+// regardless of the order of the entries and their IDs
+func sortedEntries(entries []*types.Entry) []*types.Entry {
+	sorted := make([]*types.Entry, 0, len(entries))
+	for _, e := range entries {
+		e = proto.Clone(e).(*types.Entry)
+		e.Id = ""
+		sorted = append(sorted, e)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].SpiffeId.Path < sorted[j].SpiffeId.Path
+	})
+	return sorted
+}
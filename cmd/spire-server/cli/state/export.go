@@ -0,0 +1,86 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/mitchellh/cli"
+	"github.com/spiffe/spire/cmd/spire-server/util"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/pkg/common/pemutil"
+)
+
+// NewExportCommand creates a new "export" subcommand for "state" command.
+func NewExportCommand() cli.Command {
+	return newExportCommand(common_cli.DefaultEnv, time.Now)
+}
+
+func newExportCommand(env *common_cli.Env, now func() time.Time) cli.Command {
+	return util.AdaptCommand(env, &exportCommand{now: now})
+}
+
+type exportCommand struct {
+	// Path to write the archive to
+	path string
+
+	// Path to the PEM encoded private key used to sign the archive
+	signingKeyPath string
+
+	now func() time.Time
+}
+
+func (*exportCommand) Name() string {
+	return "state export"
+}
+
+func (*exportCommand) Synopsis() string {
+	return "Exports the server state to a signed archive"
+}
+
+func (c *exportCommand) AppendFlags(f *flag.FlagSet) {
+	f.StringVar(&c.path, "path", "", "Path to write the archive to. If set to '-', write the archive to stdout.")
+	f.StringVar(&c.signingKeyPath, "signingKey", "", "Path to a PEM encoded RSA or EC private key used to sign the archive")
+}
+
+func (c *exportCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
+	if c.path == "" {
+		return errors.New("an archive path is required")
+	}
+	if c.signingKeyPath == "" {
+		return errors.New("a signing key is required")
+	}
+
+	signer, err := pemutil.LoadSigner(c.signingKeyPath)
+	if err != nil {
+		return fmt.Errorf("failed to load signing key: %w", err)
+	}
+
+	state, err := fetchState(ctx, serverClient)
+	if err != nil {
+		return err
+	}
+
+	data, err := encodeArchive(state, signer, c.now())
+	if err != nil {
+		return err
+	}
+
+	if c.path == "-" {
+		_, err := env.Stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(c.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	env.Printf("Exported the state of %s\n", state.bundle.TrustDomain)
+	env.Printf("Federated bundles        : %d\n", len(state.federatedBundles))
+	env.Printf("Federation relationships : %d\n", len(state.federationRelationships))
+	env.Printf("Agents                   : %d\n", len(state.agents))
+	env.Printf("Entries                  : %d\n", len(state.entries))
+	return nil
+}
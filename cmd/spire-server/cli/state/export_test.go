@@ -0,0 +1,171 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mitchellh/cli"
+	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/spiffe/spire/test/testkey"
+	"github.com/stretchr/testify/require"
+)
+
+var exportedAt = time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestExportCommand(env *common_cli.Env) cli.Command {
+	return newExportCommand(env, func() time.Time { return exportedAt })
+}
+
+// testState returns the state of a server with an item of every kind
+func testState() serverState {
+	return serverState{
+		bundle: &types.Bundle{
+			TrustDomain:     "example.org",
+			X509Authorities: []*types.X509Certificate{{Asn1: []byte("ca-1")}},
+			JwtAuthorities:  []*types.JWTKey{{KeyId: "kid-1", PublicKey: []byte("key-1"), ExpiresAt: 1}},
+			SequenceNumber:  3,
+		},
+		federatedBundles: []*types.Bundle{
+			{TrustDomain: "domain1.test", X509Authorities: []*types.X509Certificate{{Asn1: []byte("domain1-ca")}}, SequenceNumber: 7},
+			{TrustDomain: "domain2.test", X509Authorities: []*types.X509Certificate{{Asn1: []byte("domain2-ca")}}, SequenceNumber: 2},
+		},
+		federationRelationships: []*types.FederationRelationship{
+			{
+				TrustDomain:           "domain1.test",
+				BundleEndpointUrl:     "https://domain1.test/bundle",
+				BundleEndpointProfile: &types.FederationRelationship_HttpsWeb{HttpsWeb: &types.HTTPSWebProfile{}},
+				TrustDomainBundle:     &types.Bundle{TrustDomain: "domain1.test", X509Authorities: []*types.X509Certificate{{Asn1: []byte("domain1-ca")}}},
+			},
+		},
+		agents: []*types.Agent{
+			{Id: &types.SPIFFEID{TrustDomain: "example.org", Path: "/spire/agent/x509pop/1"}, AttestationType: "x509pop"},
+		},
+		entries: []*types.Entry{
+			{
+				Id:        "entry-1",
+				SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/workload-1"},
+				ParentId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/spire/agent/x509pop/1"},
+				Selectors: []*types.Selector{{Type: "unix", Value: "uid:1"}},
+				Ttl:       60,
+			},
+			{
+				Id:            "entry-2",
+				SpiffeId:      &types.SPIFFEID{TrustDomain: "example.org", Path: "/workload-2"},
+				ParentId:      &types.SPIFFEID{TrustDomain: "example.org", Path: "/spire/agent/x509pop/1"},
+				Selectors:     []*types.Selector{{Type: "unix", Value: "uid:2"}},
+				FederatesWith: []string{"domain1.test"},
+			},
+		},
+	}
+}
+
+func TestExportHelp(t *testing.T) {
+	test := setupTest(t, newTestExportCommand)
+	test.client.Help()
+
+	require.Equal(t, `Usage of state export:
+  -path string
+    	Path to write the archive to. If set to '-', write the archive to stdout.
+  -signingKey string
+    	Path to a PEM encoded RSA or EC private key used to sign the archive
+  -socketPath string
+    	Path to the SPIRE Server API socket (default "/tmp/spire-server/private/api.sock")
+`, test.stderr.String())
+}
+
+func TestExportSynopsis(t *testing.T) {
+	test := setupTest(t, newTestExportCommand)
+	require.Equal(t, "Exports the server state to a signed archive", test.client.Synopsis())
+}
+
+func TestExport(t *testing.T) {
+	signer := testkey.NewEC256(t)
+	signingKeyPath, publicKeyPath := writeKeys(t, signer)
+	publicKey, err := loadVerificationKey(publicKeyPath)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name      string
+		args      []string
+		toStdout  bool
+		expStdout string
+		expErr    string
+	}{
+		{
+			name:   "missing path",
+			args:   []string{"-signingKey", signingKeyPath},
+			expErr: "Error: an archive path is required\n",
+		},
+		{
+			name:   "missing signing key",
+			args:   []string{"-path", "-"},
+			expErr: "Error: a signing key is required\n",
+		},
+		{
+			name:   "invalid signing key",
+			args:   []string{"-path", "-", "-signingKey", publicKeyPath},
+			expErr: "Error: failed to load signing key: expected block type [\"PRIVATE KEY\" \"RSA PRIVATE KEY\" \"EC PRIVATE KEY\"]; got \"PUBLIC KEY\"\n",
+		},
+		{
+			name: "to file",
+			expStdout: `Exported the state of example.org
+Federated bundles        : 2
+Federation relationships : 1
+Agents                   : 1
+Entries                  : 2
+`,
+		},
+		{
+			name:     "to stdout",
+			args:     []string{"-path", "-", "-signingKey", signingKeyPath},
+			toStdout: true,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupTest(t, newTestExportCommand)
+			test.server.state = testState()
+
+			archivePath := filepath.Join(t.TempDir(), "state.jws")
+			args := tt.args
+			if args == nil {
+				args = []string{"-path", archivePath, "-signingKey", signingKeyPath}
+			}
+
+			rc := test.client.Run(append(test.args, args...))
+			if tt.expErr != "" {
+				require.Equal(t, 1, rc)
+				require.Equal(t, tt.expErr, test.stderr.String())
+				return
+			}
+			require.Equal(t, 0, rc)
+			require.Empty(t, test.stderr.String())
+
+			var data []byte
+			if tt.toStdout {
+				data = test.stdout.Bytes()
+			} else {
+				require.Equal(t, tt.expStdout, test.stdout.String())
+				data, err = os.ReadFile(archivePath)
+				require.NoError(t, err)
+			}
+
+			a, state, err := decodeArchive(data, publicKey)
+			require.NoError(t, err)
+			require.Equal(t, archiveVersion, a.Version)
+			require.Equal(t, "example.org", a.TrustDomain)
+			require.Equal(t, exportedAt, a.ExportedAt)
+
+			expected := testState()
+			spiretest.AssertProtoEqual(t, expected.bundle, state.bundle)
+			spiretest.AssertProtoListEqual(t, expected.federatedBundles, state.federatedBundles)
+			spiretest.AssertProtoListEqual(t, expected.federationRelationships, state.federationRelationships)
+			spiretest.AssertProtoListEqual(t, expected.agents, state.agents)
+			spiretest.AssertProtoListEqual(t, expected.entries, state.entries)
+		})
+	}
+}
@@ -0,0 +1,474 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/mitchellh/cli"
+	bundlev1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/bundle/v1"
+	entryv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/entry/v1"
+	trustdomainv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/trustdomain/v1"
+	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
+	"github.com/spiffe/spire/cmd/spire-server/util"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// conflictFail aborts the import, before any change is made, when
+	// items in the archive exist on the server with a different content
+	conflictFail = "fail"
+
+	// conflictSkip keeps the content of the server for conflicting items
+	conflictSkip = "skip"
+
+	// conflictOverwrite replaces the content of the server for conflicting
+	// items with the content of the archive
+	conflictOverwrite = "overwrite"
+)
+
+// NewImportCommand creates a new "import" subcommand for "state" command.
+func NewImportCommand() cli.Command {
+	return newImportCommand(common_cli.DefaultEnv)
+}
+
+func newImportCommand(env *common_cli.Env) cli.Command {
+	return util.AdaptCommand(env, new(importCommand))
+}
+
+type importCommand struct {
+	// Path to read the archive from
+	path string
+
+	// Path to the PEM encoded public key or certificate used to verify the
+	// archive signature
+	verificationKeyPath string
+
+	// How items that exist with a different content are handled
+	conflict string
+
+	// Whether or not to only compare the server state with the archive
+	verifyOnly bool
+}
+
+// importPlan holds the changes needed to make the server state match an
+// archive
+type importPlan struct {
+	createBundles []*types.Bundle
+	updateBundles []*types.Bundle
+
+	// appendAuthorities holds the authorities of the archived server bundle
+	// that are missing from the current one, or nil if there are none
+	appendAuthorities *types.Bundle
+
+	createRelationships []*types.FederationRelationship
+	updateRelationships []*types.FederationRelationship
+
+	createEntries []*types.Entry
+	updateEntries []*types.Entry
+
+	// conflicts describes the items that exist with a different content and
+	// are not overwritten
+	conflicts []string
+
+	unchanged int
+
+	// unattestedAgents counts the archived agents that are not attested to
+	// the server
+	unattestedAgents int
+}
+
+func (p *importPlan) changes() int {
+	n := len(p.createBundles) + len(p.updateBundles) +
+		len(p.createRelationships) + len(p.updateRelationships) +
+		len(p.createEntries) + len(p.updateEntries)
+	if p.appendAuthorities != nil {
+		n++
+	}
+	return n
+}
+
+func (*importCommand) Name() string {
+	return "state import"
+}
+
+func (*importCommand) Synopsis() string {
+	return "Imports the server state from a signed archive"
+}
+
+func (c *importCommand) AppendFlags(f *flag.FlagSet) {
+	f.StringVar(&c.path, "path", "", "Path to the archive. If set to '-', read the archive from stdin.")
+	f.StringVar(&c.verificationKeyPath, "verificationKey", "", "Path to the PEM encoded public key, or certificate, used to verify the archive signature")
+	f.StringVar(&c.conflict, "conflict", conflictFail, fmt.Sprintf("How to handle items that exist with a different content: %q aborts the import before any change is made, %q keeps the server content and %q replaces it with the archive content", conflictFail, conflictSkip, conflictOverwrite))
+	f.BoolVar(&c.verifyOnly, "verifyOnly", false, "If set, the server state is compared with the archive but not changed")
+}
+
+func (c *importCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
+	if c.path == "" {
+		return errors.New("an archive path is required")
+	}
+	if c.verificationKeyPath == "" {
+		return errors.New("a verification key is required")
+	}
+	switch c.conflict {
+	case conflictFail, conflictSkip, conflictOverwrite:
+	default:
+		return fmt.Errorf("unsupported conflict mode %q; expected %q, %q or %q", c.conflict, conflictFail, conflictSkip, conflictOverwrite)
+	}
+
+	publicKey, err := loadVerificationKey(c.verificationKeyPath)
+	if err != nil {
+		return err
+	}
+	data, err := readArchive(env.Stdin, c.path)
+	if err != nil {
+		return err
+	}
+	a, archived, err := decodeArchive(data, publicKey)
+	if err != nil {
+		return err
+	}
+
+	current, err := fetchState(ctx, serverClient)
+	if err != nil {
+		return err
+	}
+	if a.TrustDomain != current.bundle.TrustDomain {
+		return fmt.Errorf("archive was exported from trust domain %q, but the server is in %q", a.TrustDomain, current.bundle.TrustDomain)
+	}
+
+	overwrite := c.conflict == conflictOverwrite
+	plan := makeImportPlan(archived, current, overwrite)
+	printImportPlan(plan, env)
+
+	if c.verifyOnly {
+		if plan.changes() > 0 || len(plan.conflicts) > 0 {
+			return errors.New("server state does not match the archive")
+		}
+		env.Println("Server state matches the archive")
+		return nil
+	}
+
+	if c.conflict == conflictFail && len(plan.conflicts) > 0 {
+		return fmt.Errorf("found %d conflicting items; nothing was imported. Use -conflict %s or -conflict %s to import anyway", len(plan.conflicts), conflictSkip, conflictOverwrite)
+	}
+
+	failed, err := applyImportPlan(ctx, serverClient, plan, env)
+	if err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to import %d of %d changes", failed, plan.changes())
+	}
+
+	// The verification pass fetches the state again and expects nothing
+	// left to import, other than the conflicts that were skipped
+	current, err = fetchState(ctx, serverClient)
+	if err != nil {
+		return err
+	}
+	pending := makeImportPlan(archived, current, overwrite)
+	if n := pending.changes(); n > 0 {
+		return fmt.Errorf("verification failed: %d items do not match the archive", n)
+	}
+
+	env.Println("Imported and verified successfully")
+	return nil
+}
+
+func readArchive(in io.Reader, path string) ([]byte, error) {
+	if path == "-" {
+		data, err := io.ReadAll(in)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read archive: %w", err)
+		}
+		return data, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+	return data, nil
+}
+
+// makeImportPlan compares the archived state with the current one.
+// Federated bundles and federation relationships are matched by trust
+// domain, and entries by parent ID, SPIFFE ID and selectors. Items that
+// exist with a different content are updated if overwrite is set, or
+// reported as conflicts otherwise.
+func makeImportPlan(archived, current *serverState, overwrite bool) *importPlan {
+	plan := new(importPlan)
+
+	currentBundles := make(map[string]*types.Bundle, len(current.federatedBundles))
+	for _, b := range current.federatedBundles {
+		currentBundles[b.TrustDomain] = b
+	}
+	for _, b := range archived.federatedBundles {
+		cur, ok := currentBundles[b.TrustDomain]
+		switch {
+		case !ok:
+			plan.createBundles = append(plan.createBundles, b)
+		case bundlesEqual(b, cur):
+			plan.unchanged++
+		case overwrite:
+			plan.updateBundles = append(plan.updateBundles, b)
+		default:
+			plan.conflicts = append(plan.conflicts, fmt.Sprintf("federated bundle %s", b.TrustDomain))
+		}
+	}
+
+	plan.appendAuthorities = missingAuthorities(archived.bundle, current.bundle)
+
+	currentRelationships := make(map[string]*types.FederationRelationship, len(current.federationRelationships))
+	for _, fr := range current.federationRelationships {
+		currentRelationships[fr.TrustDomain] = fr
+	}
+	for _, fr := range archived.federationRelationships {
+		// Bundles are imported on their own, so they are neither compared
+		// nor set through the relationships
+		fr = withoutTrustDomainBundle(fr)
+		cur, ok := currentRelationships[fr.TrustDomain]
+		switch {
+		case !ok:
+			plan.createRelationships = append(plan.createRelationships, fr)
+		case proto.Equal(fr, withoutTrustDomainBundle(cur)):
+			plan.unchanged++
+		case overwrite:
+			plan.updateRelationships = append(plan.updateRelationships, fr)
+		default:
+			plan.conflicts = append(plan.conflicts, fmt.Sprintf("federation relationship %s", fr.TrustDomain))
+		}
+	}
+
+	currentEntries := make(map[string]*types.Entry, len(current.entries))
+	for _, e := range current.entries {
+		currentEntries[util.EntryKey(e)] = e
+	}
+	for _, e := range archived.entries {
+		cur, ok := currentEntries[util.EntryKey(e)]
+		switch {
+		case !ok:
+			plan.createEntries = append(plan.createEntries, e)
+		case util.EntryFieldsEqual(e, cur):
+			plan.unchanged++
+		case overwrite:
+			// Entry IDs are assigned by the server, so the existing entry
+			// is updated in place
+			e = proto.Clone(e).(*types.Entry)
+			e.Id = cur.Id
+			plan.updateEntries = append(plan.updateEntries, e)
+		default:
+			plan.conflicts = append(plan.conflicts, fmt.Sprintf("entry %s %s (parent: %s)", cur.Id, idString(e.SpiffeId), idString(e.ParentId)))
+		}
+	}
+
+	currentAgents := make(map[string]bool, len(current.agents))
+	for _, agent := range current.agents {
+		currentAgents[idString(agent.Id)] = true
+	}
+	for _, agent := range archived.agents {
+		if !currentAgents[idString(agent.Id)] {
+			plan.unattestedAgents++
+		}
+	}
+
+	return plan
+}
+
+// bundlesEqual compares two bundles ignoring the sequence number, which is
+// maintained by each server
+func bundlesEqual(a, b *types.Bundle) bool {
+	a = proto.Clone(a).(*types.Bundle)
+	b = proto.Clone(b).(*types.Bundle)
+	a.SequenceNumber = 0
+	b.SequenceNumber = 0
+	return proto.Equal(a, b)
+}
+
+// missingAuthorities returns the authorities of the archived bundle that
+// are not in the current one, or nil if there are none
+func missingAuthorities(archived, current *types.Bundle) *types.Bundle {
+	x509Authorities := make(map[string]bool, len(current.X509Authorities))
+	for _, a := range current.X509Authorities {
+		x509Authorities[string(a.Asn1)] = true
+	}
+	jwtAuthorities := make(map[string]bool, len(current.JwtAuthorities))
+	for _, a := range current.JwtAuthorities {
+		jwtAuthorities[a.KeyId] = true
+	}
+
+	missing := &types.Bundle{TrustDomain: archived.TrustDomain}
+	for _, a := range archived.X509Authorities {
+		if !x509Authorities[string(a.Asn1)] {
+			missing.X509Authorities = append(missing.X509Authorities, a)
+		}
+	}
+	for _, a := range archived.JwtAuthorities {
+		if !jwtAuthorities[a.KeyId] {
+			missing.JwtAuthorities = append(missing.JwtAuthorities, a)
+		}
+	}
+	if len(missing.X509Authorities) == 0 && len(missing.JwtAuthorities) == 0 {
+		return nil
+	}
+	return missing
+}
+
+func withoutTrustDomainBundle(fr *types.FederationRelationship) *types.FederationRelationship {
+	fr = proto.Clone(fr).(*types.FederationRelationship)
+	fr.TrustDomainBundle = nil
+	return fr
+}
+
+func idString(id *types.SPIFFEID) string {
+	if id == nil {
+		return ""
+	}
+	return fmt.Sprintf("spiffe://%s%s", id.TrustDomain, id.Path)
+}
+
+func printImportPlan(plan *importPlan, env *common_cli.Env) {
+	for _, b := range plan.createBundles {
+		env.Printf("+ create federated bundle %s\n", b.TrustDomain)
+	}
+	for _, b := range plan.updateBundles {
+		env.Printf("~ update federated bundle %s\n", b.TrustDomain)
+	}
+	if b := plan.appendAuthorities; b != nil {
+		env.Printf("+ append %d X.509 and %d JWT authorities to the bundle of %s\n", len(b.X509Authorities), len(b.JwtAuthorities), b.TrustDomain)
+	}
+	for _, fr := range plan.createRelationships {
+		env.Printf("+ create federation relationship %s\n", fr.TrustDomain)
+	}
+	for _, fr := range plan.updateRelationships {
+		env.Printf("~ update federation relationship %s\n", fr.TrustDomain)
+	}
+	for _, e := range plan.createEntries {
+		env.Printf("+ create entry %s (parent: %s)\n", idString(e.SpiffeId), idString(e.ParentId))
+	}
+	for _, e := range plan.updateEntries {
+		env.Printf("~ update entry %s %s (parent: %s)\n", e.Id, idString(e.SpiffeId), idString(e.ParentId))
+	}
+	for _, conflict := range plan.conflicts {
+		env.Printf("! conflict on %s\n", conflict)
+	}
+
+	env.Printf("Plan: %d to create, %d to update, %d conflicting, %d unchanged\n",
+		len(plan.createBundles)+len(plan.createRelationships)+len(plan.createEntries),
+		len(plan.updateBundles)+len(plan.updateRelationships)+len(plan.updateEntries),
+		len(plan.conflicts), plan.unchanged)
+	if plan.unattestedAgents > 0 {
+		msg := fmt.Sprintf("%d ", plan.unattestedAgents)
+		msg = util.Pluralizer(msg, "agent in the archive is", "agents in the archive are", plan.unattestedAgents)
+		env.Printf("%s not attested to this server and must attest again\n", msg)
+	}
+}
+
+// applyImportPlan applies the plan and returns the number of changes that
+// failed. Bundles are imported first, since federation relationships and
+// entries that federate with a trust domain depend on its bundle.
+func applyImportPlan(ctx context.Context, serverClient util.ServerClient, plan *importPlan, env *common_cli.Env) (int, error) {
+	failed := 0
+	check := func(status *types.Status, format string, args ...interface{}) {
+		if status.Code != int32(codes.OK) {
+			failed++
+			env.ErrPrintf("Failed to %s (code: %s, msg: %q)\n", fmt.Sprintf(format, args...), codes.Code(status.Code), status.Message)
+		}
+	}
+
+	bundleClient := serverClient.NewBundleClient()
+	for start := 0; start < len(plan.createBundles); start += batchSize {
+		batch := plan.createBundles[start:batchEnd(start, len(plan.createBundles))]
+		resp, err := bundleClient.BatchCreateFederatedBundle(ctx, &bundlev1.BatchCreateFederatedBundleRequest{Bundle: batch})
+		if err != nil {
+			return failed, err
+		}
+		for i, r := range resp.Results {
+			check(r.Status, "create federated bundle %s", batch[i].TrustDomain)
+		}
+	}
+	for start := 0; start < len(plan.updateBundles); start += batchSize {
+		batch := plan.updateBundles[start:batchEnd(start, len(plan.updateBundles))]
+		resp, err := bundleClient.BatchUpdateFederatedBundle(ctx, &bundlev1.BatchUpdateFederatedBundleRequest{Bundle: batch})
+		if err != nil {
+			return failed, err
+		}
+		for i, r := range resp.Results {
+			check(r.Status, "update federated bundle %s", batch[i].TrustDomain)
+		}
+	}
+	if b := plan.appendAuthorities; b != nil {
+		if _, err := bundleClient.AppendBundle(ctx, &bundlev1.AppendBundleRequest{
+			X509Authorities: b.X509Authorities,
+			JwtAuthorities:  b.JwtAuthorities,
+		}); err != nil {
+			return failed, err
+		}
+	}
+
+	trustDomainClient := serverClient.NewTrustDomainClient()
+	for start := 0; start < len(plan.createRelationships); start += batchSize {
+		batch := plan.createRelationships[start:batchEnd(start, len(plan.createRelationships))]
+		resp, err := trustDomainClient.BatchCreateFederationRelationship(ctx, &trustdomainv1.BatchCreateFederationRelationshipRequest{
+			FederationRelationships: batch,
+		})
+		if err != nil {
+			return failed, err
+		}
+		for i, r := range resp.Results {
+			check(r.Status, "create federation relationship %s", batch[i].TrustDomain)
+		}
+	}
+	for start := 0; start < len(plan.updateRelationships); start += batchSize {
+		batch := plan.updateRelationships[start:batchEnd(start, len(plan.updateRelationships))]
+		resp, err := trustDomainClient.BatchUpdateFederationRelationship(ctx, &trustdomainv1.BatchUpdateFederationRelationshipRequest{
+			FederationRelationships: batch,
+			InputMask: &types.FederationRelationshipMask{
+				BundleEndpointUrl:     true,
+				BundleEndpointProfile: true,
+			},
+		})
+		if err != nil {
+			return failed, err
+		}
+		for i, r := range resp.Results {
+			check(r.Status, "update federation relationship %s", batch[i].TrustDomain)
+		}
+	}
+
+	entryClient := serverClient.NewEntryClient()
+	for start := 0; start < len(plan.createEntries); start += batchSize {
+		batch := plan.createEntries[start:batchEnd(start, len(plan.createEntries))]
+		resp, err := entryClient.BatchCreateEntry(ctx, &entryv1.BatchCreateEntryRequest{Entries: batch})
+		if err != nil {
+			return failed, err
+		}
+		for i, r := range resp.Results {
+			check(r.Status, "create entry %s", idString(batch[i].SpiffeId))
+		}
+	}
+	for start := 0; start < len(plan.updateEntries); start += batchSize {
+		batch := plan.updateEntries[start:batchEnd(start, len(plan.updateEntries))]
+		resp, err := entryClient.BatchUpdateEntry(ctx, &entryv1.BatchUpdateEntryRequest{Entries: batch})
+		if err != nil {
+			return failed, err
+		}
+		for i, r := range resp.Results {
+			check(r.Status, "update entry %s", batch[i].Id)
+		}
+	}
+
+	return failed, nil
+}
+
+func batchEnd(start, n int) int {
+	if end := start + batchSize; end < n {
+		return end
+	}
+	return n
+}
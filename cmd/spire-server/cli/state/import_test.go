@@ -0,0 +1,301 @@
+package state
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/spiffe/spire/test/testkey"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
+)
+
+func TestImportHelp(t *testing.T) {
+	test := setupTest(t, newImportCommand)
+	test.client.Help()
+
+	require.Equal(t, `Usage of state import:
+  -conflict string
+    	How to handle items that exist with a different content: "fail" aborts the import before any change is made, "skip" keeps the server content and "overwrite" replaces it with the archive content (default "fail")
+  -path string
+    	Path to the archive. If set to '-', read the archive from stdin.
+  -socketPath string
+    	Path to the SPIRE Server API socket (default "/tmp/spire-server/private/api.sock")
+  -verificationKey string
+    	Path to the PEM encoded public key, or certificate, used to verify the archive signature
+  -verifyOnly
+    	If set, the server state is compared with the archive but not changed
+`, test.stderr.String())
+}
+
+func TestImportSynopsis(t *testing.T) {
+	test := setupTest(t, newImportCommand)
+	require.Equal(t, "Imports the server state from a signed archive", test.client.Synopsis())
+}
+
+func TestImport(t *testing.T) {
+	signer := testkey.NewEC256(t)
+	_, publicKeyPath := writeKeys(t, signer)
+	_, otherPublicKeyPath := writeKeys(t, testkey.NewEC256(t))
+
+	archived := testState()
+	archivePath := filepath.Join(t.TempDir(), "state.jws")
+	data, err := encodeArchive(&archived, signer, exportedAt)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(archivePath, data, 0600))
+
+	// conflictingEntry matches the key of the first archived entry, with a
+	// different TTL
+	conflictingEntry := func() *types.Entry {
+		return &types.Entry{
+			Id:        "existing-1",
+			SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/workload-1"},
+			ParentId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/spire/agent/x509pop/1"},
+			Selectors: []*types.Selector{{Type: "unix", Value: "uid:1"}},
+			Ttl:       3600,
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		args        []string
+		prepare     func(*fakeServer)
+		expStdout   string
+		expStderr   string
+		expEntries  []*types.Entry
+		expImported bool
+	}{
+		{
+			name:      "missing path",
+			args:      []string{"-verificationKey", publicKeyPath},
+			expStderr: "Error: an archive path is required\n",
+		},
+		{
+			name:      "missing verification key",
+			args:      []string{"-path", archivePath},
+			expStderr: "Error: a verification key is required\n",
+		},
+		{
+			name:      "unsupported conflict mode",
+			args:      []string{"-path", archivePath, "-verificationKey", publicKeyPath, "-conflict", "merge"},
+			expStderr: "Error: unsupported conflict mode \"merge\"; expected \"fail\", \"skip\" or \"overwrite\"\n",
+		},
+		{
+			name:      "wrong verification key",
+			args:      []string{"-path", archivePath, "-verificationKey", otherPublicKeyPath},
+			expStderr: "Error: failed to verify archive signature: square/go-jose: error in cryptographic primitive\n",
+		},
+		{
+			name: "different trust domain",
+			args: []string{"-path", archivePath, "-verificationKey", publicKeyPath},
+			prepare: func(s *fakeServer) {
+				s.state.bundle.TrustDomain = "other.org"
+			},
+			expStderr: "Error: archive was exported from trust domain \"example.org\", but the server is in \"other.org\"\n",
+		},
+		{
+			name: "into an empty server",
+			args: []string{"-path", archivePath, "-verificationKey", publicKeyPath},
+			expStdout: `+ create federated bundle domain1.test
++ create federated bundle domain2.test
++ append 1 X.509 and 1 JWT authorities to the bundle of example.org
++ create federation relationship domain1.test
++ create entry spiffe://example.org/workload-1 (parent: spiffe://example.org/spire/agent/x509pop/1)
++ create entry spiffe://example.org/workload-2 (parent: spiffe://example.org/spire/agent/x509pop/1)
+Plan: 5 to create, 0 to update, 0 conflicting, 0 unchanged
+1 agent in the archive is not attested to this server and must attest again
+Imported and verified successfully
+`,
+			expEntries:  archived.entries,
+			expImported: true,
+		},
+		{
+			name: "from stdin",
+			args: []string{"-path", "-", "-verificationKey", publicKeyPath},
+			expStdout: `+ create federated bundle domain1.test
++ create federated bundle domain2.test
++ append 1 X.509 and 1 JWT authorities to the bundle of example.org
++ create federation relationship domain1.test
++ create entry spiffe://example.org/workload-1 (parent: spiffe://example.org/spire/agent/x509pop/1)
++ create entry spiffe://example.org/workload-2 (parent: spiffe://example.org/spire/agent/x509pop/1)
+Plan: 5 to create, 0 to update, 0 conflicting, 0 unchanged
+1 agent in the archive is not attested to this server and must attest again
+Imported and verified successfully
+`,
+			expEntries:  archived.entries,
+			expImported: true,
+		},
+		{
+			name: "conflicts fail the import",
+			args: []string{"-path", archivePath, "-verificationKey", publicKeyPath},
+			prepare: func(s *fakeServer) {
+				s.state.entries = []*types.Entry{conflictingEntry()}
+			},
+			expStdout: `+ create federated bundle domain1.test
++ create federated bundle domain2.test
++ append 1 X.509 and 1 JWT authorities to the bundle of example.org
++ create federation relationship domain1.test
++ create entry spiffe://example.org/workload-2 (parent: spiffe://example.org/spire/agent/x509pop/1)
+! conflict on entry existing-1 spiffe://example.org/workload-1 (parent: spiffe://example.org/spire/agent/x509pop/1)
+Plan: 4 to create, 0 to update, 1 conflicting, 0 unchanged
+1 agent in the archive is not attested to this server and must attest again
+`,
+			expStderr:  "Error: found 1 conflicting items; nothing was imported. Use -conflict skip or -conflict overwrite to import anyway\n",
+			expEntries: []*types.Entry{conflictingEntry()},
+		},
+		{
+			name: "conflicts are skipped",
+			args: []string{"-path", archivePath, "-verificationKey", publicKeyPath, "-conflict", "skip"},
+			prepare: func(s *fakeServer) {
+				s.state.entries = []*types.Entry{conflictingEntry()}
+			},
+			expStdout: `+ create federated bundle domain1.test
++ create federated bundle domain2.test
++ append 1 X.509 and 1 JWT authorities to the bundle of example.org
++ create federation relationship domain1.test
++ create entry spiffe://example.org/workload-2 (parent: spiffe://example.org/spire/agent/x509pop/1)
+! conflict on entry existing-1 spiffe://example.org/workload-1 (parent: spiffe://example.org/spire/agent/x509pop/1)
+Plan: 4 to create, 0 to update, 1 conflicting, 0 unchanged
+1 agent in the archive is not attested to this server and must attest again
+Imported and verified successfully
+`,
+			expEntries:  []*types.Entry{conflictingEntry(), archived.entries[1]},
+			expImported: true,
+		},
+		{
+			name: "conflicts are overwritten",
+			args: []string{"-path", archivePath, "-verificationKey", publicKeyPath, "-conflict", "overwrite"},
+			prepare: func(s *fakeServer) {
+				s.state.entries = []*types.Entry{conflictingEntry()}
+				s.state.federatedBundles = []*types.Bundle{{TrustDomain: "domain2.test", SequenceNumber: 1}}
+				s.state.federationRelationships = []*types.FederationRelationship{
+					{
+						TrustDomain:           "domain1.test",
+						BundleEndpointUrl:     "https://old.domain1.test/bundle",
+						BundleEndpointProfile: &types.FederationRelationship_HttpsWeb{HttpsWeb: &types.HTTPSWebProfile{}},
+					},
+				}
+			},
+			expStdout: `+ create federated bundle domain1.test
+~ update federated bundle domain2.test
++ append 1 X.509 and 1 JWT authorities to the bundle of example.org
+~ update federation relationship domain1.test
++ create entry spiffe://example.org/workload-2 (parent: spiffe://example.org/spire/agent/x509pop/1)
+~ update entry existing-1 spiffe://example.org/workload-1 (parent: spiffe://example.org/spire/agent/x509pop/1)
+Plan: 2 to create, 3 to update, 0 conflicting, 0 unchanged
+1 agent in the archive is not attested to this server and must attest again
+Imported and verified successfully
+`,
+			expEntries:  archived.entries,
+			expImported: true,
+		},
+		{
+			name: "verify only with differences",
+			args: []string{"-path", archivePath, "-verificationKey", publicKeyPath, "-verifyOnly"},
+			prepare: func(s *fakeServer) {
+				s.state.entries = []*types.Entry{conflictingEntry()}
+			},
+			expStdout: `+ create federated bundle domain1.test
++ create federated bundle domain2.test
++ append 1 X.509 and 1 JWT authorities to the bundle of example.org
++ create federation relationship domain1.test
++ create entry spiffe://example.org/workload-2 (parent: spiffe://example.org/spire/agent/x509pop/1)
+! conflict on entry existing-1 spiffe://example.org/workload-1 (parent: spiffe://example.org/spire/agent/x509pop/1)
+Plan: 4 to create, 0 to update, 1 conflicting, 0 unchanged
+1 agent in the archive is not attested to this server and must attest again
+`,
+			expStderr:  "Error: server state does not match the archive\n",
+			expEntries: []*types.Entry{conflictingEntry()},
+		},
+		{
+			name: "verify only with a matching state",
+			args: []string{"-path", archivePath, "-verificationKey", publicKeyPath, "-verifyOnly"},
+			prepare: func(s *fakeServer) {
+				s.state = testState()
+			},
+			expStdout: `Plan: 0 to create, 0 to update, 0 conflicting, 5 unchanged
+Server state matches the archive
+`,
+			expEntries:  archived.entries,
+			expImported: true,
+		},
+		{
+			name: "failed changes",
+			args: []string{"-path", archivePath, "-verificationKey", publicKeyPath},
+			prepare: func(s *fakeServer) {
+				s.failEntries = true
+			},
+			expStdout: `+ create federated bundle domain1.test
++ create federated bundle domain2.test
++ append 1 X.509 and 1 JWT authorities to the bundle of example.org
++ create federation relationship domain1.test
++ create entry spiffe://example.org/workload-1 (parent: spiffe://example.org/spire/agent/x509pop/1)
++ create entry spiffe://example.org/workload-2 (parent: spiffe://example.org/spire/agent/x509pop/1)
+Plan: 5 to create, 0 to update, 0 conflicting, 0 unchanged
+1 agent in the archive is not attested to this server and must attest again
+`,
+			expStderr: `Failed to create entry spiffe://example.org/workload-1 (code: Internal, msg: "oh no")
+Failed to create entry spiffe://example.org/workload-2 (code: Internal, msg: "oh no")
+Error: failed to import 2 of 6 changes
+`,
+			expImported: true,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupTest(t, newImportCommand)
+			test.stdin.Write(data)
+			if tt.prepare != nil {
+				tt.prepare(test.server)
+			}
+
+			rc := test.client.Run(append(test.args, tt.args...))
+			require.Equal(t, tt.expStdout, test.stdout.String())
+			require.Equal(t, tt.expStderr, test.stderr.String())
+			if tt.expStderr != "" && rc == 0 {
+				t.Fatal("expected a non-zero exit code")
+			}
+
+			state := test.server.state
+			spiretest.AssertProtoListEqual(t, sortedEntries(tt.expEntries), sortedEntries(state.entries))
+			if !tt.expImported {
+				return
+			}
+
+			require.Len(t, state.federatedBundles, 2)
+			for _, b := range state.federatedBundles {
+				expected := archived.federatedBundles[0]
+				if b.TrustDomain == "domain2.test" {
+					expected = archived.federatedBundles[1]
+				}
+				require.True(t, bundlesEqual(expected, b), "unexpected bundle %v", b)
+			}
+			require.Len(t, state.federationRelationships, 1)
+			spiretest.AssertProtoEqual(t, withoutTrustDomainBundle(archived.federationRelationships[0]), withoutTrustDomainBundle(state.federationRelationships[0]))
+			spiretest.AssertProtoListEqual(t, archived.bundle.X509Authorities, state.bundle.X509Authorities)
+			spiretest.AssertProtoListEqual(t, archived.bundle.JwtAuthorities, state.bundle.JwtAuthorities)
+		})
+	}
+}
+
+func TestImportUnsupportedVersion(t *testing.T) {
+	signer := testkey.NewEC256(t)
+	_, publicKeyPath := writeKeys(t, signer)
+
+	payload, err := json.Marshal(&archive{Version: archiveVersion + 1, TrustDomain: "example.org"})
+	require.NoError(t, err)
+	jwsSigner, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: signer}, nil)
+	require.NoError(t, err)
+	jws, err := jwsSigner.Sign(payload)
+	require.NoError(t, err)
+
+	test := setupTest(t, newImportCommand)
+	test.stdin.WriteString(jws.FullSerialize())
+
+	rc := test.client.Run(append(test.args, "-path", "-", "-verificationKey", publicKeyPath))
+	require.Equal(t, 1, rc)
+	require.Equal(t, "Error: unsupported archive version 2; expected 1\n", test.stderr.String())
+}
@@ -27,6 +27,8 @@ type generateCommand struct {
 
 	// Token TTL in seconds
 	TTL int
+
+	printer common_cli.Printer
 }
 
 func (g *generateCommand) Name() string {
@@ -52,16 +54,18 @@ func (g *generateCommand) Run(ctx context.Context, env *common_cli.Env, serverCl
 		return err
 	}
 
-	if err := env.Printf("Token: %s\n", resp.Value); err != nil {
-		return err
-	}
+	return g.printer.PrintProto(env, resp, func() error {
+		if err := env.Printf("Token: %s\n", resp.Value); err != nil {
+			return err
+		}
 
-	if g.SpiffeID == "" {
-		env.Printf("Warning: Missing SPIFFE ID.\n")
-		return nil
-	}
+		if g.SpiffeID == "" {
+			env.Printf("Warning: Missing SPIFFE ID.\n")
+			return nil
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func getID(spiffeID string) (*types.SPIFFEID, error) {
@@ -82,4 +86,5 @@ func getID(spiffeID string) (*types.SPIFFEID, error) {
 func (g *generateCommand) AppendFlags(fs *flag.FlagSet) {
 	fs.IntVar(&g.TTL, "ttl", 600, "Token TTL in seconds")
 	fs.StringVar(&g.SpiffeID, "spiffeID", "", "Additional SPIFFE ID to assign the token owner (optional)")
+	g.printer.AppendFlag(fs)
 }
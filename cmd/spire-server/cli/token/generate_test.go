@@ -52,6 +52,19 @@ func TestCreateToken(t *testing.T) {
 			},
 			token: "token",
 		},
+		{
+			name: "json output",
+			args: []string{
+				"-spiffeID", "spiffe://example.org/agent",
+				"-output", "json",
+			},
+			expectedReq: &agentv1.CreateJoinTokenRequest{
+				AgentId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/agent"},
+				Ttl:     600,
+			},
+			expectedStdout: "{\n  \"value\": \"token\"\n}\n",
+			token:          "token",
+		},
 		{
 			name: "malformed spiffe ID",
 			args: []string{
@@ -0,0 +1,73 @@
+package util
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
+)
+
+// EntryKey returns a key built from the parent ID, SPIFFE ID and selectors
+// of an entry, the same tuple the server uses to detect similar entries.
+func EntryKey(e *types.Entry) string {
+	selectors := make([]string, 0, len(e.Selectors))
+	for _, s := range e.Selectors {
+		selectors = append(selectors, s.Type+":"+s.Value)
+	}
+	sort.Strings(selectors)
+	return strings.Join(append([]string{idString(e.ParentId), idString(e.SpiffeId)}, selectors...), "\n")
+}
+
+// EntryFieldsEqual compares the fields of two entries that are not part of
+// the entry key
+func EntryFieldsEqual(a, b *types.Entry) bool {
+	return a.Ttl == b.Ttl &&
+		a.JwtSvidTtl == b.JwtSvidTtl &&
+		a.Admin == b.Admin &&
+		a.Downstream == b.Downstream &&
+		a.ExpiresAt == b.ExpiresAt &&
+		a.StoreSvid == b.StoreSvid &&
+		stringsEqual(a.DnsNames, b.DnsNames) &&
+		stringSetsEqual(a.FederatesWith, b.FederatesWith) &&
+		labelsEqual(a.Labels, b.Labels)
+}
+
+func idString(id *types.SPIFFEID) string {
+	if id == nil {
+		return ""
+	}
+	return fmt.Sprintf("spiffe://%s%s", id.TrustDomain, id.Path)
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func stringSetsEqual(a, b []string) bool {
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	return stringsEqual(a, b)
+}
+
+func labelsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, value := range a {
+		if v, ok := b[name]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
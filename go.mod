@@ -79,6 +79,7 @@ require (
 	github.com/google/go-tpm v0.3.2
 	github.com/google/go-tpm-tools v0.2.1
 	github.com/google/gofuzz v1.2.0 // indirect
+	github.com/google/trillian v1.4.0
 	github.com/google/uuid v1.3.0 // indirect
 	github.com/googleapis/gax-go/v2 v2.1.1 // indirect
 	github.com/googleapis/gnostic v0.5.5 // indirect
@@ -186,7 +187,7 @@ require (
 	k8s.io/utils v0.0.0-20211203121628-587287796c64
 	sigs.k8s.io/controller-runtime v0.10.0
 	sigs.k8s.io/structured-merge-diff/v4 v4.1.2 // indirect
-	sigs.k8s.io/yaml v1.3.0 // indirect
+	sigs.k8s.io/yaml v1.3.0
 )
 
 require (
@@ -222,7 +223,6 @@ require (
 	github.com/google/go-containerregistry/pkg/authn/k8schain v0.0.0-20211203164431-c75901cce627 // indirect
 	github.com/google/go-github/v39 v39.2.0 // indirect
 	github.com/google/go-querystring v1.1.0 // indirect
-	github.com/google/trillian v1.4.0 // indirect
 	github.com/hashicorp/go-secure-stdlib/mlock v0.1.1 // indirect
 	github.com/hashicorp/go-secure-stdlib/parseutil v0.1.2 // indirect
 	github.com/hashicorp/go-secure-stdlib/strutil v0.1.1 // indirect
@@ -260,6 +260,7 @@ require (
 	github.com/vdemeester/k8s-pkg-credentialprovider v1.21.0-1 // indirect
 	github.com/xanzy/go-gitlab v0.52.2 // indirect
 	go.mongodb.org/mongo-driver v1.7.5 // indirect
+	golang.org/x/mod v0.5.1 // indirect
 	gopkg.in/ini.v1 v1.66.0 // indirect
 	k8s.io/cloud-provider v0.21.0 // indirect
 	k8s.io/legacy-cloud-providers v0.21.0 // indirect
@@ -269,3 +270,7 @@ require (
 replace github.com/go-logr/logr => github.com/go-logr/logr v0.4.0
 
 replace k8s.io/klog/v2 => k8s.io/klog/v2 v2.10.0
+
+// The API SDK is carried in-tree while the entry API, agent debug API and
+// entry type changes it needs are not released upstream.
+replace github.com/spiffe/spire-api-sdk => ./third_party/spire-api-sdk
@@ -20,6 +20,7 @@ import (
 	"github.com/spiffe/spire/pkg/agent/endpoints"
 	"github.com/spiffe/spire/pkg/agent/manager"
 	"github.com/spiffe/spire/pkg/agent/manager/storecache"
+	"github.com/spiffe/spire/pkg/agent/plugin/workloadattestor/k8s/sigstore"
 	"github.com/spiffe/spire/pkg/agent/svid/store"
 	"github.com/spiffe/spire/pkg/common/health"
 	"github.com/spiffe/spire/pkg/common/profiling"
@@ -261,6 +262,8 @@ func (a *Agent) newAdminEndpoints(mgr manager.Manager) admin_api.Server {
 		Log:         a.c.Log.WithField(telemetry.SubsystemName, telemetry.DebugAPI),
 		TrustDomain: a.c.TrustDomain,
 		Uptime:      uptime.Uptime,
+
+		ImageVerificationResults: sigstore.PublishedVerificationResults,
 	}
 
 	return admin_api.New(config)
@@ -7,6 +7,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/spire/pkg/agent/manager"
+	"github.com/spiffe/spire/pkg/agent/plugin/workloadattestor/k8s/sigstore"
 	"github.com/spiffe/spire/pkg/common/peertracker"
 )
 
@@ -21,6 +22,10 @@ type Config struct {
 	TrustDomain spiffeid.TrustDomain
 
 	Uptime func() time.Duration
+
+	// ImageVerificationResults returns the cached image signature
+	// verification results of the workload attestors
+	ImageVerificationResults func() []sigstore.VerificationResult
 }
 
 func New(c *Config) *Endpoints {
@@ -13,6 +13,7 @@ import (
 	debugv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/agent/debug/v1"
 	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
 	"github.com/spiffe/spire/pkg/agent/manager"
+	"github.com/spiffe/spire/pkg/agent/plugin/workloadattestor/k8s/sigstore"
 	"github.com/spiffe/spire/test/clock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -35,6 +36,10 @@ type Config struct {
 	Manager     manager.Manager
 	TrustDomain spiffeid.TrustDomain
 	Uptime      func() time.Duration
+
+	// ImageVerificationResults returns the cached image signature
+	// verification results of the workload attestors. Optional.
+	ImageVerificationResults func() []sigstore.VerificationResult
 }
 
 // New creates a new debug service
@@ -45,6 +50,8 @@ func New(config Config) *Service {
 		m:      config.Manager,
 		td:     config.TrustDomain,
 		uptime: config.Uptime,
+
+		imageVerificationResults: config.ImageVerificationResults,
 	}
 }
 
@@ -58,6 +65,8 @@ type Service struct {
 	td     spiffeid.TrustDomain
 	uptime func() time.Duration
 
+	imageVerificationResults func() []sigstore.VerificationResult
+
 	getInfoResp getInfoResp
 }
 
@@ -105,6 +114,40 @@ func (s *Service) GetInfo(ctx context.Context, req *debugv1.GetInfoRequest) (*de
 	return s.getInfoResp.resp, nil
 }
 
+// ListImageVerificationResults lists the cached image signature verification
+// results of the workload attestors
+func (s *Service) ListImageVerificationResults(ctx context.Context, req *debugv1.ListImageVerificationResultsRequest) (*debugv1.ListImageVerificationResultsResponse, error) {
+	resp := &debugv1.ListImageVerificationResultsResponse{}
+	if s.imageVerificationResults == nil {
+		return resp, nil
+	}
+
+	for _, result := range s.imageVerificationResults() {
+		var signatures []*debugv1.ListImageVerificationResultsResponse_Signature
+		for _, signature := range result.Signatures {
+			signatures = append(signatures, &debugv1.ListImageVerificationResultsResponse_Signature{
+				VerifierType:   signature.VerifierType,
+				Subject:        signature.Subject,
+				Issuer:         signature.Issuer,
+				KeyId:          signature.KeyID,
+				RekorUuid:      signature.RekorUUID,
+				LogIndex:       signature.LogIndex,
+				IntegratedTime: signature.IntegratedTime,
+				FailureReason:  signature.FailureReason,
+			})
+		}
+		resp.Results = append(resp.Results, &debugv1.ListImageVerificationResultsResponse_Result{
+			ImageId:    result.ImageID,
+			Verifier:   result.Verifier,
+			VerifiedAt: result.VerifiedAt.Unix(),
+			Error:      result.Error,
+			Signatures: signatures,
+		})
+	}
+
+	return resp, nil
+}
+
 // spiffeIDFromCert gets types SPIFFE ID from certificate, it can be nil
 func spiffeIDFromCert(cert *x509.Certificate) *types.SPIFFEID {
 	id, err := x509svid.IDFromCert(cert)
@@ -15,6 +15,7 @@ import (
 	"github.com/spiffe/spire/pkg/agent/api/debug/v1"
 	"github.com/spiffe/spire/pkg/agent/manager"
 	"github.com/spiffe/spire/pkg/agent/manager/cache"
+	"github.com/spiffe/spire/pkg/agent/plugin/workloadattestor/k8s/sigstore"
 	"github.com/spiffe/spire/pkg/agent/svid"
 	"github.com/spiffe/spire/pkg/common/bundleutil"
 	"github.com/spiffe/spire/test/clock"
@@ -209,6 +210,94 @@ func TestGetInfo(t *testing.T) {
 	}
 }
 
+func TestListImageVerificationResults(t *testing.T) {
+	verifiedAt := time.Unix(1234, 0)
+
+	for _, tt := range []struct {
+		name       string
+		results    []sigstore.VerificationResult
+		expectResp *debugv1.ListImageVerificationResultsResponse
+	}{
+		{
+			name:       "no results",
+			expectResp: &debugv1.ListImageVerificationResultsResponse{},
+		},
+		{
+			name: "results",
+			results: []sigstore.VerificationResult{
+				{
+					ImageID:    "docker-registry.com/some/image@sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+					VerifiedAt: verifiedAt,
+					Signatures: []sigstore.SignatureResult{
+						{
+							VerifierType:   sigstore.CosignKeylessVerifierType,
+							Subject:        "spirex@example.com",
+							Issuer:         "https://accounts.example.com",
+							KeyID:          "0102",
+							RekorUUID:      "eb48155e4b08e1d0c69fdf91bb803b735e0c38c64bdcd1c08ea1b9774ec7b44d",
+							LogIndex:       42,
+							IntegratedTime: 12345,
+						},
+						{
+							VerifierType:  sigstore.CosignKeylessVerifierType,
+							Subject:       "someone@example.com",
+							FailureReason: "Signature subject is not allowed",
+						},
+					},
+				},
+				{
+					ImageID:    "docker-registry.com/other/image@sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+					Verifier:   "allowed",
+					VerifiedAt: verifiedAt,
+					Error:      "no matching signatures",
+				},
+			},
+			expectResp: &debugv1.ListImageVerificationResultsResponse{
+				Results: []*debugv1.ListImageVerificationResultsResponse_Result{
+					{
+						ImageId:    "docker-registry.com/some/image@sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+						VerifiedAt: 1234,
+						Signatures: []*debugv1.ListImageVerificationResultsResponse_Signature{
+							{
+								VerifierType:   sigstore.CosignKeylessVerifierType,
+								Subject:        "spirex@example.com",
+								Issuer:         "https://accounts.example.com",
+								KeyId:          "0102",
+								RekorUuid:      "eb48155e4b08e1d0c69fdf91bb803b735e0c38c64bdcd1c08ea1b9774ec7b44d",
+								LogIndex:       42,
+								IntegratedTime: 12345,
+							},
+							{
+								VerifierType:  sigstore.CosignKeylessVerifierType,
+								Subject:       "someone@example.com",
+								FailureReason: "Signature subject is not allowed",
+							},
+						},
+					},
+					{
+						ImageId:    "docker-registry.com/other/image@sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+						Verifier:   "allowed",
+						VerifiedAt: 1234,
+						Error:      "no matching signatures",
+					},
+				},
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupServiceTest(t)
+			defer test.Cleanup()
+
+			test.results = tt.results
+
+			resp, err := test.client.ListImageVerificationResults(ctx, &debugv1.ListImageVerificationResultsRequest{})
+			require.NoError(t, err)
+			spiretest.RequireProtoEqual(t, tt.expectResp, resp)
+		})
+	}
+}
+
 type serviceTest struct {
 	client debugv1.DebugClient
 	done   func()
@@ -217,6 +306,7 @@ type serviceTest struct {
 	logHook *test.Hook
 	m       *fakeManager
 	uptime  *fakeUptime
+	results []sigstore.VerificationResult
 }
 
 func (s *serviceTest) Cleanup() {
@@ -234,21 +324,24 @@ func setupServiceTest(t *testing.T) *serviceTest {
 		clk:   clk,
 	}
 
+	test := &serviceTest{
+		clk:     clk,
+		logHook: logHook,
+		m:       manager,
+		uptime:  fakeUptime,
+	}
+
 	service := debug.New(debug.Config{
 		Clock:       clk,
 		Log:         log,
 		Manager:     manager,
 		TrustDomain: td,
 		Uptime:      fakeUptime.uptime,
+		ImageVerificationResults: func() []sigstore.VerificationResult {
+			return test.results
+		},
 	})
 
-	test := &serviceTest{
-		clk:     clk,
-		logHook: logHook,
-		m:       manager,
-		uptime:  fakeUptime,
-	}
-
 	registerFn := func(s *grpc.Server) {
 		debug.RegisterService(s, service)
 	}
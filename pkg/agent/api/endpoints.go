@@ -58,6 +58,8 @@ func (e *Endpoints) registerDebugAPI(server *grpc.Server) {
 		Manager:     e.c.Manager,
 		Uptime:      e.c.Uptime,
 		TrustDomain: e.c.TrustDomain,
+
+		ImageVerificationResults: e.c.ImageVerificationResults,
 	})
 
 	debug.RegisterService(server, service)
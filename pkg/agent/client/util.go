@@ -73,5 +73,6 @@ func slicedEntryFromProto(e *types.Entry) (*common.RegistrationEntry, error) {
 		RevisionNumber: e.RevisionNumber,
 		Selectors:      selectors,
 		StoreSvid:      e.StoreSvid,
+		JwtSvidTtl:     e.JwtSvidTtl,
 	}, nil
 }
@@ -185,16 +185,16 @@ func (m *manager) FetchJWTSVID(ctx context.Context, spiffeID spiffeid.ID, audien
 	now := m.clk.Now()
 
 	cachedSVID, ok := m.cache.GetJWTSVID(spiffeID, audience)
-	if ok && !rotationutil.JWTSVIDExpiresSoon(cachedSVID, now) {
+	entry := m.getEntry(spiffeID.String())
+	if ok && !rotationutil.JWTSVIDExpiresSoon(cachedSVID, now) && !jwtSVIDExceedsEntryTTL(cachedSVID, entry) {
 		return cachedSVID, nil
 	}
 
-	entryID := m.getEntryID(spiffeID.String())
-	if entryID == "" {
+	if entry == nil {
 		return nil, errors.New("no entry found")
 	}
 
-	newSVID, err := m.client.NewJWTSVID(ctx, entryID, audience)
+	newSVID, err := m.client.NewJWTSVID(ctx, entry.EntryId, audience)
 	switch {
 	case err == nil:
 	case cachedSVID == nil:
@@ -210,13 +210,23 @@ func (m *manager) FetchJWTSVID(ctx context.Context, spiffeID spiffeid.ID, audien
 	return newSVID, nil
 }
 
-func (m *manager) getEntryID(spiffeID string) string {
+func (m *manager) getEntry(spiffeID string) *common.RegistrationEntry {
 	for _, identity := range m.cache.Identities() {
 		if identity.Entry.SpiffeId == spiffeID {
-			return identity.Entry.EntryId
+			return identity.Entry
 		}
 	}
-	return ""
+	return nil
+}
+
+// jwtSVIDExceedsEntryTTL returns true if the JWT-SVID was issued with a
+// lifetime longer than the JWT-SVID TTL of the entry, which happens when the
+// TTL is lowered after the JWT-SVID was cached.
+func jwtSVIDExceedsEntryTTL(svid *client.JWTSVID, entry *common.RegistrationEntry) bool {
+	if entry == nil || entry.JwtSvidTtl <= 0 {
+		return false
+	}
+	return svid.ExpiresAt.Sub(svid.IssuedAt) > time.Duration(entry.JwtSvidTtl)*time.Second
 }
 
 func (m *manager) runSynchronizer(ctx context.Context) error {
@@ -899,6 +899,93 @@ func TestFetchJWTSVID(t *testing.T) {
 	require.Nil(t, svid)
 }
 
+func TestFetchJWTSVIDWithEntryJWTSVIDTTL(t *testing.T) {
+	dir := spiretest.TempDir(t)
+	km := fakeagentkeymanager.New(t, dir)
+
+	fetchResp := &svidv1.NewJWTSVIDResponse{}
+	var jwtSVIDTTL int32
+
+	clk := clock.NewMock(t)
+	api := newMockAPI(t, &mockAPIConfig{
+		km: km,
+		getAuthorizedEntries: func(*mockAPI, int32, *entryv1.GetAuthorizedEntriesRequest) (*entryv1.GetAuthorizedEntriesResponse, error) {
+			resp := makeGetAuthorizedEntriesResponse(t, "resp1", "resp2")
+			for _, entry := range resp.Entries {
+				entry.JwtSvidTtl = jwtSVIDTTL
+			}
+			return resp, nil
+		},
+		batchNewX509SVIDEntries: func(*mockAPI, int32) []*common.RegistrationEntry {
+			return makeBatchNewX509SVIDEntries("resp1", "resp2")
+		},
+		newJWTSVID: func(*mockAPI, *svidv1.NewJWTSVIDRequest) (*svidv1.NewJWTSVIDResponse, error) {
+			return fetchResp, nil
+		},
+		clk:     clk,
+		svidTTL: 200,
+	})
+
+	cat := fakeagentcatalog.New()
+	cat.SetKeyManager(km)
+
+	baseSVID, baseSVIDKey := api.newSVID(joinTokenID, 1*time.Hour)
+
+	c := &Config{
+		ServerAddr:      api.addr,
+		SVID:            baseSVID,
+		SVIDKey:         baseSVIDKey,
+		Log:             testLogger,
+		TrustDomain:     trustDomain,
+		SVIDCachePath:   path.Join(dir, "svid.der"),
+		BundleCachePath: path.Join(dir, "bundle.der"),
+		Bundle:          api.bundle,
+		Metrics:         &telemetry.Blackhole{},
+		Catalog:         cat,
+		Clk:             clk,
+		SVIDStoreCache:  storecache.New(&storecache.Config{TrustDomain: trustDomain, Log: testLogger}),
+	}
+
+	m := newManager(c)
+	require.NoError(t, m.Initialize(context.Background()))
+
+	spiffeID := spiffeid.RequireFromString("spiffe://example.org/blog")
+	audience := []string{"foo"}
+
+	now := clk.Now()
+	fetchResp.Svid = &types.JWTSVID{
+		Token:     "A",
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(time.Minute).Unix(),
+	}
+	svid, err := m.FetchJWTSVID(context.Background(), spiffeID, audience)
+	require.NoError(t, err)
+	require.Equal(t, "A", svid.Token)
+
+	// lower the JWT-SVID TTL of the entry below the lifetime of the cached
+	// JWT and make sure a new JWT is fetched
+	jwtSVIDTTL = 30
+	require.NoError(t, m.synchronize(context.Background()))
+	fetchResp.Svid = &types.JWTSVID{
+		Token:     "B",
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(30 * time.Second).Unix(),
+	}
+	svid, err = m.FetchJWTSVID(context.Background(), spiffeID, audience)
+	require.NoError(t, err)
+	require.Equal(t, "B", svid.Token)
+
+	// assert the new JWT is cached since it is within the entry TTL
+	fetchResp.Svid = &types.JWTSVID{
+		Token:     "C",
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(30 * time.Second).Unix(),
+	}
+	svid, err = m.FetchJWTSVID(context.Background(), spiffeID, audience)
+	require.NoError(t, err)
+	require.Equal(t, "B", svid.Token)
+}
+
 func TestStorableSVIDsSync(t *testing.T) {
 	dir := spiretest.TempDir(t)
 	km := fakeagentkeymanager.New(t, dir)
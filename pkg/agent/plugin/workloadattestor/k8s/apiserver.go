@@ -0,0 +1,155 @@
+package k8s
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/spiffe/spire/pkg/common/pemutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	kubeletPodInfoSource   = "kubelet"
+	apiServerPodInfoSource = "apiserver"
+
+	// Environment variables set by Kubernetes with the in-cluster address
+	// of the API server
+	serviceHostEnv = "KUBERNETES_SERVICE_HOST"
+	servicePortEnv = "KUBERNETES_SERVICE_PORT"
+)
+
+// apiServerClient lists the pods of the node from the Kubernetes API server
+type apiServerClient struct {
+	Transport *http.Transport
+	URL       url.URL
+	Token     string
+	NodeName  string
+}
+
+func (c *apiServerClient) GetPodList() (*corev1.PodList, error) {
+	url := c.URL
+	url.Path = "/api/v1/pods"
+	query := url.Query()
+	query.Set("fieldSelector", "spec.nodeName="+c.NodeName)
+	url.RawQuery = query.Encode()
+	return getPodList(c.Transport, url, c.Token)
+}
+
+// GetControllerOf returns the controller of the owner object in the given
+// namespace, or nil if the owner has no controller. The owner is looked up
+// by the group, resource and name in ownerResource.
+func (c *apiServerClient) GetControllerOf(namespace string, owner metav1.OwnerReference, resource ownerResource) (*metav1.OwnerReference, error) {
+	url := c.URL
+	if resource.Group == "" {
+		url.Path = path.Join("/api", owner.APIVersion, "namespaces", namespace, resource.Resource, owner.Name)
+	} else {
+		url.Path = path.Join("/apis", owner.APIVersion, "namespaces", namespace, resource.Resource, owner.Name)
+	}
+	object := new(metav1.PartialObjectMetadata)
+	if err := getObject(c.Transport, url, c.Token, resource.Resource, object); err != nil {
+		return nil, err
+	}
+
+	// The owner may have been deleted and recreated with the same name
+	if object.UID != owner.UID {
+		return nil, status.Errorf(codes.Internal, "%s %q has UID %q instead of %q", owner.Kind, owner.Name, object.UID, owner.UID)
+	}
+	return metav1.GetControllerOf(object), nil
+}
+
+// getPodInfoSources validates the pod information sources
+func getPodInfoSources(config *HCLConfig, nodeName string) ([]string, error) {
+	sources := config.PodInfoSources
+	if len(sources) == 0 {
+		sources = []string{kubeletPodInfoSource}
+	}
+
+	seen := make(map[string]bool)
+	for _, source := range sources {
+		switch {
+		case source != kubeletPodInfoSource && source != apiServerPodInfoSource:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown pod info source %q", source)
+		case seen[source]:
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate pod info source %q", source)
+		}
+		seen[source] = true
+	}
+	if seen[apiServerPodInfoSource] && nodeName == "" {
+		return nil, status.Error(codes.InvalidArgument, "the node name is required to get pod information from the API server")
+	}
+	return sources, nil
+}
+
+// getAPIServerURL determines the URL of the API server, defaulting to the
+// in-cluster URL.
+func (p *Plugin) getAPIServerURL(config *HCLConfig) (*url.URL, error) {
+	apiServerURL := config.APIServerURL
+	if apiServerURL == "" {
+		host, port := p.getenv(serviceHostEnv), p.getenv(servicePortEnv)
+		if host == "" || port == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "the API server URL is required when not running in a cluster (%s and %s are not set)", serviceHostEnv, servicePortEnv)
+		}
+		apiServerURL = "https://" + net.JoinHostPort(host, port)
+	}
+	u, err := url.Parse(apiServerURL)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to parse API server URL: %v", err)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid API server URL %q: must be an https URL", apiServerURL)
+	}
+	return u, nil
+}
+
+// reloadAPIServerClient loads the API server client when the API server is a
+// pod information source or resolves the pod owners, reloading the service account token and CA
+// certificates every reload interval.
+func (p *Plugin) reloadAPIServerClient(config *k8sConfig) error {
+	if config.APIServerURL == nil {
+		return nil
+	}
+
+	// Is the client still fresh?
+	if config.APIServerClient != nil && p.clock.Now().Sub(config.APIServerLastReload) < config.ReloadInterval {
+		return nil
+	}
+
+	caPath := config.APIServerCAPath
+	if caPath == "" {
+		caPath = defaultKubeletCAPath
+	}
+	caPEM, err := p.readFile(caPath)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "unable to load API server CA: %v", err)
+	}
+	certs, err := pemutil.ParseCertificates(caPEM)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "unable to parse API server CA: %v", err)
+	}
+
+	// The API server is always authenticated with the service account token
+	token, err := p.loadToken("")
+	if err != nil {
+		return err
+	}
+
+	config.APIServerClient = &apiServerClient{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs:    newCertPool(certs),
+				MinVersion: tls.VersionTLS12,
+			},
+		},
+		URL:      *config.APIServerURL,
+		Token:    token,
+		NodeName: config.NodeName,
+	}
+	config.APIServerLastReload = p.clock.Now()
+	return nil
+}
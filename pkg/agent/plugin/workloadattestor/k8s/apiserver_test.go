@@ -0,0 +1,274 @@
+package k8s
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	metricsv1 "github.com/spiffe/spire-plugin-sdk/proto/spire/hostservice/common/metrics/v1"
+	"github.com/spiffe/spire/pkg/agent/common/cgroups"
+	"github.com/spiffe/spire/pkg/agent/plugin/workloadattestor"
+	"github.com/spiffe/spire/pkg/common/hostservice/metricsservice"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	"github.com/spiffe/spire/test/fakes/fakemetrics"
+	"github.com/spiffe/spire/test/plugintest"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	apiServerCAPath = "apiserver-ca.pem"
+	testNodeName    = "k8s-node-1"
+)
+
+// fakeAPIServer serves the pods of a node like the Kubernetes API server
+type fakeAPIServer struct {
+	server   *httptest.Server
+	podList  []byte
+	objects  map[string]string
+	requests int
+}
+
+// startAPIServer starts a fake API server serving the pod list fixture to
+// requests authenticated with the default service account token.
+func (s *Suite) startAPIServer(fixturePath string) *fakeAPIServer {
+	podList, err := os.ReadFile(fixturePath)
+	s.Require().NoError(err)
+
+	cert := s.createKubeletCert("localhost")
+	s.writeCert(apiServerCAPath, cert)
+
+	fake := &fakeAPIServer{podList: podList, objects: make(map[string]string)}
+	fake.server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		fake.requests++
+		object, isObject := fake.objects[req.URL.Path]
+		switch {
+		case isObject && req.Header.Get("Authorization") == "Bearer default-token":
+			_, _ = w.Write([]byte(object))
+		case req.URL.Path != "/api/v1/pods":
+			http.NotFound(w, req)
+		case req.Header.Get("Authorization") != "Bearer default-token":
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+		case req.URL.Query().Get("fieldSelector") != "spec.nodeName="+testNodeName:
+			http.Error(w, fmt.Sprintf("unexpected field selector %q", req.URL.Query().Get("fieldSelector")), http.StatusBadRequest)
+		default:
+			_, _ = w.Write(fake.podList)
+		}
+	}))
+	fake.server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{
+			{
+				Certificate: [][]byte{cert.Raw},
+				PrivateKey:  kubeletKey,
+			},
+		},
+		MinVersion: tls.VersionTLS12,
+	}
+	fake.server.StartTLS()
+	s.T().Cleanup(fake.server.Close)
+	return fake
+}
+
+func (f *fakeAPIServer) port() string {
+	_, port, _ := net.SplitHostPort(f.server.Listener.Addr().String())
+	return port
+}
+
+func (f *fakeAPIServer) url() string {
+	return "https://localhost:" + f.port()
+}
+
+func (s *Suite) TestAttestViaAPIServerWhenKubeletIsUnreachable() {
+	apiServer := s.startAPIServer(podListFilePath)
+
+	// Start and stop the kubelet to get a port nothing listens on
+	s.startInsecureKubelet()
+	kubeletPort := s.kubeletPort()
+	s.server.Close()
+
+	metrics := fakemetrics.New()
+	v1 := new(workloadattestor.V1)
+	plugintest.Load(s.T(), builtin(s.newPlugin()), v1,
+		plugintest.HostServices(metricsv1.MetricsServiceServer(metricsservice.V1(metrics))),
+		plugintest.Configure(fmt.Sprintf(`
+			kubelet_read_only_port = %d
+			node_name = %q
+			pod_info_sources = ["kubelet", "apiserver"]
+			api_server_url = %q
+			api_server_ca_path = %q
+		`, kubeletPort, testNodeName, apiServer.url(), apiServerCAPath)))
+
+	s.addCgroupsResponse(cgPidInPodFilePath)
+	s.requireAttestSuccess(v1, testPodSelectors)
+	s.Require().Equal(1, apiServer.requests)
+
+	s.Require().Equal([]fakemetrics.MetricItem{
+		{
+			Type:   fakemetrics.IncrCounterWithLabelsType,
+			Key:    kubeletPodListRequestMetricKey,
+			Val:    1,
+			Labels: []telemetry.Label{{Name: telemetry.Status, Value: codes.Internal.String()}},
+		},
+		{
+			Type:   fakemetrics.IncrCounterWithLabelsType,
+			Key:    apiServerPodListRequestMetricKey,
+			Val:    1,
+			Labels: []telemetry.Label{{Name: telemetry.Status, Value: codes.OK.String()}},
+		},
+	}, metrics.AllMetrics())
+}
+
+func (s *Suite) TestAttestViaInClusterAPIServer() {
+	apiServer := s.startAPIServer(podListFilePath)
+	s.env[serviceHostEnv] = "localhost"
+	s.env[servicePortEnv] = apiServer.port()
+	s.env[defaultNodeNameEnv] = testNodeName
+	s.writeCert(defaultKubeletCAPath, apiServer.server.Certificate())
+
+	p := s.loadPlugin(`
+		pod_info_sources = ["apiserver", "kubelet"]
+	`)
+
+	s.addCgroupsResponse(cgPidInPodFilePath)
+	s.requireAttestSuccess(p, testPodSelectors)
+	s.Require().Equal(1, apiServer.requests)
+}
+
+func (s *Suite) TestAttestViaAPIServerFailure() {
+	apiServer := s.startAPIServer(podListFilePath)
+	s.writeFile(defaultTokenPath, "other-token")
+
+	p := s.loadPlugin(fmt.Sprintf(`
+		kubelet_read_only_port = 12345
+		node_name = %q
+		pod_info_sources = ["apiserver"]
+		api_server_url = %q
+		api_server_ca_path = %q
+	`, testNodeName, apiServer.url(), apiServerCAPath))
+
+	s.addCgroupsResponse(cgPidInPodFilePath)
+	selectors, err := p.Attest(context.Background(), pid)
+	s.RequireGRPCStatusContains(err, codes.Internal, "unexpected status code on pods response: 401")
+	s.Require().Nil(selectors)
+}
+
+func (s *Suite) TestConfigurePodInfoSources() {
+	s.writeCert(apiServerCAPath, s.createKubeletCert("localhost"))
+
+	for _, tt := range []struct {
+		name string
+		hcl  string
+		err  string
+	}{
+		{
+			name: "unknown source",
+			hcl:  `pod_info_sources = ["etcd"]`,
+			err:  `unknown pod info source "etcd"`,
+		},
+		{
+			name: "duplicate source",
+			hcl:  `pod_info_sources = ["kubelet", "kubelet"]`,
+			err:  `duplicate pod info source "kubelet"`,
+		},
+		{
+			name: "API server without node name",
+			hcl:  `pod_info_sources = ["apiserver"]`,
+			err:  "the node name is required to get pod information from the API server",
+		},
+		{
+			name: "API server outside of the cluster",
+			hcl: `
+				node_name = "k8s-node-1"
+				pod_info_sources = ["apiserver"]
+			`,
+			err: "the API server URL is required when not running in a cluster",
+		},
+		{
+			name: "insecure API server URL",
+			hcl: `
+				node_name = "k8s-node-1"
+				pod_info_sources = ["apiserver"]
+				api_server_url = "http://localhost:6443"
+			`,
+			err: "must be an https URL",
+		},
+		{
+			name: "missing API server CA",
+			hcl: `
+				node_name = "k8s-node-1"
+				pod_info_sources = ["apiserver"]
+				api_server_url = "https://localhost:6443"
+				api_server_ca_path = "no-such-file"
+			`,
+			err: "unable to load API server CA",
+		},
+		{
+			name: "API server",
+			hcl: `
+				node_name = "k8s-node-1"
+				pod_info_sources = ["kubelet", "apiserver"]
+				api_server_url = "https://localhost:6443"
+				api_server_ca_path = "apiserver-ca.pem"
+			`,
+		},
+	} {
+		tt := tt
+		s.T().Run(tt.name, func(t *testing.T) {
+			p := s.newPlugin()
+			var err error
+			plugintest.Load(t, builtin(p), nil,
+				plugintest.Configure(`
+					kubelet_read_only_port = 12345
+				`+tt.hcl),
+				plugintest.CaptureConfigureError(&err))
+			if tt.err != "" {
+				s.AssertGRPCStatusContains(err, codes.InvalidArgument, tt.err)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			c, err := p.getConfig()
+			if assert.NoError(t, err) && assert.NotNil(t, c.APIServerClient) {
+				assert.Equal(t, []string{kubeletPodInfoSource, apiServerPodInfoSource}, c.PodInfoSources)
+				assert.Equal(t, "https://localhost:6443", c.APIServerClient.URL.String())
+				assert.Equal(t, "default-token", c.APIServerClient.Token)
+				assert.Equal(t, testNodeName, c.APIServerClient.NodeName)
+			}
+		})
+	}
+}
+
+func TestGetPodUIDFromCGroups(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		cgroupPath string
+		podUID     string
+	}{
+		{
+			name:       "no pod",
+			cgroupPath: "/user.slice",
+		},
+		{
+			name:       "cgroupfs driver",
+			cgroupPath: "/kubepods/burstable/pod2c48913c-b29f-11e7-9350-020968147796/9bca8d63d5fa610783847915bcff0ecac1273e5b4bed3f6fa1b07350e0135961",
+			podUID:     "2c48913c-b29f-11e7-9350-020968147796",
+		},
+		{
+			name:       "systemd driver",
+			cgroupPath: "/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod72f7f152_440c_66ac_9084_e0fc1d8a910c.slice/cri-containerd-b2a102854b4969b2ce98dc329c86b4fb2b06e4ad2cc8da9d8a7578c9cd2004a2.scope",
+			podUID:     "72f7f152-440c-66ac-9084-e0fc1d8a910c",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			podUID := getPodUIDFromCGroups([]cgroups.Cgroup{{GroupPath: tt.cgroupPath}})
+			assert.Equal(t, tt.podUID, podUID)
+		})
+	}
+}
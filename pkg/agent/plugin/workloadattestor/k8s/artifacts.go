@@ -0,0 +1,63 @@
+package k8s
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// maxVerifiedArtifacts bounds the artifacts verified per attestation
+const maxVerifiedArtifacts = 10
+
+// parseArtifactReferences splits the annotation value on commas and
+// whitespace, dropping duplicates.
+func parseArtifactReferences(value string) []string {
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+
+	seen := make(map[string]bool)
+	var artifacts []string
+	for _, field := range fields {
+		if !seen[field] {
+			seen[field] = true
+			artifacts = append(artifacts, field)
+		}
+	}
+	return artifacts
+}
+
+// getArtifactSelectorValues verifies the signatures of the artifacts listed
+// in the pod annotation and returns an artifact-signature-subject selector per
+// artifact and accepted signature subject. Artifacts that fail verification
+// are logged and get no selectors.
+func (p *Plugin) getArtifactSelectorValues(config *k8sConfig, pod *corev1.Pod, log hclog.Logger) []string {
+	annotation := config.VerifyArtifactsAnnotation
+	value, ok := pod.Annotations[annotation]
+	if !ok {
+		return nil
+	}
+
+	artifacts := parseArtifactReferences(value)
+	if len(artifacts) > maxVerifiedArtifacts {
+		log.Warn("Too many artifacts to verify; ignoring the rest", "annotation", annotation, "count", len(artifacts), "max", maxVerifiedArtifacts)
+		artifacts = artifacts[:maxVerifiedArtifacts]
+	}
+
+	var selectorValues []string
+	for _, artifact := range artifacts {
+		subjects, err := config.Sigstore.AttestArtifactSignatures(artifact)
+		if err != nil {
+			log.Warn("Unable to verify artifact signatures", "artifact", artifact, telemetry.Error, err)
+			continue
+		}
+		for _, subject := range subjects {
+			selectorValues = append(selectorValues, fmt.Sprintf("artifact-signature-subject:%s:%s", artifact, subject))
+		}
+	}
+	return selectorValues
+}
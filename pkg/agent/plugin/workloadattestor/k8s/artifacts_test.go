@@ -0,0 +1,104 @@
+package k8s
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/spiffe/spire/pkg/agent/plugin/workloadattestor"
+	"github.com/spiffe/spire/pkg/common/util"
+	"github.com/spiffe/spire/proto/spire/common"
+	"github.com/spiffe/spire/test/plugintest"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testArtifact        = "docker-registry.com/some/module@" + testImageDigest
+	otherTestArtifact   = "docker-registry.com/other/module@" + otherImageDigest
+	failingTestArtifact = "docker-registry.com/unsigned/module@" + testImageDigest
+)
+
+func (s *Suite) TestAttestWithArtifactSignatures() {
+	s.startInsecureKubelet()
+	p := s.newPlugin()
+	sigstoreMock(p).artifactSubjects = map[string][]string{
+		testArtifact:      {"spirex@example.com"},
+		otherTestArtifact: {"build@example.com", "release@example.com"},
+	}
+	v1 := new(workloadattestor.V1)
+	plugintest.Load(s.T(), builtin(p), v1, plugintest.Configure(fmt.Sprintf(`
+		kubelet_read_only_port = %d
+		verify_artifacts_annotation = "spire.io/verify-artifacts"
+	`, s.kubeletPort())))
+
+	podList, err := os.ReadFile(kindPodListFilePath)
+	s.Require().NoError(err)
+	annotation := strings.Join([]string{testArtifact, otherTestArtifact, failingTestArtifact}, ", ")
+	s.podList = append(s.podList, bytes.Replace(podList,
+		[]byte(`"labels": {`),
+		[]byte(`"annotations": {"spire.io/verify-artifacts": "`+annotation+`"}, "labels": {`), 1))
+	s.addCgroupsResponse(cgPidInKindPodFilePath)
+
+	expected := append([]*common.Selector{
+		{Type: "k8s", Value: "artifact-signature-subject:" + testArtifact + ":spirex@example.com"},
+		{Type: "k8s", Value: "artifact-signature-subject:" + otherTestArtifact + ":build@example.com"},
+		{Type: "k8s", Value: "artifact-signature-subject:" + otherTestArtifact + ":release@example.com"},
+	}, testKindPodSelectors...)
+	util.SortSelectors(expected)
+	s.requireAttestSuccess(v1, expected)
+}
+
+func (s *Suite) TestAttestWithArtifactSignaturesDisabled() {
+	s.startInsecureKubelet()
+	p := s.newPlugin()
+	sigstoreMock(p).artifactSubjects = map[string][]string{
+		testArtifact: {"spirex@example.com"},
+	}
+	v1 := new(workloadattestor.V1)
+	plugintest.Load(s.T(), builtin(p), v1, plugintest.Configure(fmt.Sprintf(`
+		kubelet_read_only_port = %d
+	`, s.kubeletPort())))
+
+	podList, err := os.ReadFile(kindPodListFilePath)
+	s.Require().NoError(err)
+	s.podList = append(s.podList, bytes.Replace(podList,
+		[]byte(`"labels": {`),
+		[]byte(`"annotations": {"spire.io/verify-artifacts": "`+testArtifact+`"}, "labels": {`), 1))
+	s.addCgroupsResponse(cgPidInKindPodFilePath)
+
+	s.requireAttestSuccess(v1, testKindPodSelectors)
+}
+
+func TestParseArtifactReferences(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		value    string
+		expected []string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:     "single",
+			value:    testArtifact,
+			expected: []string{testArtifact},
+		},
+		{
+			name:     "commas and whitespace",
+			value:    " " + testArtifact + ",\n\t" + otherTestArtifact + " ,, ",
+			expected: []string{testArtifact, otherTestArtifact},
+		},
+		{
+			name:     "duplicates",
+			value:    testArtifact + " " + otherTestArtifact + " " + testArtifact,
+			expected: []string{testArtifact, otherTestArtifact},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseArtifactReferences(tt.value))
+		})
+	}
+}
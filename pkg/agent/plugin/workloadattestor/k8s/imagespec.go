@@ -0,0 +1,68 @@
+package k8s
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/spiffe/spire/pkg/agent/plugin/workloadattestor/k8s/sigstore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// verifyContainerImage checks that the image running in the container is the
+// one requested by the pod spec: the repositories must match and, when the
+// spec pins a digest, so must the digests. It returns whether the spec image
+// is pinned to the running digest.
+func verifyContainerImage(pod *corev1.Pod, containerStatus *corev1.ContainerStatus) (bool, error) {
+	specImage, ok := getContainerSpecImage(pod, containerStatus.Name)
+	if !ok {
+		return false, status.Errorf(codes.Internal, "container %q not found in the pod spec", containerStatus.Name)
+	}
+	specRef, err := name.ParseReference(specImage)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "unable to parse pod spec image %q: %v", specImage, err)
+	}
+	runningRef, err := sigstore.ImageReference(containerStatus)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "unable to parse running image ID %q: %v", containerStatus.ImageID, err)
+	}
+
+	if specRef.Context().Name() != runningRef.Context().Name() {
+		return false, status.Errorf(codes.Internal, "running image %q is not from the pod spec image repository %q", runningRef.String(), specRef.Context().Name())
+	}
+	specDigest, ok := specRef.(name.Digest)
+	if !ok {
+		return false, nil
+	}
+	if specDigest.DigestStr() != runningRef.DigestStr() {
+		return false, status.Errorf(codes.Internal, "running image digest %q does not match the pod spec image digest %q", runningRef.DigestStr(), specDigest.DigestStr())
+	}
+	return true, nil
+}
+
+// getContainerSpecImage returns the image of the container, init container or
+// ephemeral container with the given name in the pod spec.
+func getContainerSpecImage(pod *corev1.Pod, containerName string) (string, bool) {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == containerName {
+			return container.Image, true
+		}
+	}
+	for _, container := range pod.Spec.InitContainers {
+		if container.Name == containerName {
+			return container.Image, true
+		}
+	}
+	for _, container := range pod.Spec.EphemeralContainers {
+		if container.Name == containerName {
+			return container.Image, true
+		}
+	}
+	return "", false
+}
+
+func getImagePinnedSelectorValue(pinned bool) string {
+	return fmt.Sprintf("container-image-pinned:%s", strconv.FormatBool(pinned))
+}
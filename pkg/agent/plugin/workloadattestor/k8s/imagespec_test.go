@@ -0,0 +1,155 @@
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/spiffe/spire/pkg/common/util"
+	"github.com/spiffe/spire/proto/spire/common"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	testImageDigest  = "sha256:1e4c481d76e9ecbd3d8684891e0e46aa021a30920ca04936e1fdcc552747d941"
+	otherImageDigest = "sha256:0cfdaced91cb46dd7af48309799a3c351e4ca2d5e1ee9737ca0cbd932cb79898"
+)
+
+func (s *Suite) TestAttestWithImageSpecVerification() {
+	s.startInsecureKubelet()
+	p := s.loadPlugin(fmt.Sprintf(`
+		kubelet_read_only_port = %d
+		verify_image_spec = true
+	`, s.kubeletPort()))
+
+	expected := append([]*common.Selector{
+		{Type: "k8s", Value: "container-image-pinned:false"},
+	}, testKindPodSelectors...)
+	util.SortSelectors(expected)
+
+	s.addPodListResponse(kindPodListFilePath)
+	s.addCgroupsResponse(cgPidInKindPodFilePath)
+	s.requireAttestSuccess(p, expected)
+}
+
+func (s *Suite) TestAttestWithImageSpecVerificationFailure() {
+	s.startInsecureKubelet()
+	p := s.loadPlugin(fmt.Sprintf(`
+		kubelet_read_only_port = %d
+		verify_image_spec = true
+	`, s.kubeletPort()))
+
+	// The pod spec, which comes before the status, pins another digest than
+	// the running one
+	podList, err := os.ReadFile(kindPodListFilePath)
+	s.Require().NoError(err)
+	s.podList = append(s.podList, bytes.Replace(podList,
+		[]byte(`"image": "gcr.io/spiffe-io/spire-agent:0.8.1"`),
+		[]byte(`"image": "gcr.io/spiffe-io/spire-agent@`+otherImageDigest+`"`), 1))
+	s.addCgroupsResponse(cgPidInKindPodFilePath)
+
+	selectors, err := p.Attest(context.Background(), pid)
+	s.RequireGRPCStatusContains(err, codes.Internal, "does not match the pod spec image digest")
+	s.Require().Nil(selectors)
+}
+
+func TestVerifyContainerImage(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		specImage  string
+		image      string
+		imageID    string
+		wantPinned bool
+		wantErr    string
+	}{
+		{
+			name:      "tag",
+			specImage: "gcr.io/spiffe-io/spire-agent:0.8.1",
+			image:     "gcr.io/spiffe-io/spire-agent:0.8.1",
+			imageID:   "gcr.io/spiffe-io/spire-agent@" + testImageDigest,
+		},
+		{
+			name:       "pinned digest",
+			specImage:  "gcr.io/spiffe-io/spire-agent@" + testImageDigest,
+			image:      "gcr.io/spiffe-io/spire-agent@" + testImageDigest,
+			imageID:    "docker-pullable://gcr.io/spiffe-io/spire-agent@" + testImageDigest,
+			wantPinned: true,
+		},
+		{
+			name:       "pinned tag and digest",
+			specImage:  "gcr.io/spiffe-io/spire-agent:0.8.1@" + testImageDigest,
+			image:      "gcr.io/spiffe-io/spire-agent:0.8.1",
+			imageID:    "gcr.io/spiffe-io/spire-agent@" + testImageDigest,
+			wantPinned: true,
+		},
+		{
+			name:       "docker hub short name",
+			specImage:  "nginx@" + testImageDigest,
+			image:      "docker.io/library/nginx@" + testImageDigest,
+			imageID:    "docker.io/library/nginx@" + testImageDigest,
+			wantPinned: true,
+		},
+		{
+			name:       "image ID without repository",
+			specImage:  "gcr.io/spiffe-io/spire-agent@" + testImageDigest,
+			image:      "gcr.io/spiffe-io/spire-agent@" + testImageDigest,
+			imageID:    testImageDigest,
+			wantPinned: true,
+		},
+		{
+			name:      "pinned digest mismatch",
+			specImage: "gcr.io/spiffe-io/spire-agent@" + otherImageDigest,
+			image:     "gcr.io/spiffe-io/spire-agent:0.8.1",
+			imageID:   "gcr.io/spiffe-io/spire-agent@" + testImageDigest,
+			wantErr:   `running image digest "` + testImageDigest + `" does not match the pod spec image digest "` + otherImageDigest + `"`,
+		},
+		{
+			name:      "repository mismatch",
+			specImage: "gcr.io/spiffe-io/spire-agent:0.8.1",
+			image:     "gcr.io/spiffe-io/spire-agent:0.8.1",
+			imageID:   "gcr.io/evil/spire-agent@" + testImageDigest,
+			wantErr:   `running image "gcr.io/evil/spire-agent@` + testImageDigest + `" is not from the pod spec image repository "gcr.io/spiffe-io/spire-agent"`,
+		},
+		{
+			name:      "invalid spec image",
+			specImage: "gcr.io/spiffe-io/SPIRE-agent",
+			image:     "gcr.io/spiffe-io/spire-agent:0.8.1",
+			imageID:   "gcr.io/spiffe-io/spire-agent@" + testImageDigest,
+			wantErr:   `unable to parse pod spec image "gcr.io/spiffe-io/SPIRE-agent"`,
+		},
+		{
+			name:      "missing image ID",
+			specImage: "gcr.io/spiffe-io/spire-agent:0.8.1",
+			image:     "gcr.io/spiffe-io/spire-agent:0.8.1",
+			wantErr:   `unable to parse running image ID ""`,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{{Name: "init", Image: "busybox"}},
+					Containers:     []corev1.Container{{Name: "workload", Image: tt.specImage}},
+				},
+			}
+			containerStatus := &corev1.ContainerStatus{
+				Name:    "workload",
+				Image:   tt.image,
+				ImageID: tt.imageID,
+			}
+			pinned, err := verifyContainerImage(pod, containerStatus)
+			if tt.wantErr != "" {
+				assert.Equal(t, codes.Internal, status.Code(err))
+				assert.Contains(t, status.Convert(err).Message(), tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPinned, pinned)
+		})
+	}
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/hcl"
 
+	"github.com/spiffe/spire-plugin-sdk/pluginsdk"
+	metricsv1 "github.com/spiffe/spire-plugin-sdk/proto/spire/hostservice/common/metrics/v1"
 	workloadattestorv1 "github.com/spiffe/spire-plugin-sdk/proto/spire/plugin/agent/workloadattestor/v1"
 	configv1 "github.com/spiffe/spire-plugin-sdk/proto/spire/service/common/config/v1"
 	"github.com/spiffe/spire/pkg/agent/common/cgroups"
@@ -35,16 +37,33 @@ import (
 )
 
 const (
-	pluginName               = "k8s"
-	defaultMaxPollAttempts   = 60
-	defaultPollRetryInterval = time.Millisecond * 500
-	defaultSecureKubeletPort = 10250
-	defaultKubeletCAPath     = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
-	defaultTokenPath         = "/var/run/secrets/kubernetes.io/serviceaccount/token" //nolint: gosec // false positive
-	defaultNodeNameEnv       = "MY_NODE_NAME"
-	defaultReloadInterval    = time.Minute
+	pluginName                  = "k8s"
+	defaultMaxPollAttempts      = 60
+	defaultPollRetryInterval    = time.Millisecond * 500
+	defaultSecureKubeletPort    = 10250
+	defaultKubeletCAPath        = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+	defaultTokenPath            = "/var/run/secrets/kubernetes.io/serviceaccount/token" //nolint: gosec // false positive
+	defaultNodeNameEnv          = "MY_NODE_NAME"
+	defaultReloadInterval       = time.Minute
+	defaultPodListCacheInterval = 5 * time.Second
 )
 
+// rekorInconsistencyMetricKey is the counter incremented each time the rekor
+// transparency log is found inconsistent with the persisted checkpoint
+var rekorInconsistencyMetricKey = []string{"k8s", "sigstore", "rekor_inconsistency"}
+
+// kubeletPodListRequestMetricKey is the counter of the pod list requests sent
+// to the kubelet, labeled by status
+var kubeletPodListRequestMetricKey = []string{"k8s", "kubelet", "pod_list_request"}
+
+// apiServerPodListRequestMetricKey is the counter of the pod list requests
+// sent to the API server, labeled by status
+var apiServerPodListRequestMetricKey = []string{"k8s", "apiserver", "pod_list_request"}
+
+// podListCacheHitMetricKey is the counter of attestations served from the
+// cached pod list
+var podListCacheHitMetricKey = []string{"k8s", "pod_list_cache", "hit"}
+
 type containerLookup int
 
 const (
@@ -129,6 +148,108 @@ type HCLConfig struct {
 
 	// AllowedSubjects is a list of subjects that should be allowed after verification
 	AllowedSubjects []string `hcl:"allowed_subjects_list"`
+
+	// SignatureVerifiers configures signature verification backends by name.
+	// Each verifier is used for the images matching its image patterns.
+	SignatureVerifiers map[string]*sigstore.VerifierConfig `hcl:"signature_verifiers"`
+
+	// SignatureSelectors are the kinds of selectors emitted for the verified
+	// signatures, e.g. "subject" or "content-hash". Defaults to the subject,
+	// content, log ID, integrated time, trust store, docker reference and
+	// annotation selectors.
+	SignatureSelectors []string `hcl:"signature_selectors"`
+
+	// RekorCheckpointPath is the file where the last verified rekor
+	// checkpoint is persisted. When set, the inclusion proofs of the
+	// signatures are verified against the signed tree heads of the log.
+	RekorCheckpointPath string `hcl:"rekor_checkpoint_path"`
+
+	// RekorPublicKeyPath is the path to the public key that signs the rekor
+	// tree heads. The key is fetched from the rekor server if unset.
+	RekorPublicKeyPath string `hcl:"rekor_public_key_path"`
+
+	// MaxSignatureAge rejects signatures integrated into the rekor log
+	// longer ago than this duration (e.g. "2160h").
+	MaxSignatureAge string `hcl:"max_signature_age"`
+
+	// SignedAfter rejects signatures integrated into the rekor log before
+	// this RFC 3339 timestamp.
+	SignedAfter string `hcl:"signed_after"`
+
+	// RevocationListPath is the path to a JSON file with the revoked
+	// signature subjects and rekor log entries. It is reloaded every
+	// ReloadInterval.
+	RevocationListPath string `hcl:"revocation_list_path"`
+
+	// MaxConcurrentVerifications bounds the signature verifications in
+	// flight against the registries and the rekor log.
+	MaxConcurrentVerifications int `hcl:"max_concurrent_verifications"`
+
+	// VerificationRateLimit is the number of signature verifications
+	// started per second, with bursts of up to VerificationRateBurst.
+	VerificationRateLimit float64 `hcl:"verification_rate_limit"`
+	VerificationRateBurst int     `hcl:"verification_rate_burst"`
+
+	// VerificationMaxWait bounds how long a verification waits for the
+	// concurrency and rate limits (e.g. "10s").
+	VerificationMaxWait string `hcl:"verification_max_wait"`
+
+	// VerificationFailureThreshold is the number of consecutive failures
+	// reaching the registries or the rekor log that stops the verifications
+	// for VerificationBackoff, doubling up to VerificationMaxBackoff.
+	VerificationFailureThreshold int    `hcl:"verification_failure_threshold"`
+	VerificationBackoff          string `hcl:"verification_backoff"`
+	VerificationMaxBackoff       string `hcl:"verification_max_backoff"`
+
+	// SignatureCacheTTL is how long verification results are cached before
+	// the image is verified again (e.g. "1h").
+	SignatureCacheTTL string `hcl:"signature_cache_ttl"`
+
+	// ServeStaleSignatures serves expired cached verification results while
+	// the registries or the rekor log are unavailable.
+	ServeStaleSignatures bool `hcl:"serve_stale_signatures"`
+
+	// SigstorePolicyPath is the path to a YAML or JSON signing policy file,
+	// e.g. a mounted ConfigMap, holding the skip list, allowed subjects and
+	// signature verifiers. The file is reloaded every ReloadInterval.
+	SigstorePolicyPath string `hcl:"sigstore_policy_path"`
+
+	// PodListCacheInterval is how long the kubelet pod list is cached and
+	// shared between attestations. Containers missing from the cached list
+	// trigger an immediate refresh.
+	PodListCacheInterval string `hcl:"pod_list_cache_interval"`
+
+	// PodInfoSources are the sources of pod information, "kubelet" and
+	// "apiserver", in order of preference. Defaults to the kubelet only.
+	PodInfoSources []string `hcl:"pod_info_sources"`
+
+	// APIServerURL is the URL of the Kubernetes API server. Defaults to the
+	// in-cluster URL derived from the KUBERNETES_SERVICE_HOST and
+	// KUBERNETES_SERVICE_PORT environment variables.
+	APIServerURL string `hcl:"api_server_url"`
+
+	// APIServerCAPath is the path to the CA certificates used to verify the
+	// API server certificate. Defaults to the cluster CA bundle.
+	APIServerCAPath string `hcl:"api_server_ca_path"`
+
+	// PodAnnotationSelectors are the pod annotation keys emitted as
+	// pod-annotation selectors.
+	PodAnnotationSelectors []string `hcl:"pod_annotation_selectors"`
+
+	// PodMetadataSelectors are the additional pod selectors to emit:
+	// "runtime-class", "host-network", "priority-class" and
+	// "pod-owner-root". The root owner is resolved through the API server.
+	PodMetadataSelectors []string `hcl:"pod_metadata_selectors"`
+
+	// VerifyImageSpec checks that the running image matches the image
+	// requested by the pod spec before issuing selectors, and emits the
+	// container-image-pinned selector.
+	VerifyImageSpec bool `hcl:"verify_image_spec"`
+
+	// VerifyArtifactsAnnotation is the pod annotation listing the OCI
+	// artifacts whose signatures are verified with the sigstore policy,
+	// e.g. "spire.io/verify-artifacts". Disabled when empty.
+	VerifyArtifactsAnnotation string `hcl:"verify_artifacts_annotation"`
 }
 
 // k8sConfig holds the configuration distilled from HCL
@@ -151,8 +272,34 @@ type k8sConfig struct {
 	AllowedSubjectListEnabled bool
 	AllowedSubjects           []string
 
-	Client     *kubeletClient
-	LastReload time.Time
+	SignatureVerifiers map[string]*sigstore.VerifierConfig
+	SignatureSelectors []string
+
+	RekorCheckpointPath string
+	RekorPublicKeyPath  string
+
+	SignaturePolicy *sigstore.SignaturePolicyConfig
+	RemoteLimits    *sigstore.RemoteLimitsConfig
+
+	SigstorePolicyPath string
+	Sigstore           sigstore.Sigstore
+
+	Client       *kubeletClient
+	LastReload   time.Time
+	PodListCache *podListCache
+
+	PodInfoSources      []string
+	APIServerURL        *url.URL
+	APIServerCAPath     string
+	APIServerClient     *apiServerClient
+	APIServerLastReload time.Time
+
+	PodSelectors *podSelectorsConfig
+	OwnerRoots   *ownerRootCache
+
+	VerifyImageSpec bool
+
+	VerifyArtifactsAnnotation string
 }
 
 type Plugin struct {
@@ -167,22 +314,31 @@ type Plugin struct {
 	mu     sync.RWMutex
 	config *k8sConfig
 
-	sigstore sigstore.Sigstore
+	newSigstore func(logger hclog.Logger) sigstore.Sigstore
+	metrics     metricsv1.MetricsServiceClient
 }
 
 func New() *Plugin {
 	newcache := sigstore.NewCache(maximumAmountCache)
 	return &Plugin{
-		fs:       cgroups.OSFileSystem{},
-		clock:    clock.New(),
-		getenv:   os.Getenv,
-		sigstore: sigstore.New(newcache, nil),
+		fs:     cgroups.OSFileSystem{},
+		clock:  clock.New(),
+		getenv: os.Getenv,
+		newSigstore: func(logger hclog.Logger) sigstore.Sigstore {
+			return sigstore.New(newcache, logger)
+		},
 	}
 }
 
 func (p *Plugin) SetLogger(log hclog.Logger) {
 	p.log = log
-	p.sigstore.SetLogger(log)
+}
+
+// BrokerHostServices obtains the metrics host service, used to report rekor
+// transparency log inconsistencies. Metrics are optional.
+func (p *Plugin) BrokerHostServices(broker pluginsdk.ServiceBroker) error {
+	broker.BrokerClient(&p.metrics)
+	return nil
 }
 
 func (p *Plugin) Attest(ctx context.Context, req *workloadattestorv1.AttestRequest) (*workloadattestorv1.AttestResponse, error) {
@@ -191,7 +347,7 @@ func (p *Plugin) Attest(ctx context.Context, req *workloadattestorv1.AttestReque
 		return nil, err
 	}
 
-	containerID, err := p.getContainerIDFromCGroups(req.Pid)
+	containerID, podUID, err := p.getContainerIDFromCGroups(req.Pid)
 	if err != nil {
 		return nil, err
 	}
@@ -208,30 +364,59 @@ func (p *Plugin) Attest(ctx context.Context, req *workloadattestorv1.AttestReque
 	for attempt := 1; ; attempt++ {
 		log = log.With(telemetry.Attempt, attempt)
 
-		list, err := config.Client.GetPodList()
+		// Only the first attempt may use the cached pod list, the next ones
+		// wait for the container to show up in a fresh one.
+		fetchPodList := func() (*corev1.PodList, error) {
+			return p.fetchPodList(config, log)
+		}
+		list, cached, err := config.PodListCache.GetPodList(fetchPodList, attempt > 1)
 		if err != nil {
 			return nil, err
 		}
+		pod, containerStatus := findContainerInPodList(containerID, podUID, list)
+		if pod == nil && cached {
+			// The container may have started after the list was cached
+			list, _, err = config.PodListCache.GetPodList(fetchPodList, true)
+			if err != nil {
+				return nil, err
+			}
+			pod, containerStatus = findContainerInPodList(containerID, podUID, list)
+		}
 
-		for _, item := range list.Items {
-			item := item
-			status, lookup := lookUpContainerInPod(containerID, item.Status)
-			switch lookup {
-			case containerInPod:
-				selectors := getSelectorValuesFromPodInfo(&item, status)
-				log.Debug("Attemping to get signature info from image", status)
-				sigstoreSelectors, err := p.sigstore.AttestContainerSignatures(status)
+		if pod != nil {
+			var pinned bool
+			if config.VerifyImageSpec {
+				pinned, err = verifyContainerImage(pod, containerStatus)
 				if err != nil {
-					log.Error("Error retrieving signature payload: ", err.Error())
-				} else {
-					selectors = append(selectors, sigstoreSelectors...)
+					log.Error("Container image verification failed", telemetry.Error, err)
+					return nil, err
 				}
+			}
 
-				return &workloadattestorv1.AttestResponse{
-					SelectorValues: selectors,
-				}, nil
-			case containerNotInPod:
+			selectors := getSelectorValuesFromPodInfo(pod, containerStatus)
+			if config.VerifyImageSpec {
+				selectors = append(selectors, getImagePinnedSelectorValue(pinned))
+			}
+			selectors = append(selectors, getPodMetadataSelectorValues(pod, config.PodSelectors)...)
+			if config.PodSelectors.OwnerRoot {
+				if selector, ok := p.getOwnerRootSelectorValue(config, pod, log); ok {
+					selectors = append(selectors, selector)
+				}
+			}
+			log.Debug("Attemping to get signature info from image", containerStatus)
+			sigstoreSelectors, err := config.Sigstore.AttestContainerSignatures(containerStatus)
+			if err != nil {
+				log.Error("Error retrieving signature payload: ", err.Error())
+			} else {
+				selectors = append(selectors, sigstoreSelectors...)
 			}
+			if config.VerifyArtifactsAnnotation != "" {
+				selectors = append(selectors, p.getArtifactSelectorValues(config, pod, log)...)
+			}
+
+			return &workloadattestorv1.AttestResponse{
+				SelectorValues: selectors,
+			}, nil
 		}
 
 		// if the container was not located after the maximum number of attempts then the search is over.
@@ -288,6 +473,35 @@ func (p *Plugin) Configure(ctx context.Context, req *configv1.ConfigureRequest)
 		reloadInterval = defaultReloadInterval
 	}
 
+	// Determine pod list cache interval
+	podListCacheInterval := defaultPodListCacheInterval
+	if config.PodListCacheInterval != "" {
+		podListCacheInterval, err = time.ParseDuration(config.PodListCacheInterval)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unable to parse pod list cache interval: %v", err)
+		}
+		if podListCacheInterval < 0 {
+			return nil, status.Error(codes.InvalidArgument, "pod list cache interval cannot be negative")
+		}
+	}
+
+	// Determine the signature freshness and revocation policy
+	signaturePolicy, err := buildSignaturePolicy(config, reloadInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	// Determine the limits of the verifications reaching remote services
+	remoteLimits, err := buildRemoteLimits(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// The signing policy file replaces the inline sigstore settings
+	if config.SigstorePolicyPath != "" && (len(config.SkippedImages) > 0 || config.AllowedSubjectListEnabled || len(config.AllowedSubjects) > 0 || len(config.SignatureVerifiers) > 0) {
+		return nil, status.Error(codes.InvalidArgument, "sigstore_policy_path cannot be used with skip_signature_verification_image_list, enable_allowed_subjects_list, allowed_subjects_list or signature_verifiers")
+	}
+
 	// Determine which kubelet port to hit. Default to the secure port if none
 	// is specified (this is backwards compatible because the read-only-port
 	// config value has always been required, so it should already be set in
@@ -309,6 +523,31 @@ func (p *Plugin) Configure(ctx context.Context, req *configv1.ConfigureRequest)
 	// Determine the node name
 	nodeName := p.getNodeName(config.NodeName, config.NodeNameEnv)
 
+	// Determine the pod information sources
+	podInfoSources, err := getPodInfoSources(config, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Determine the opt-in pod selectors
+	podSelectors, err := getPodSelectorsConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// The API server is needed to list the pods or resolve the pod owners
+	needsAPIServer := podSelectors.OwnerRoot
+	for _, source := range podInfoSources {
+		needsAPIServer = needsAPIServer || source == apiServerPodInfoSource
+	}
+	var apiServerURL *url.URL
+	if needsAPIServer {
+		apiServerURL, err = p.getAPIServerURL(config)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Configure the kubelet client
 	c := &k8sConfig{
 		Secure:                  secure,
@@ -327,36 +566,254 @@ func (p *Plugin) Configure(ctx context.Context, req *configv1.ConfigureRequest)
 		SkippedImages:             config.SkippedImages,
 		AllowedSubjectListEnabled: config.AllowedSubjectListEnabled,
 		AllowedSubjects:           config.AllowedSubjects,
+		SignatureVerifiers:        config.SignatureVerifiers,
+		SignatureSelectors:        config.SignatureSelectors,
+		RekorCheckpointPath:       config.RekorCheckpointPath,
+		RekorPublicKeyPath:        config.RekorPublicKeyPath,
+		SignaturePolicy:           signaturePolicy,
+		RemoteLimits:              remoteLimits,
+		SigstorePolicyPath:        config.SigstorePolicyPath,
+		PodListCache:              newPodListCache(p.clock, podListCacheInterval, p.reportPodListCacheHit),
+		PodInfoSources:            podInfoSources,
+		APIServerURL:              apiServerURL,
+		APIServerCAPath:           config.APIServerCAPath,
+		PodSelectors:              podSelectors,
+		OwnerRoots:                newOwnerRootCache(p.clock),
+		VerifyImageSpec:           config.VerifyImageSpec,
+		VerifyArtifactsAnnotation: config.VerifyArtifactsAnnotation,
 	}
 	if err := p.reloadKubeletClient(c); err != nil {
 		return nil, err
 	}
+	if err := p.reloadAPIServerClient(c); err != nil {
+		return nil, err
+	}
+
+	c.Sigstore, err = p.buildSigstore(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set the config, and expose the verification results of the new
+	// verifier through the agent debug API
+	p.setConfig(c)
+	sigstore.Publish(pluginName, c.Sigstore)
+	return &configv1.ConfigureResponse{}, nil
+}
+
+// buildSigstore builds the signature verifier of the configuration. A new
+// verifier is built on every configuration and swapped in with the rest of
+// the configuration, so that a configuration that fails validation is not
+// partially applied and attestations in progress keep their settings.
+func (p *Plugin) buildSigstore(c *k8sConfig) (sigstore.Sigstore, error) {
+	verifier := p.newSigstore(p.log)
+	for _, imageID := range c.SkippedImages {
+		verifier.AddSkippedImage(imageID)
+	}
 
-	// Configure sigstore settings
-	p.sigstore.ClearSkipList()
-	if c.SkippedImages != nil {
-		for _, imageID := range c.SkippedImages {
-			p.sigstore.AddSkippedImage(imageID)
+	verifier.EnableAllowSubjectList(c.AllowedSubjectListEnabled)
+	for _, subject := range c.AllowedSubjects {
+		verifier.AddAllowedSubject(subject)
+	}
+	if c.RekorURL != "" {
+		if err := verifier.SetRekorURL(c.RekorURL); err != nil {
+			return nil, err
+		}
+	}
+	var transparencyLog *sigstore.TransparencyLogConfig
+	if c.RekorCheckpointPath != "" {
+		transparencyLog = &sigstore.TransparencyLogConfig{
+			CheckpointPath:  c.RekorCheckpointPath,
+			PublicKeyPath:   c.RekorPublicKeyPath,
+			OnInconsistency: p.reportRekorInconsistency,
 		}
 	}
+	if err := verifier.SetTransparencyLog(transparencyLog); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to configure transparency log verification: %v", err)
+	}
+	if err := verifier.SetVerifiers(c.SignatureVerifiers); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to configure signature verifiers: %v", err)
+	}
+	if err := verifier.SetSelectorKinds(c.SignatureSelectors); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to configure signature selectors: %v", err)
+	}
+	if err := verifier.SetSignaturePolicy(c.SignaturePolicy); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to configure signature policy: %v", err)
+	}
+	if err := verifier.SetRemoteLimits(c.RemoteLimits); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to configure verification limits: %v", err)
+	}
+	var signingPolicyFile *sigstore.SigningPolicyFileConfig
+	if c.SigstorePolicyPath != "" {
+		signingPolicyFile = &sigstore.SigningPolicyFileConfig{
+			Path:           c.SigstorePolicyPath,
+			ReloadInterval: c.ReloadInterval,
+		}
+	}
+	if err := verifier.SetSigningPolicyFile(signingPolicyFile); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to load sigstore policy: %v", err)
+	}
+	return verifier, nil
+}
+
+// buildSignaturePolicy returns the signature policy configuration, or nil if
+// no policy option is set.
+func buildSignaturePolicy(config *HCLConfig, reloadInterval time.Duration) (*sigstore.SignaturePolicyConfig, error) {
+	if config.MaxSignatureAge == "" && config.SignedAfter == "" && config.RevocationListPath == "" {
+		return nil, nil
+	}
 
-	p.sigstore.EnableAllowSubjectList(c.AllowedSubjectListEnabled)
-	p.sigstore.ClearAllowedSubjects()
-	if c.AllowedSubjects != nil {
-		for _, subject := range c.AllowedSubjects {
-			p.sigstore.AddAllowedSubject(subject)
+	policy := &sigstore.SignaturePolicyConfig{
+		RevocationListPath: config.RevocationListPath,
+		ReloadInterval:     reloadInterval,
+	}
+	if config.MaxSignatureAge != "" {
+		maxSignatureAge, err := time.ParseDuration(config.MaxSignatureAge)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unable to parse max signature age: %v", err)
 		}
+		if maxSignatureAge <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "max signature age must be positive")
+		}
+		policy.MaxSignatureAge = maxSignatureAge
 	}
-	if c.RekorURL != "" {
-		err = p.sigstore.SetRekorURL(c.RekorURL)
+	if config.SignedAfter != "" {
+		signedAfter, err := time.Parse(time.RFC3339, config.SignedAfter)
 		if err != nil {
-			return nil, err
+			return nil, status.Errorf(codes.InvalidArgument, "unable to parse signed after: %v", err)
 		}
+		policy.SignedAfter = signedAfter
 	}
+	return policy, nil
+}
 
-	// Set the config
-	p.setConfig(c)
-	return &configv1.ConfigureResponse{}, nil
+// buildRemoteLimits returns the limits of the verifications reaching the
+// registries and the rekor log, or nil if no limit option is set.
+func buildRemoteLimits(config *HCLConfig) (*sigstore.RemoteLimitsConfig, error) {
+	if config.MaxConcurrentVerifications == 0 && config.VerificationRateLimit == 0 && config.VerificationFailureThreshold == 0 &&
+		config.SignatureCacheTTL == "" && !config.ServeStaleSignatures {
+		return nil, nil
+	}
+
+	limits := &sigstore.RemoteLimitsConfig{
+		MaxConcurrent:    config.MaxConcurrentVerifications,
+		RateLimit:        config.VerificationRateLimit,
+		RateBurst:        config.VerificationRateBurst,
+		FailureThreshold: config.VerificationFailureThreshold,
+		ServeStale:       config.ServeStaleSignatures,
+	}
+	for _, duration := range []struct {
+		name  string
+		value string
+		out   *time.Duration
+	}{
+		{name: "verification max wait", value: config.VerificationMaxWait, out: &limits.MaxWait},
+		{name: "verification backoff", value: config.VerificationBackoff, out: &limits.Backoff},
+		{name: "verification max backoff", value: config.VerificationMaxBackoff, out: &limits.MaxBackoff},
+		{name: "signature cache TTL", value: config.SignatureCacheTTL, out: &limits.CacheTTL},
+	} {
+		if duration.value == "" {
+			continue
+		}
+		d, err := time.ParseDuration(duration.value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unable to parse %s: %v", duration.name, err)
+		}
+		if d <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "%s must be positive", duration.name)
+		}
+		*duration.out = d
+	}
+	return limits, nil
+}
+
+// findContainerInPodList returns the pod running the container and the
+// container status, or nil if the container is not in any pod. The pod with
+// the given UID, if any, is looked up first.
+func findContainerInPodList(containerID, podUID string, list *corev1.PodList) (*corev1.Pod, *corev1.ContainerStatus) {
+	if podUID != "" {
+		for i := range list.Items {
+			pod := &list.Items[i]
+			if string(pod.UID) != podUID {
+				continue
+			}
+			if status, lookup := lookUpContainerInPod(containerID, pod.Status); lookup == containerInPod {
+				return pod, status
+			}
+		}
+	}
+
+	// The UID in the cgroup is not the one of the pod for static pods
+	// fetched from the API server, which are represented by mirror pods.
+	for i := range list.Items {
+		pod := &list.Items[i]
+		status, lookup := lookUpContainerInPod(containerID, pod.Status)
+		if lookup == containerInPod {
+			return pod, status
+		}
+	}
+	return nil, nil
+}
+
+// fetchPodList fetches the pod list from the configured pod information
+// sources, in order of preference, until one succeeds.
+func (p *Plugin) fetchPodList(config *k8sConfig, log hclog.Logger) (*corev1.PodList, error) {
+	var err error
+	for i, source := range config.PodInfoSources {
+		var list *corev1.PodList
+		switch source {
+		case kubeletPodInfoSource:
+			list, err = config.Client.GetPodList()
+		case apiServerPodInfoSource:
+			list, err = config.APIServerClient.GetPodList()
+		}
+		p.reportPodListRequest(source, err)
+		if err == nil {
+			return list, nil
+		}
+		if i < len(config.PodInfoSources)-1 {
+			log.Warn("Unable to get the pod list, trying the next source", "source", source, telemetry.Error, err)
+		}
+	}
+	return nil, err
+}
+
+// reportRekorInconsistency counts the rekor transparency log inconsistencies
+// detected by the signature verification. The inconsistency itself is logged
+// by the verifier.
+func (p *Plugin) reportRekorInconsistency(error) {
+	p.incrCounter(rekorInconsistencyMetricKey)
+}
+
+// reportPodListRequest counts the pod list requests sent to each source
+func (p *Plugin) reportPodListRequest(source string, err error) {
+	key := kubeletPodListRequestMetricKey
+	if source == apiServerPodInfoSource {
+		key = apiServerPodListRequestMetricKey
+	}
+	p.incrCounter(key, &metricsv1.Label{
+		Name:  telemetry.Status,
+		Value: status.Code(err).String(),
+	})
+}
+
+// reportPodListCacheHit counts the attestations served from the cached
+// pod list
+func (p *Plugin) reportPodListCacheHit() {
+	p.incrCounter(podListCacheHitMetricKey)
+}
+
+func (p *Plugin) incrCounter(key []string, labels ...*metricsv1.Label) {
+	if !p.metrics.IsInitialized() {
+		return
+	}
+	if _, err := p.metrics.IncrCounter(context.Background(), &metricsv1.IncrCounterRequest{
+		Key:    key,
+		Val:    1,
+		Labels: labels,
+	}); err != nil {
+		p.log.Warn("Unable to report metric", "key", strings.Join(key, "."), "err", err)
+	}
 }
 
 func (p *Plugin) setConfig(config *k8sConfig) {
@@ -375,16 +832,23 @@ func (p *Plugin) getConfig() (*k8sConfig, error) {
 	if err := p.reloadKubeletClient(p.config); err != nil {
 		p.log.Warn("Unable to load kubelet client", "err", err)
 	}
+	if err := p.reloadAPIServerClient(p.config); err != nil {
+		p.log.Warn("Unable to load API server client", "err", err)
+	}
 	return p.config, nil
 }
 
-func (p *Plugin) getContainerIDFromCGroups(pid int32) (string, error) {
+func (p *Plugin) getContainerIDFromCGroups(pid int32) (string, string, error) {
 	cgroups, err := cgroups.GetCgroups(pid, p.fs)
 	if err != nil {
-		return "", status.Errorf(codes.Internal, "unable to obtain cgroups: %v", err)
+		return "", "", status.Errorf(codes.Internal, "unable to obtain cgroups: %v", err)
 	}
 
-	return getContainerIDFromCGroups(cgroups)
+	containerID, err := getContainerIDFromCGroups(cgroups)
+	if err != nil {
+		return "", "", err
+	}
+	return containerID, getPodUIDFromCGroups(cgroups), nil
 }
 
 func (p *Plugin) reloadKubeletClient(config *k8sConfig) (err error) {
@@ -563,34 +1027,48 @@ type kubeletClient struct {
 func (c *kubeletClient) GetPodList() (*corev1.PodList, error) {
 	url := c.URL
 	url.Path = "/pods"
+	return getPodList(c.Transport, url, c.Token)
+}
+
+// getPodList gets the pod list from the URL, authenticating with the token
+// if set.
+func getPodList(transport *http.Transport, url url.URL, token string) (*corev1.PodList, error) {
+	out := new(corev1.PodList)
+	if err := getObject(transport, url, token, "pods", out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// getObject gets the JSON encoded object at the given URL
+func getObject(transport *http.Transport, url url.URL, token, name string, out interface{}) error {
 	req, err := http.NewRequest("GET", url.String(), nil)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "unable to create request: %v", err)
+		return status.Errorf(codes.Internal, "unable to create request: %v", err)
 	}
-	if c.Token != "" {
-		req.Header.Set("Authorization", "Bearer "+c.Token)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
 	}
 
 	client := &http.Client{}
-	if c.Transport != nil {
-		client.Transport = c.Transport
+	if transport != nil {
+		client.Transport = transport
 	}
 	resp, err := client.Do(req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "unable to perform request: %v", err)
+		return status.Errorf(codes.Internal, "unable to perform request: %v", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, status.Errorf(codes.Internal, "unexpected status code on pods response: %d %s", resp.StatusCode, tryRead(resp.Body))
+		return status.Errorf(codes.Internal, "unexpected status code on %s response: %d %s", name, resp.StatusCode, tryRead(resp.Body))
 	}
 
-	out := new(corev1.PodList)
 	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
-		return nil, status.Errorf(codes.Internal, "unable to decode kubelet response: %v", err)
+		return status.Errorf(codes.Internal, "unable to decode %s response: %v", name, err)
 	}
 
-	return out, nil
+	return nil
 }
 
 func getContainerIDFromCGroups(cgroups []cgroups.Cgroup) (string, error) {
@@ -622,7 +1100,7 @@ var containerIDRe = regexp.MustCompile(`` +
 	// zero or more punctuation separated "segments" (e.g. "burstable-")
 	`(?:[[:^punct:]]+[[:punct:]])*` +
 	// "pod"-prefixed Pod UUID (with punctuation separated groups) followed by punctuation
-	`pod[[:xdigit:]]{8}[[:punct:]][[:xdigit:]]{4}[[:punct:]][[:xdigit:]]{4}[[:punct:]][[:xdigit:]]{4}[[:punct:]][[:xdigit:]]{12}[[:punct:]]` +
+	`pod([[:xdigit:]]{8}[[:punct:]][[:xdigit:]]{4}[[:punct:]][[:xdigit:]]{4}[[:punct:]][[:xdigit:]]{4}[[:punct:]][[:xdigit:]]{12})[[:punct:]]` +
 	// zero or more punctuation separated "segments" (e.g. "docker-")
 	`(?:[[:^punct:]]+[[:punct:]])*` +
 	// non-punctuation end of string, i.e., the container ID
@@ -642,11 +1120,24 @@ func getContainerIDFromCGroupPath(cgroupPath string) (string, bool) {
 
 	matches := containerIDRe.FindStringSubmatch(cgroupPath)
 	if matches != nil {
-		return matches[1], true
+		return matches[2], true
 	}
 	return "", false
 }
 
+// getPodUIDFromCGroups returns the UID of the pod of the container running
+// in the cgroups, or an empty string if it cannot be determined. The systemd
+// cgroup driver replaces the dashes of the UID with underscores.
+func getPodUIDFromCGroups(cgroups []cgroups.Cgroup) string {
+	for _, cgroup := range cgroups {
+		matches := containerIDRe.FindStringSubmatch(strings.TrimSuffix(cgroup.GroupPath, ".scope"))
+		if matches != nil {
+			return strings.ReplaceAll(matches[1], "_", "-")
+		}
+	}
+	return ""
+}
+
 func lookUpContainerInPod(containerID string, status corev1.PodStatus) (*corev1.ContainerStatus, containerLookup) {
 	for _, status := range status.ContainerStatuses {
 		// TODO: should we be keying off of the status or is the lack of a
@@ -22,13 +22,18 @@ import (
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/hashicorp/go-hclog"
 	"github.com/sigstore/cosign/pkg/oci"
+	metricsv1 "github.com/spiffe/spire-plugin-sdk/proto/spire/hostservice/common/metrics/v1"
+	configv1 "github.com/spiffe/spire-plugin-sdk/proto/spire/service/common/config/v1"
 	"github.com/spiffe/spire/pkg/agent/common/cgroups"
 	"github.com/spiffe/spire/pkg/agent/plugin/workloadattestor"
 	"github.com/spiffe/spire/pkg/agent/plugin/workloadattestor/k8s/sigstore"
+	"github.com/spiffe/spire/pkg/common/hostservice/metricsservice"
 	"github.com/spiffe/spire/pkg/common/pemutil"
+	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/pkg/common/util"
 	"github.com/spiffe/spire/proto/spire/common"
 	"github.com/spiffe/spire/test/clock"
+	"github.com/spiffe/spire/test/fakes/fakemetrics"
 	"github.com/spiffe/spire/test/plugintest"
 	"github.com/spiffe/spire/test/spiretest"
 	"github.com/stretchr/testify/assert"
@@ -433,6 +438,51 @@ func (s *Suite) TestAttestAgainstNodeOverride() {
 	s.Require().Empty(selectors)
 }
 
+func (s *Suite) TestAttestWithCachedPodList() {
+	s.startInsecureKubelet()
+	metrics := fakemetrics.New()
+	v1 := new(workloadattestor.V1)
+	plugintest.Load(s.T(), builtin(s.newPlugin()), v1,
+		plugintest.HostServices(metricsv1.MetricsServiceServer(metricsservice.V1(metrics))),
+		plugintest.Configure(fmt.Sprintf(`
+			kubelet_read_only_port = %d
+			pod_list_cache_interval = "10s"
+		`, s.kubeletPort())))
+
+	// The second attestation is served from the cached pod list
+	s.requireAttestSuccessWithPod(v1)
+	s.addCgroupsResponse(cgPidInPodFilePath)
+	s.requireAttestSuccess(v1, testPodSelectors)
+
+	// A container missing from the cached pod list triggers a refresh
+	s.requireAttestSuccessWithKindPod(v1)
+
+	// The cached pod list expires
+	s.clock.Add(10 * time.Second)
+	s.requireAttestSuccessWithPod(v1)
+	s.Require().Empty(s.podList)
+
+	podListRequest := fakemetrics.MetricItem{
+		Type:   fakemetrics.IncrCounterWithLabelsType,
+		Key:    kubeletPodListRequestMetricKey,
+		Val:    1,
+		Labels: []telemetry.Label{{Name: telemetry.Status, Value: codes.OK.String()}},
+	}
+	podListCacheHit := fakemetrics.MetricItem{
+		Type:   fakemetrics.IncrCounterWithLabelsType,
+		Key:    podListCacheHitMetricKey,
+		Val:    1,
+		Labels: []telemetry.Label{},
+	}
+	s.Require().Equal([]fakemetrics.MetricItem{
+		podListRequest,
+		podListCacheHit,
+		podListCacheHit,
+		podListRequest,
+		podListRequest,
+	}, metrics.AllMetrics())
+}
+
 func (s *Suite) TestConfigure() {
 	s.generateCerts("")
 
@@ -454,15 +504,24 @@ func (s *Suite) TestConfigure() {
 		AllowedSubjectListEnabled bool
 		AllowedSubjects           []string
 		RekorURL                  string
+		SignatureVerifiers        map[string]*sigstore.VerifierConfig
+		SignatureSelectors        []string
+		RekorCheckpointPath       string
+		RekorPublicKeyPath        string
+		SignaturePolicy           *sigstore.SignaturePolicyConfig
+		RemoteLimits              *sigstore.RemoteLimitsConfig
+		SigstorePolicyPath        string
 	}
 
 	testCases := []struct {
-		name          string
-		raw           string
-		hcl           string
-		config        *config
-		sigstoreError error
-		err           string
+		name               string
+		raw                string
+		hcl                string
+		config             *config
+		sigstoreError      error
+		verifiersError     error
+		signingPolicyError error
+		err                string
 	}{
 		{
 			name: "insecure defaults",
@@ -714,6 +773,214 @@ func (s *Suite) TestConfigure() {
 			config:        nil,
 			err:           "Error parsing rekor URI",
 		},
+		{
+			name: "secure defaults with signature verifiers",
+			hcl: `
+				signature_verifiers = {
+					"allowed-digests" = {
+						type = "digest-allowlist"
+						image_patterns = ["localhost/spiffe/*"]
+						digests = ["sha256:0cfdaced91cb46dd7af48309799a3c351e4ca2d5e1ee9737ca0cbd932cb79898"]
+					}
+				}
+			`,
+			config: &config{
+				VerifyKubelet:     true,
+				Token:             "default-token",
+				KubeletURL:        "https://127.0.0.1:10250",
+				MaxPollAttempts:   defaultMaxPollAttempts,
+				PollRetryInterval: defaultPollRetryInterval,
+				ReloadInterval:    defaultReloadInterval,
+				SignatureVerifiers: map[string]*sigstore.VerifierConfig{
+					"allowed-digests": {
+						Type:          "digest-allowlist",
+						ImagePatterns: []string{"localhost/spiffe/*"},
+						Digests:       []string{"sha256:0cfdaced91cb46dd7af48309799a3c351e4ca2d5e1ee9737ca0cbd932cb79898"},
+					},
+				},
+			},
+		},
+		{
+			name: "secure defaults with signature selectors",
+			hcl: `
+				signature_selectors = ["subject", "issuer", "content-hash"]
+			`,
+			config: &config{
+				VerifyKubelet:      true,
+				Token:              "default-token",
+				KubeletURL:         "https://127.0.0.1:10250",
+				MaxPollAttempts:    defaultMaxPollAttempts,
+				PollRetryInterval:  defaultPollRetryInterval,
+				ReloadInterval:     defaultReloadInterval,
+				SignatureSelectors: []string{"subject", "issuer", "content-hash"},
+			},
+		},
+		{
+			name: "secure defaults with rekor checkpoint",
+			hcl: `
+				rekor_checkpoint_path = "/opt/spire/data/agent/rekor_checkpoint.json"
+				rekor_public_key_path = "/run/spire/rekor.pub"
+			`,
+			config: &config{
+				VerifyKubelet:       true,
+				Token:               "default-token",
+				KubeletURL:          "https://127.0.0.1:10250",
+				MaxPollAttempts:     defaultMaxPollAttempts,
+				PollRetryInterval:   defaultPollRetryInterval,
+				ReloadInterval:      defaultReloadInterval,
+				RekorCheckpointPath: "/opt/spire/data/agent/rekor_checkpoint.json",
+				RekorPublicKeyPath:  "/run/spire/rekor.pub",
+			},
+		},
+		{
+			name: "secure defaults with signature policy",
+			hcl: `
+				max_signature_age = "2160h"
+				signed_after = "2022-01-01T00:00:00Z"
+				revocation_list_path = "/run/spire/revocations.json"
+				reload_interval = "30s"
+			`,
+			config: &config{
+				VerifyKubelet:     true,
+				Token:             "default-token",
+				KubeletURL:        "https://127.0.0.1:10250",
+				MaxPollAttempts:   defaultMaxPollAttempts,
+				PollRetryInterval: defaultPollRetryInterval,
+				ReloadInterval:    30 * time.Second,
+				SignaturePolicy: &sigstore.SignaturePolicyConfig{
+					MaxSignatureAge:    2160 * time.Hour,
+					SignedAfter:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+					RevocationListPath: "/run/spire/revocations.json",
+					ReloadInterval:     30 * time.Second,
+				},
+			},
+		},
+		{
+			name: "secure defaults with verification limits",
+			hcl: `
+				max_concurrent_verifications = 4
+				verification_rate_limit = 0.5
+				verification_rate_burst = 2
+				verification_max_wait = "5s"
+				verification_failure_threshold = 3
+				verification_backoff = "1m"
+				verification_max_backoff = "15m"
+				signature_cache_ttl = "1h"
+				serve_stale_signatures = true
+			`,
+			config: &config{
+				VerifyKubelet:     true,
+				Token:             "default-token",
+				KubeletURL:        "https://127.0.0.1:10250",
+				MaxPollAttempts:   defaultMaxPollAttempts,
+				PollRetryInterval: defaultPollRetryInterval,
+				ReloadInterval:    defaultReloadInterval,
+				RemoteLimits: &sigstore.RemoteLimitsConfig{
+					MaxConcurrent:    4,
+					RateLimit:        0.5,
+					RateBurst:        2,
+					MaxWait:          5 * time.Second,
+					FailureThreshold: 3,
+					Backoff:          time.Minute,
+					MaxBackoff:       15 * time.Minute,
+					CacheTTL:         time.Hour,
+					ServeStale:       true,
+				},
+			},
+		},
+		{
+			name: "invalid signature cache TTL",
+			hcl: `
+				signature_cache_ttl = "1d"
+			`,
+			err: "unable to parse signature cache TTL",
+		},
+		{
+			name: "negative verification backoff",
+			hcl: `
+				verification_failure_threshold = 3
+				verification_backoff = "-1m"
+			`,
+			err: "verification backoff must be positive",
+		},
+		{
+			name: "invalid max signature age",
+			hcl: `
+				max_signature_age = "90d"
+			`,
+			err: "unable to parse max signature age",
+		},
+		{
+			name: "negative max signature age",
+			hcl: `
+				max_signature_age = "-1h"
+			`,
+			err: "max signature age must be positive",
+		},
+		{
+			name: "invalid signed after",
+			hcl: `
+				signed_after = "2022-01-01"
+			`,
+			err: "unable to parse signed after",
+		},
+		{
+			name: "secure defaults with sigstore policy file",
+			hcl: `
+				sigstore_policy_path = "/run/spire/sigstore/policy.yaml"
+			`,
+			config: &config{
+				VerifyKubelet:      true,
+				Token:              "default-token",
+				KubeletURL:         "https://127.0.0.1:10250",
+				MaxPollAttempts:    defaultMaxPollAttempts,
+				PollRetryInterval:  defaultPollRetryInterval,
+				ReloadInterval:     defaultReloadInterval,
+				SigstorePolicyPath: "/run/spire/sigstore/policy.yaml",
+			},
+		},
+		{
+			name: "sigstore policy file with inline sigstore settings",
+			hcl: `
+				sigstore_policy_path = "/run/spire/sigstore/policy.yaml"
+				allowed_subjects_list = ["spirex@example.com"]
+			`,
+			err: "sigstore_policy_path cannot be used with",
+		},
+		{
+			name: "invalid sigstore policy file",
+			hcl: `
+				sigstore_policy_path = "/run/spire/sigstore/policy.yaml"
+			`,
+			signingPolicyError: errors.New("Unable to parse signing policy"),
+			err:                "unable to load sigstore policy: Unable to parse signing policy",
+		},
+		{
+			name: "invalid pod list cache interval",
+			hcl: `
+				pod_list_cache_interval = "foo"
+			`,
+			err: "unable to parse pod list cache interval",
+		},
+		{
+			name: "negative pod list cache interval",
+			hcl: `
+				pod_list_cache_interval = "-1s"
+			`,
+			err: "pod list cache interval cannot be negative",
+		},
+		{
+			name: "invalid signature verifiers",
+			hcl: `
+				signature_verifiers = {
+					"bad" = {
+						type = "unknown"
+					}
+				}
+			`,
+			verifiersError: errors.New("Verifier \"bad\" has unknown type \"unknown\""),
+			err:            "unable to configure signature verifiers",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -721,14 +988,16 @@ func (s *Suite) TestConfigure() {
 		s.T().Run(testCase.name, func(t *testing.T) {
 			p := s.newPlugin()
 			if testCase.sigstoreError != nil {
-				p.sigstore.(*SigstoreMock).returnError = testCase.sigstoreError
+				sigstoreMock(p).returnError = testCase.sigstoreError
 			}
+			sigstoreMock(p).verifiersError = testCase.verifiersError
+			sigstoreMock(p).signingPolicyError = testCase.signingPolicyError
 			var err error
 			plugintest.Load(s.T(), builtin(p), nil,
 				plugintest.Configure(testCase.hcl),
 				plugintest.CaptureConfigureError(&err))
 			if testCase.sigstoreError != nil {
-				p.sigstore.(*SigstoreMock).returnError = nil
+				sigstoreMock(p).returnError = nil
 			}
 			if testCase.err != "" {
 				s.AssertErrorContains(err, testCase.err)
@@ -768,10 +1037,96 @@ func (s *Suite) TestConfigure() {
 			assert.Equal(t, testCase.config.AllowedSubjectListEnabled, c.AllowedSubjectListEnabled)
 			assert.Equal(t, testCase.config.AllowedSubjects, c.AllowedSubjects)
 			assert.Equal(t, testCase.config.RekorURL, c.RekorURL)
+			assert.Equal(t, testCase.config.SignatureVerifiers, c.SignatureVerifiers)
+			assert.Equal(t, testCase.config.SignatureVerifiers, sigstoreMock(p).verifiers)
+			assert.Equal(t, testCase.config.SignatureSelectors, c.SignatureSelectors)
+			assert.Equal(t, testCase.config.SignatureSelectors, sigstoreMock(p).selectorKinds)
+			assert.Equal(t, testCase.config.RekorCheckpointPath, c.RekorCheckpointPath)
+			assert.Equal(t, testCase.config.RekorPublicKeyPath, c.RekorPublicKeyPath)
+			transparencyLog := sigstoreMock(p).transparencyLog
+			if testCase.config.RekorCheckpointPath == "" {
+				assert.Nil(t, transparencyLog)
+			} else if assert.NotNil(t, transparencyLog) {
+				assert.Equal(t, testCase.config.RekorCheckpointPath, transparencyLog.CheckpointPath)
+				assert.Equal(t, testCase.config.RekorPublicKeyPath, transparencyLog.PublicKeyPath)
+				assert.NotNil(t, transparencyLog.OnInconsistency)
+			}
+			assert.Equal(t, testCase.config.SignaturePolicy, c.SignaturePolicy)
+			assert.Equal(t, testCase.config.SignaturePolicy, sigstoreMock(p).signaturePolicy)
+			assert.Equal(t, testCase.config.RemoteLimits, c.RemoteLimits)
+			assert.Equal(t, testCase.config.RemoteLimits, sigstoreMock(p).remoteLimits)
+			assert.Equal(t, testCase.config.SigstorePolicyPath, c.SigstorePolicyPath)
+			signingPolicy := sigstoreMock(p).signingPolicy
+			if testCase.config.SigstorePolicyPath == "" {
+				assert.Nil(t, signingPolicy)
+			} else if assert.NotNil(t, signingPolicy) {
+				assert.Equal(t, testCase.config.SigstorePolicyPath, signingPolicy.Path)
+				assert.Equal(t, testCase.config.ReloadInterval, signingPolicy.ReloadInterval)
+			}
 		})
 	}
 }
 
+func (s *Suite) TestConfigureKeepsSigstoreOnFailure() {
+	var built []*SigstoreMock
+	p := s.newPlugin()
+	p.newSigstore = func(hclog.Logger) sigstore.Sigstore {
+		mock := &SigstoreMock{}
+		if len(built) > 0 {
+			mock.verifiersError = errors.New("Verifier \"bad\" has unknown type \"unknown\"")
+		}
+		built = append(built, mock)
+		return mock
+	}
+
+	_, err := p.Configure(context.Background(), &configv1.ConfigureRequest{HclConfiguration: `
+		kubelet_read_only_port = 12345
+		skip_signature_verification_image_list = ["sha256:abcdef"]
+	`})
+	s.Require().NoError(err)
+
+	_, err = p.Configure(context.Background(), &configv1.ConfigureRequest{HclConfiguration: `
+		kubelet_read_only_port = 12345
+		signature_verifiers = {
+			"bad" = {
+				type = "unknown"
+			}
+		}
+	`})
+	s.AssertErrorContains(err, "unable to configure signature verifiers")
+
+	// The failed configuration is not applied, not even partially
+	c, err := p.getConfig()
+	s.Require().NoError(err)
+	s.Require().Len(built, 2)
+	s.Require().Same(built[0], c.Sigstore)
+	s.Require().Equal([]string{"sha256:abcdef"}, c.SkippedImages)
+}
+
+func (s *Suite) TestReportRekorInconsistency() {
+	metrics := fakemetrics.New()
+	p := s.newPlugin()
+	plugintest.Load(s.T(), builtin(p), nil,
+		plugintest.HostServices(metricsv1.MetricsServiceServer(metricsservice.V1(metrics))),
+		plugintest.Configure(`
+			kubelet_read_only_port = 12345
+			rekor_checkpoint_path = "rekor_checkpoint.json"
+		`))
+
+	transparencyLog := sigstoreMock(p).transparencyLog
+	s.Require().NotNil(transparencyLog)
+	transparencyLog.OnInconsistency(sigstore.ErrInconsistentLog)
+
+	s.Require().Equal([]fakemetrics.MetricItem{
+		{
+			Type:   fakemetrics.IncrCounterWithLabelsType,
+			Key:    rekorInconsistencyMetricKey,
+			Val:    1,
+			Labels: []telemetry.Label{},
+		},
+	}, metrics.AllMetrics())
+}
+
 type signature struct {
 	v1.Layer
 
@@ -810,8 +1165,18 @@ type SigstoreMock struct {
 	skipSigs            bool
 	skippedSigSelectors []string
 	returnError         error
+	verifiersError      error
+	signingPolicyError  error
+
+	rekorURL        string
+	verifiers       map[string]*sigstore.VerifierConfig
+	transparencyLog *sigstore.TransparencyLogConfig
+	signaturePolicy *sigstore.SignaturePolicyConfig
+	signingPolicy   *sigstore.SigningPolicyFileConfig
 
-	rekorURL string
+	artifactSubjects map[string][]string
+	selectorKinds    []string
+	remoteLimits     *sigstore.RemoteLimitsConfig
 }
 
 // SetLogger implements sigstore.Sigstore
@@ -877,6 +1242,48 @@ func (s *SigstoreMock) SetRekorURL(url string) error {
 	return s.returnError
 }
 
+func (s *SigstoreMock) SetVerifiers(configs map[string]*sigstore.VerifierConfig) error {
+	s.verifiers = configs
+	return s.verifiersError
+}
+
+func (s *SigstoreMock) SetTransparencyLog(config *sigstore.TransparencyLogConfig) error {
+	s.transparencyLog = config
+	return nil
+}
+
+func (s *SigstoreMock) SetSignaturePolicy(config *sigstore.SignaturePolicyConfig) error {
+	s.signaturePolicy = config
+	return nil
+}
+
+func (s *SigstoreMock) VerificationResults() []sigstore.VerificationResult {
+	return nil
+}
+
+func (s *SigstoreMock) AttestArtifactSignatures(artifact string) ([]string, error) {
+	subjects, ok := s.artifactSubjects[artifact]
+	if !ok {
+		return nil, errors.New("no matching signatures")
+	}
+	return subjects, nil
+}
+
+func (s *SigstoreMock) SetSelectorKinds(kinds []string) error {
+	s.selectorKinds = kinds
+	return nil
+}
+
+func (s *SigstoreMock) SetRemoteLimits(config *sigstore.RemoteLimitsConfig) error {
+	s.remoteLimits = config
+	return nil
+}
+
+func (s *SigstoreMock) SetSigningPolicyFile(config *sigstore.SigningPolicyFileConfig) error {
+	s.signingPolicy = config
+	return s.signingPolicyError
+}
+
 func (s *Suite) newPlugin() *Plugin {
 	p := New()
 	p.fs = testFS(s.dir)
@@ -884,17 +1291,25 @@ func (s *Suite) newPlugin() *Plugin {
 	p.getenv = func(key string) string {
 		return s.env[key]
 	}
-	p.sigstore = &SigstoreMock{
+	mock := &SigstoreMock{
 		selectors:           s.sigstoreSelectors,
 		sigs:                s.sigstoreSigs,
 		skipSigs:            s.sigstoreSkipSigs,
 		skippedSigSelectors: s.sigstoreSkippedSigSelectors,
 		returnError:         s.sigstoreReturnError,
 	}
+	p.newSigstore = func(hclog.Logger) sigstore.Sigstore {
+		return mock
+	}
 
 	return p
 }
 
+// sigstoreMock returns the sigstore mock the plugin configures
+func sigstoreMock(p *Plugin) *SigstoreMock {
+	return p.newSigstore(nil).(*SigstoreMock)
+}
+
 func (s *Suite) setServer(server *httptest.Server) {
 	if s.server != nil {
 		s.server.Close()
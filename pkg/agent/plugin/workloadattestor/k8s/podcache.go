@@ -0,0 +1,66 @@
+package k8s
+
+import (
+	"sync"
+	"time"
+
+	"github.com/andres-erbsen/clock"
+	"golang.org/x/sync/singleflight"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// podListCache caches the pod list of the node, so that concurrent
+// attestations share the same requests to the pod information sources.
+type podListCache struct {
+	clock           clock.Clock
+	refreshInterval time.Duration
+
+	// onHit is called every time the cached pod list is served
+	onHit func()
+
+	group singleflight.Group
+
+	mu        sync.RWMutex
+	list      *corev1.PodList
+	fetchedAt time.Time
+}
+
+func newPodListCache(clock clock.Clock, refreshInterval time.Duration, onHit func()) *podListCache {
+	return &podListCache{
+		clock:           clock,
+		refreshInterval: refreshInterval,
+		onHit:           onHit,
+	}
+}
+
+// GetPodList returns the cached pod list, fetching it if it is older than
+// the refresh interval or refresh is set. Concurrent fetches are shared. The
+// returned boolean reports whether the list was served from the cache.
+func (c *podListCache) GetPodList(fetch func() (*corev1.PodList, error), refresh bool) (*corev1.PodList, bool, error) {
+	if !refresh {
+		c.mu.RLock()
+		list, fetchedAt := c.list, c.fetchedAt
+		c.mu.RUnlock()
+		if list != nil && c.clock.Now().Sub(fetchedAt) < c.refreshInterval {
+			c.onHit()
+			return list, true, nil
+		}
+	}
+
+	list, err, _ := c.group.Do("pods", func() (interface{}, error) {
+		list, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		c.list = list
+		c.fetchedAt = c.clock.Now()
+		c.mu.Unlock()
+		return list, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return list.(*corev1.PodList), false, nil
+}
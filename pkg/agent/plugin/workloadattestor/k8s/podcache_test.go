@@ -0,0 +1,90 @@
+package k8s
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/andres-erbsen/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestPodListCacheSharesConcurrentRefreshes(t *testing.T) {
+	var fetches int32
+	release := make(chan struct{})
+	fetch := func() (*corev1.PodList, error) {
+		atomic.AddInt32(&fetches, 1)
+		<-release
+		return &corev1.PodList{}, nil
+	}
+
+	var hits int32
+	cache := newPodListCache(clock.NewMock(), time.Minute, func() {
+		atomic.AddInt32(&hits, 1)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			list, _, err := cache.GetPodList(fetch, true)
+			assert.NoError(t, err)
+			assert.NotNil(t, list)
+		}()
+	}
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&fetches) == 1
+	}, time.Minute, 10*time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// Refreshes arriving after the first fetch completed may start a second
+	// one, but never one per caller.
+	require.Less(t, int(atomic.LoadInt32(&fetches)), 10)
+
+	list, cached, err := cache.GetPodList(fetch, false)
+	require.NoError(t, err)
+	require.True(t, cached)
+	require.Empty(t, list.Items)
+	require.Equal(t, int32(1), atomic.LoadInt32(&hits))
+}
+
+func TestPodListCacheExpires(t *testing.T) {
+	clk := clock.NewMock()
+	var fetches int
+	fetch := func() (*corev1.PodList, error) {
+		fetches++
+		if fetches == 3 {
+			return nil, errors.New("unavailable")
+		}
+		return &corev1.PodList{}, nil
+	}
+	cache := newPodListCache(clk, time.Minute, func() {})
+
+	_, cached, err := cache.GetPodList(fetch, false)
+	require.NoError(t, err)
+	require.False(t, cached)
+
+	clk.Add(time.Minute - time.Second)
+	_, cached, err = cache.GetPodList(fetch, false)
+	require.NoError(t, err)
+	require.True(t, cached)
+
+	clk.Add(time.Second)
+	_, cached, err = cache.GetPodList(fetch, false)
+	require.NoError(t, err)
+	require.False(t, cached)
+
+	// Errors are not cached
+	_, _, err = cache.GetPodList(fetch, true)
+	require.EqualError(t, err, "unavailable")
+	_, cached, err = cache.GetPodList(fetch, true)
+	require.NoError(t, err)
+	require.False(t, cached)
+	require.Equal(t, 4, fetches)
+}
@@ -0,0 +1,198 @@
+package k8s
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/andres-erbsen/clock"
+	"github.com/hashicorp/go-hclog"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	runtimeClassPodSelector  = "runtime-class"
+	hostNetworkPodSelector   = "host-network"
+	priorityClassPodSelector = "priority-class"
+	ownerRootPodSelector     = "pod-owner-root"
+
+	// maxOwnerDepth bounds the owner chain walked to find the root owner
+	maxOwnerDepth = 5
+
+	// ownerRootCacheTTL is how long the resolved root owners are cached
+	ownerRootCacheTTL = 10 * time.Minute
+)
+
+// ownerResource is the API resource of an owner kind
+type ownerResource struct {
+	Group    string
+	Resource string
+}
+
+// ownerResources are the owner kinds looked up in the API server to find
+// their own controller. Owners of other kinds are taken as the root owner.
+var ownerResources = map[string]ownerResource{
+	"ReplicaSet":            {Group: "apps", Resource: "replicasets"},
+	"Deployment":            {Group: "apps", Resource: "deployments"},
+	"StatefulSet":           {Group: "apps", Resource: "statefulsets"},
+	"DaemonSet":             {Group: "apps", Resource: "daemonsets"},
+	"Job":                   {Group: "batch", Resource: "jobs"},
+	"CronJob":               {Group: "batch", Resource: "cronjobs"},
+	"ReplicationController": {Group: "", Resource: "replicationcontrollers"},
+}
+
+// podSelectorsConfig holds the opt-in pod selectors
+type podSelectorsConfig struct {
+	AnnotationKeys []string
+	RuntimeClass   bool
+	HostNetwork    bool
+	PriorityClass  bool
+	OwnerRoot      bool
+}
+
+func getPodSelectorsConfig(config *HCLConfig) (*podSelectorsConfig, error) {
+	c := new(podSelectorsConfig)
+	for _, key := range config.PodAnnotationSelectors {
+		if key == "" {
+			return nil, status.Error(codes.InvalidArgument, "pod annotation selector keys cannot be empty")
+		}
+		c.AnnotationKeys = append(c.AnnotationKeys, key)
+	}
+	for _, selector := range config.PodMetadataSelectors {
+		switch selector {
+		case runtimeClassPodSelector:
+			c.RuntimeClass = true
+		case hostNetworkPodSelector:
+			c.HostNetwork = true
+		case priorityClassPodSelector:
+			c.PriorityClass = true
+		case ownerRootPodSelector:
+			c.OwnerRoot = true
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown pod metadata selector %q", selector)
+		}
+	}
+	return c, nil
+}
+
+// getPodMetadataSelectorValues returns the opt-in selectors derived from the
+// pod object alone.
+func getPodMetadataSelectorValues(pod *corev1.Pod, c *podSelectorsConfig) []string {
+	var selectorValues []string
+	for _, key := range c.AnnotationKeys {
+		if value, ok := pod.Annotations[key]; ok {
+			selectorValues = append(selectorValues, fmt.Sprintf("pod-annotation:%s:%s", key, value))
+		}
+	}
+	if c.RuntimeClass && pod.Spec.RuntimeClassName != nil {
+		selectorValues = append(selectorValues, fmt.Sprintf("runtime-class:%s", *pod.Spec.RuntimeClassName))
+	}
+	if c.HostNetwork {
+		selectorValues = append(selectorValues, fmt.Sprintf("host-network:%s", strconv.FormatBool(pod.Spec.HostNetwork)))
+	}
+	if c.PriorityClass && pod.Spec.PriorityClassName != "" {
+		selectorValues = append(selectorValues, fmt.Sprintf("priority-class:%s", pod.Spec.PriorityClassName))
+	}
+	return selectorValues
+}
+
+// getOwnerRootSelectorValue returns the pod-owner-root selector of the pod,
+// if it has a controller. Failures to resolve the root owner are logged and
+// the selector is omitted.
+func (p *Plugin) getOwnerRootSelectorValue(config *k8sConfig, pod *corev1.Pod, log hclog.Logger) (string, bool) {
+	controller := metav1.GetControllerOf(pod)
+	if controller == nil {
+		return "", false
+	}
+
+	root, ok := config.OwnerRoots.Get(controller.UID)
+	if !ok {
+		var err error
+		root, err = resolveOwnerRoot(config.APIServerClient, pod.Namespace, *controller)
+		if err != nil {
+			log.Warn("Unable to resolve the root owner of the pod", telemetry.Error, err)
+			return "", false
+		}
+		config.OwnerRoots.Set(controller.UID, root)
+	}
+	return fmt.Sprintf("pod-owner-root:%s:%s", root.Kind, root.Name), true
+}
+
+// resolveOwnerRoot follows the controllers of the owner up to the top-level
+// workload, e.g. from a ReplicaSet to its Deployment.
+func resolveOwnerRoot(client *apiServerClient, namespace string, owner metav1.OwnerReference) (metav1.OwnerReference, error) {
+	for depth := 0; depth < maxOwnerDepth; depth++ {
+		resource, ok := ownerResources[owner.Kind]
+		if !ok {
+			return owner, nil
+		}
+		gv, err := schema.ParseGroupVersion(owner.APIVersion)
+		if err != nil || gv.Group != resource.Group {
+			return owner, nil
+		}
+
+		controller, err := client.GetControllerOf(namespace, owner, resource)
+		if err != nil {
+			return metav1.OwnerReference{}, err
+		}
+		if controller == nil {
+			return owner, nil
+		}
+		owner = *controller
+	}
+	return metav1.OwnerReference{}, status.Errorf(codes.Internal, "owner chain is deeper than %d", maxOwnerDepth)
+}
+
+// ownerRootCache caches the root owners by the UID of the pod controller.
+// UIDs are never reused, so entries only expire to pick up the rare change
+// of controller, e.g. an orphaned ReplicaSet adopted by another Deployment.
+type ownerRootCache struct {
+	clock clock.Clock
+
+	mu      sync.Mutex
+	entries map[types.UID]ownerRootEntry
+}
+
+type ownerRootEntry struct {
+	root      metav1.OwnerReference
+	expiresAt time.Time
+}
+
+func newOwnerRootCache(clock clock.Clock) *ownerRootCache {
+	return &ownerRootCache{
+		clock:   clock,
+		entries: make(map[types.UID]ownerRootEntry),
+	}
+}
+
+func (c *ownerRootCache) Get(uid types.UID) (metav1.OwnerReference, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[uid]
+	if !ok || !c.clock.Now().Before(entry.expiresAt) {
+		return metav1.OwnerReference{}, false
+	}
+	return entry.root, true
+}
+
+func (c *ownerRootCache) Set(uid types.UID, root metav1.OwnerReference) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.clock.Now()
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+	c.entries[uid] = ownerRootEntry{
+		root:      root,
+		expiresAt: now.Add(ownerRootCacheTTL),
+	}
+}
@@ -0,0 +1,236 @@
+package k8s
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/spiffe/spire/pkg/common/util"
+	"github.com/spiffe/spire/proto/spire/common"
+	"github.com/spiffe/spire/test/plugintest"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	kindReplicaSetPath = "/apis/apps/v1/namespaces/default/replicasets/sample-workload-6658cb9566"
+	kindDeploymentPath = "/apis/apps/v1/namespaces/default/deployments/sample-workload"
+
+	kindReplicaSet = `{
+		"apiVersion": "apps/v1",
+		"kind": "ReplicaSet",
+		"metadata": {
+			"name": "sample-workload-6658cb9566",
+			"namespace": "default",
+			"uid": "349d135e-3781-43e3-bc25-c900aedf1d0c",
+			"ownerReferences": [{
+				"apiVersion": "apps/v1",
+				"kind": "Deployment",
+				"name": "sample-workload",
+				"uid": "5d1f7d4c-0c4f-4b8e-a3a4-4d2c4a1c6a01",
+				"controller": true
+			}]
+		}
+	}`
+	kindDeployment = `{
+		"apiVersion": "apps/v1",
+		"kind": "Deployment",
+		"metadata": {
+			"name": "sample-workload",
+			"namespace": "default",
+			"uid": "5d1f7d4c-0c4f-4b8e-a3a4-4d2c4a1c6a01"
+		}
+	}`
+)
+
+func (s *Suite) TestAttestWithPodOwnerRoot() {
+	apiServer := s.startAPIServer(podListFilePath)
+	apiServer.objects[kindReplicaSetPath] = kindReplicaSet
+	apiServer.objects[kindDeploymentPath] = kindDeployment
+
+	s.startInsecureKubelet()
+	p := s.loadPlugin(fmt.Sprintf(`
+		kubelet_read_only_port = %d
+		pod_metadata_selectors = ["pod-owner-root", "host-network"]
+		api_server_url = %q
+		api_server_ca_path = %q
+	`, s.kubeletPort(), apiServer.url(), apiServerCAPath))
+
+	expected := append([]*common.Selector{
+		{Type: "k8s", Value: "host-network:false"},
+		{Type: "k8s", Value: "pod-owner-root:Deployment:sample-workload"},
+	}, testKindPodSelectors...)
+	util.SortSelectors(expected)
+
+	s.addPodListResponse(kindPodListFilePath)
+	s.addCgroupsResponse(cgPidInKindPodFilePath)
+	s.requireAttestSuccess(p, expected)
+	s.Require().Equal(2, apiServer.requests)
+
+	// The root owner is cached
+	s.addCgroupsResponse(cgPidInKindPodFilePath)
+	s.requireAttestSuccess(p, expected)
+	s.Require().Equal(2, apiServer.requests)
+
+	// The root owner is resolved again once the cached one expires
+	s.clock.Add(ownerRootCacheTTL)
+	s.addPodListResponse(kindPodListFilePath)
+	s.addCgroupsResponse(cgPidInKindPodFilePath)
+	s.requireAttestSuccess(p, expected)
+	s.Require().Equal(4, apiServer.requests)
+}
+
+func (s *Suite) TestAttestWithUnresolvedPodOwnerRoot() {
+	// The ReplicaSet was recreated with another UID
+	apiServer := s.startAPIServer(podListFilePath)
+	apiServer.objects[kindReplicaSetPath] = `{"metadata": {"name": "sample-workload-6658cb9566", "uid": "00000000-0000-0000-0000-000000000000"}}`
+
+	s.startInsecureKubelet()
+	p := s.loadPlugin(fmt.Sprintf(`
+		kubelet_read_only_port = %d
+		pod_metadata_selectors = ["pod-owner-root"]
+		api_server_url = %q
+		api_server_ca_path = %q
+	`, s.kubeletPort(), apiServer.url(), apiServerCAPath))
+
+	// The selector is omitted
+	s.requireAttestSuccessWithKindPod(p)
+	s.Require().Equal(1, apiServer.requests)
+}
+
+func (s *Suite) TestConfigurePodSelectors() {
+	for _, tt := range []struct {
+		name string
+		hcl  string
+		err  string
+	}{
+		{
+			name: "unknown metadata selector",
+			hcl:  `pod_metadata_selectors = ["qos-class"]`,
+			err:  `unknown pod metadata selector "qos-class"`,
+		},
+		{
+			name: "empty annotation key",
+			hcl:  `pod_annotation_selectors = [""]`,
+			err:  "pod annotation selector keys cannot be empty",
+		},
+		{
+			name: "root owner outside of the cluster",
+			hcl:  `pod_metadata_selectors = ["pod-owner-root"]`,
+			err:  "the API server URL is required when not running in a cluster",
+		},
+		{
+			name: "pod selectors",
+			hcl: `
+				pod_annotation_selectors = ["example.org/team"]
+				pod_metadata_selectors = ["runtime-class", "host-network", "priority-class"]
+			`,
+		},
+	} {
+		tt := tt
+		s.T().Run(tt.name, func(t *testing.T) {
+			p := s.newPlugin()
+			var err error
+			plugintest.Load(t, builtin(p), nil,
+				plugintest.Configure(`
+					kubelet_read_only_port = 12345
+				`+tt.hcl),
+				plugintest.CaptureConfigureError(&err))
+			if tt.err != "" {
+				s.AssertGRPCStatusContains(err, codes.InvalidArgument, tt.err)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			c, err := p.getConfig()
+			if assert.NoError(t, err) {
+				assert.Equal(t, &podSelectorsConfig{
+					AnnotationKeys: []string{"example.org/team"},
+					RuntimeClass:   true,
+					HostNetwork:    true,
+					PriorityClass:  true,
+				}, c.PodSelectors)
+				assert.Nil(t, c.APIServerClient)
+			}
+		})
+	}
+}
+
+func TestGetPodMetadataSelectorValues(t *testing.T) {
+	runtimeClass := "gvisor"
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				"example.org/team":  "payments",
+				"example.org/owner": "alice",
+			},
+		},
+		Spec: corev1.PodSpec{
+			RuntimeClassName:  &runtimeClass,
+			HostNetwork:       true,
+			PriorityClassName: "high-priority",
+		},
+	}
+
+	for _, tt := range []struct {
+		name     string
+		pod      *corev1.Pod
+		config   *podSelectorsConfig
+		expected []string
+	}{
+		{
+			name:   "nothing enabled",
+			pod:    pod,
+			config: &podSelectorsConfig{},
+		},
+		{
+			name: "all enabled",
+			pod:  pod,
+			config: &podSelectorsConfig{
+				AnnotationKeys: []string{"example.org/team", "example.org/missing"},
+				RuntimeClass:   true,
+				HostNetwork:    true,
+				PriorityClass:  true,
+			},
+			expected: []string{
+				"pod-annotation:example.org/team:payments",
+				"runtime-class:gvisor",
+				"host-network:true",
+				"priority-class:high-priority",
+			},
+		},
+		{
+			name: "unset pod fields",
+			pod:  &corev1.Pod{},
+			config: &podSelectorsConfig{
+				AnnotationKeys: []string{"example.org/team"},
+				RuntimeClass:   true,
+				HostNetwork:    true,
+				PriorityClass:  true,
+			},
+			expected: []string{
+				"host-network:false",
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, getPodMetadataSelectorValues(tt.pod, tt.config))
+		})
+	}
+}
+
+func TestResolveOwnerRootOfUnknownKinds(t *testing.T) {
+	for _, owner := range []metav1.OwnerReference{
+		{APIVersion: "v1", Kind: "Node", Name: "k8s-node-1"},
+		{APIVersion: "extensions/v1beta1", Kind: "DaemonSet", Name: "kube-proxy"},
+		{APIVersion: "example.org/v1", Kind: "ReplicaSet", Name: "custom"},
+	} {
+		// Owners that are not looked up need no client
+		root, err := resolveOwnerRoot(nil, "default", owner)
+		assert.NoError(t, err)
+		assert.Equal(t, owner, root)
+	}
+}
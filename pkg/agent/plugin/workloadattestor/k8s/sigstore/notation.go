@@ -0,0 +1,838 @@
+package sigstore
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/hashicorp/go-hclog"
+	"github.com/spiffe/spire/pkg/common/pemutil"
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	// NotationVerifierType verifies Notary v2 signatures using a notation
+	// trust policy
+	NotationVerifierType = "notation"
+
+	notationSelectorPrefix = "notation-signature"
+
+	notationSignatureArtifactType = "application/vnd.cncf.notary.signature"
+	notationJWSMediaType          = "application/jose+json"
+	notationPayloadContentType    = "application/vnd.cncf.notary.payload.v1+json"
+	notationSigningSchemeX509     = "notary.x509"
+	notationTrustPolicyVersion    = "1.0"
+	notationVerificationStrict    = "strict"
+
+	notationHeaderSigningScheme = "io.cncf.notary.signingScheme"
+	notationHeaderSigningTime   = "io.cncf.notary.signingTime"
+	notationHeaderExpiry        = "io.cncf.notary.expiry"
+
+	ociImageIndexMediaType    = "application/vnd.oci.image.index.v1+json"
+	ociImageManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+	ociArtifactMediaType      = "application/vnd.oci.artifact.manifest.v1+json"
+
+	// maxRegistryResponseSize bounds the size of the manifests and signature
+	// envelopes read from the registry.
+	maxRegistryResponseSize = 4 << 20
+
+	// ocspTimeout bounds each OCSP request of the revocation check
+	ocspTimeout = 10 * time.Second
+)
+
+// notationCriticalHeaders are the protected headers understood by the
+// verifier that may be listed as critical.
+var notationCriticalHeaders = map[string]bool{
+	notationHeaderSigningScheme: true,
+	notationHeaderExpiry:        true,
+}
+
+// notationRequiredSubjectAttributes are the distinguished name attributes
+// every trusted identity must have, as required by the notation trust policy
+// specification.
+var notationRequiredSubjectAttributes = []string{"C", "ST", "O"}
+
+// notationSubjectAttributes maps the distinguished name attribute types used
+// in trusted identities to their OIDs.
+var notationSubjectAttributes = map[string]string{
+	"CN":           "2.5.4.3",
+	"SERIALNUMBER": "2.5.4.5",
+	"C":            "2.5.4.6",
+	"L":            "2.5.4.7",
+	"ST":           "2.5.4.8",
+	"STREET":       "2.5.4.9",
+	"O":            "2.5.4.10",
+	"OU":           "2.5.4.11",
+	"POSTALCODE":   "2.5.4.17",
+}
+
+// The following structs are used to go through the notation trust policy and
+// the OCI registry json objects
+type notationTrustPolicyDocument struct {
+	Version       string                `json:"version"`
+	TrustPolicies []notationTrustPolicy `json:"trustPolicies"`
+}
+
+type notationTrustPolicy struct {
+	Name                  string   `json:"name"`
+	RegistryScopes        []string `json:"registryScopes"`
+	SignatureVerification struct {
+		Level string `json:"level"`
+	} `json:"signatureVerification"`
+	TrustStores       []string `json:"trustStores"`
+	TrustedIdentities []string `json:"trustedIdentities"`
+}
+
+type ociDescriptor struct {
+	MediaType    string `json:"mediaType"`
+	ArtifactType string `json:"artifactType"`
+	Digest       string `json:"digest"`
+	Size         int64  `json:"size"`
+}
+
+type ociIndex struct {
+	Manifests []ociDescriptor `json:"manifests"`
+}
+
+type ociManifest struct {
+	ArtifactType string          `json:"artifactType"`
+	Config       ociDescriptor   `json:"config"`
+	Layers       []ociDescriptor `json:"layers"`
+	Blobs        []ociDescriptor `json:"blobs"`
+}
+
+type jwsEnvelope struct {
+	Payload   string `json:"payload"`
+	Protected string `json:"protected"`
+	Header    struct {
+		CertChain []string `json:"x5c"`
+	} `json:"header"`
+	Signature string `json:"signature"`
+}
+
+type notationProtectedHeader struct {
+	Algorithm     string   `json:"alg"`
+	ContentType   string   `json:"cty"`
+	Critical      []string `json:"crit"`
+	SigningScheme string   `json:"io.cncf.notary.signingScheme"`
+	SigningTime   string   `json:"io.cncf.notary.signingTime"`
+	Expiry        string   `json:"io.cncf.notary.expiry"`
+}
+
+type notationPayload struct {
+	TargetArtifact ociDescriptor `json:"targetArtifact"`
+}
+
+type notationTrustStore struct {
+	name  string
+	roots *x509.CertPool
+}
+
+type notationPolicy struct {
+	name        string
+	scopes      []string
+	trustStores []notationTrustStore
+	anyIdentity bool
+	identities  []map[string]string
+}
+
+// notationVerifier verifies Notary v2 signatures discovered through the OCI
+// referrers API, following a notation trust policy.
+type notationVerifier struct {
+	name      string
+	policies  []notationPolicy
+	keychain  authn.Keychain
+	transport http.RoundTripper
+	now       func() time.Time
+	logger    hclog.Logger
+}
+
+func newNotationVerifier(name string, config *VerifierConfig, opts verifierOptions) (ImageVerifier, error) {
+	if config.TrustPolicyPath == "" {
+		return nil, fmt.Errorf("Verifier %q requires a trust policy path", name)
+	}
+	if config.TrustStoreDir == "" {
+		return nil, fmt.Errorf("Verifier %q requires a trust store directory", name)
+	}
+	policies, err := loadNotationTrustPolicy(config.TrustPolicyPath, config.TrustStoreDir)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load trust policy for verifier %q: %w", name, err)
+	}
+	return &notationVerifier{
+		name:      name,
+		policies:  policies,
+		keychain:  authn.DefaultKeychain,
+		transport: http.DefaultTransport,
+		now:       time.Now,
+		logger:    opts.logger,
+	}, nil
+}
+
+func loadNotationTrustPolicy(policyPath, trustStoreDir string) ([]notationPolicy, error) {
+	policyJSON, err := os.ReadFile(policyPath)
+	if err != nil {
+		return nil, err
+	}
+	var document notationTrustPolicyDocument
+	if err := json.Unmarshal(policyJSON, &document); err != nil {
+		return nil, fmt.Errorf("Error parsing trust policy: %w", err)
+	}
+	if document.Version != notationTrustPolicyVersion {
+		return nil, fmt.Errorf("Unsupported trust policy version %q", document.Version)
+	}
+	if len(document.TrustPolicies) == 0 {
+		return nil, errors.New("Trust policy has no policies")
+	}
+
+	trustStores := make(map[string]notationTrustStore)
+	var policies []notationPolicy
+	for _, trustPolicy := range document.TrustPolicies {
+		policy := notationPolicy{
+			name: trustPolicy.Name,
+		}
+		if len(trustPolicy.RegistryScopes) == 0 {
+			return nil, fmt.Errorf("Trust policy %q has no registry scopes", policy.name)
+		}
+		// Scopes are normalized like the image repositories, so that e.g.
+		// "docker.io/library/nginx" matches "index.docker.io/library/nginx".
+		for _, scope := range trustPolicy.RegistryScopes {
+			if scope != "*" {
+				repository, err := name.NewRepository(scope)
+				if err != nil {
+					return nil, fmt.Errorf("Trust policy %q has invalid registry scope %q: %w", policy.name, scope, err)
+				}
+				scope = repository.Name()
+			}
+			policy.scopes = append(policy.scopes, scope)
+		}
+		level := trustPolicy.SignatureVerification.Level
+		if level != "" && level != notationVerificationStrict {
+			return nil, fmt.Errorf("Trust policy %q has unsupported verification level %q", policy.name, level)
+		}
+
+		if len(trustPolicy.TrustStores) == 0 {
+			return nil, fmt.Errorf("Trust policy %q has no trust stores", policy.name)
+		}
+		for _, storeName := range trustPolicy.TrustStores {
+			store, ok := trustStores[storeName]
+			if !ok {
+				store, err = loadNotationTrustStore(trustStoreDir, storeName)
+				if err != nil {
+					return nil, fmt.Errorf("Trust policy %q: %w", policy.name, err)
+				}
+				trustStores[storeName] = store
+			}
+			policy.trustStores = append(policy.trustStores, store)
+		}
+
+		if len(trustPolicy.TrustedIdentities) == 0 {
+			return nil, fmt.Errorf("Trust policy %q has no trusted identities", policy.name)
+		}
+		for _, identity := range trustPolicy.TrustedIdentities {
+			if identity == "*" {
+				policy.anyIdentity = true
+				continue
+			}
+			attributes, err := parseNotationIdentity(identity)
+			if err != nil {
+				return nil, fmt.Errorf("Trust policy %q: %w", policy.name, err)
+			}
+			policy.identities = append(policy.identities, attributes)
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+// loadNotationTrustStore loads the certificates of a trust store, named as
+// "<type>:<name>", from the "x509/<type>/<name>" directory of the trust store
+// directory.
+func loadNotationTrustStore(trustStoreDir, storeName string) (notationTrustStore, error) {
+	parts := strings.SplitN(storeName, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return notationTrustStore{}, fmt.Errorf("Invalid trust store %q", storeName)
+	}
+	storePath := filepath.Join(trustStoreDir, "x509", parts[0], parts[1])
+	files, err := os.ReadDir(storePath)
+	if err != nil {
+		return notationTrustStore{}, fmt.Errorf("Unable to read trust store %q: %w", storeName, err)
+	}
+
+	roots := x509.NewCertPool()
+	count := 0
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		certBytes, err := os.ReadFile(filepath.Join(storePath, file.Name()))
+		if err != nil {
+			return notationTrustStore{}, err
+		}
+		certs, err := pemutil.ParseCertificates(certBytes)
+		if err != nil {
+			cert, derErr := x509.ParseCertificate(certBytes)
+			if derErr != nil {
+				return notationTrustStore{}, fmt.Errorf("Unable to parse certificate %q in trust store %q: %w", file.Name(), storeName, err)
+			}
+			certs = []*x509.Certificate{cert}
+		}
+		for _, cert := range certs {
+			roots.AddCert(cert)
+			count++
+		}
+	}
+	if count == 0 {
+		return notationTrustStore{}, fmt.Errorf("Trust store %q has no certificates", storeName)
+	}
+	return notationTrustStore{
+		name:  storeName,
+		roots: roots,
+	}, nil
+}
+
+// parseNotationIdentity parses a trusted identity of the form
+// "x509.subject: C=US, O=example" into its distinguished name attributes.
+func parseNotationIdentity(identity string) (map[string]string, error) {
+	parts := strings.SplitN(identity, ":", 2)
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) != "x509.subject" {
+		return nil, fmt.Errorf("Unsupported trusted identity %q", identity)
+	}
+	attributes := make(map[string]string)
+	for _, rdn := range strings.Split(parts[1], ",") {
+		kv := strings.SplitN(strings.TrimSpace(rdn), "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("Invalid trusted identity %q", identity)
+		}
+		key := strings.ToUpper(strings.TrimSpace(kv[0]))
+		if _, ok := notationSubjectAttributes[key]; !ok {
+			return nil, fmt.Errorf("Unsupported attribute %q in trusted identity %q", kv[0], identity)
+		}
+		if _, ok := attributes[key]; ok {
+			return nil, fmt.Errorf("Duplicated attribute %q in trusted identity %q", kv[0], identity)
+		}
+		attributes[key] = strings.TrimSpace(kv[1])
+	}
+	for _, key := range notationRequiredSubjectAttributes {
+		if attributes[key] == "" {
+			return nil, fmt.Errorf("Trusted identity %q must have the %s attributes", identity, strings.Join(notationRequiredSubjectAttributes, ", "))
+		}
+	}
+	return attributes, nil
+}
+
+// policyForRepository returns the trust policy scoped to the repository, or
+// the wildcard policy if none is.
+func (v *notationVerifier) policyForRepository(repository string) (*notationPolicy, error) {
+	var wildcard *notationPolicy
+	for i := range v.policies {
+		for _, scope := range v.policies[i].scopes {
+			switch scope {
+			case repository:
+				return &v.policies[i], nil
+			case "*":
+				wildcard = &v.policies[i]
+			}
+		}
+	}
+	if wildcard == nil {
+		return nil, fmt.Errorf("No trust policy applies to %s", repository)
+	}
+	return wildcard, nil
+}
+
+func (v *notationVerifier) Verify(ctx context.Context, ref name.Digest) ([]SelectorsFromSignatures, error) {
+	policy, err := v.policyForRepository(ref.Context().Name())
+	if err != nil {
+		return nil, err
+	}
+
+	// Registry credentials are resolved like cosign does for the other
+	// verifiers.
+	auth, err := v.keychain.Resolve(ref.Context())
+	if err != nil {
+		return nil, fmt.Errorf("Error resolving registry credentials: %w", err)
+	}
+	rt, err := transport.NewWithContext(ctx, ref.Context().Registry, auth, v.transport, []string{ref.Scope(transport.PullScope)})
+	if err != nil {
+		return nil, fmt.Errorf("Error connecting to registry: %w", err)
+	}
+	client := &http.Client{Transport: rt}
+
+	descriptors, err := v.signatureReferrers(ctx, client, ref)
+	if err != nil {
+		return nil, err
+	}
+	if len(descriptors) == 0 {
+		return nil, fmt.Errorf("No notation signatures found for %s", ref.String())
+	}
+
+	var selectors []SelectorsFromSignatures
+	var validationErrs []string
+	for _, descriptor := range descriptors {
+		envelope, err := v.fetchSignatureEnvelope(ctx, client, ref, descriptor)
+		if err == nil {
+			var sigSelectors SelectorsFromSignatures
+			sigSelectors, err = v.verifyEnvelope(ctx, policy, envelope, ref)
+			if err == nil {
+				selectors = append(selectors, sigSelectors)
+				continue
+			}
+		}
+		v.logger.Debug("Notation signature not verified", "signature", descriptor.Digest, "error", err)
+		validationErrs = append(validationErrs, err.Error())
+	}
+	if len(selectors) == 0 {
+		return nil, fmt.Errorf("No verified notation signatures: %s", strings.Join(validationErrs, "; "))
+	}
+	return selectors, nil
+}
+
+// signatureReferrers discovers the notation signatures of the image through
+// the OCI referrers API, falling back to the referrers tag schema for
+// registries that do not support it.
+func (v *notationVerifier) signatureReferrers(ctx context.Context, client *http.Client, ref name.Digest) ([]ociDescriptor, error) {
+	repo := ref.Context()
+	referrersURL := url.URL{
+		Scheme:   repo.Registry.Scheme(),
+		Host:     repo.RegistryStr(),
+		Path:     fmt.Sprintf("/v2/%s/referrers/%s", repo.RepositoryStr(), ref.DigestStr()),
+		RawQuery: url.Values{"artifactType": []string{notationSignatureArtifactType}}.Encode(),
+	}
+	body, statusCode, err := registryGet(ctx, client, referrersURL, ociImageIndexMediaType)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == http.StatusNotFound {
+		referrersURL.Path = fmt.Sprintf("/v2/%s/manifests/%s", repo.RepositoryStr(), strings.Replace(ref.DigestStr(), ":", "-", 1))
+		referrersURL.RawQuery = ""
+		body, statusCode, err = registryGet(ctx, client, referrersURL, ociImageIndexMediaType)
+		if err != nil {
+			return nil, err
+		}
+		if statusCode == http.StatusNotFound {
+			return nil, nil
+		}
+	}
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("Unexpected status code listing referrers: %d", statusCode)
+	}
+
+	var index ociIndex
+	if err := json.Unmarshal(body, &index); err != nil {
+		return nil, fmt.Errorf("Error parsing referrers: %w", err)
+	}
+	var descriptors []ociDescriptor
+	for _, descriptor := range index.Manifests {
+		if descriptor.ArtifactType == notationSignatureArtifactType {
+			descriptors = append(descriptors, descriptor)
+		}
+	}
+	return descriptors, nil
+}
+
+// fetchSignatureEnvelope fetches the JWS signature envelope of a notation
+// signature manifest.
+func (v *notationVerifier) fetchSignatureEnvelope(ctx context.Context, client *http.Client, ref name.Digest, descriptor ociDescriptor) ([]byte, error) {
+	repo := ref.Context()
+	manifestURL := url.URL{
+		Scheme: repo.Registry.Scheme(),
+		Host:   repo.RegistryStr(),
+		Path:   fmt.Sprintf("/v2/%s/manifests/%s", repo.RepositoryStr(), descriptor.Digest),
+	}
+	body, err := registryGetDigest(ctx, client, manifestURL, descriptor.Digest, ociImageManifestMediaType+", "+ociArtifactMediaType)
+	if err != nil {
+		return nil, err
+	}
+	var manifest ociManifest
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return nil, fmt.Errorf("Error parsing signature manifest: %w", err)
+	}
+
+	for _, layer := range append(manifest.Layers, manifest.Blobs...) {
+		if layer.MediaType != notationJWSMediaType {
+			continue
+		}
+		blobURL := url.URL{
+			Scheme: repo.Registry.Scheme(),
+			Host:   repo.RegistryStr(),
+			Path:   fmt.Sprintf("/v2/%s/blobs/%s", repo.RepositoryStr(), layer.Digest),
+		}
+		return registryGetDigest(ctx, client, blobURL, layer.Digest, "")
+	}
+	return nil, fmt.Errorf("Signature %s has no supported signature envelope", descriptor.Digest)
+}
+
+// verifyEnvelope verifies a JWS signature envelope against the trust policy
+// and returns the selectors of the signature.
+func (v *notationVerifier) verifyEnvelope(ctx context.Context, policy *notationPolicy, envelopeJSON []byte, ref name.Digest) (SelectorsFromSignatures, error) {
+	var envelope jwsEnvelope
+	if err := json.Unmarshal(envelopeJSON, &envelope); err != nil {
+		return SelectorsFromSignatures{}, fmt.Errorf("Error parsing signature envelope: %w", err)
+	}
+
+	headerJSON, err := base64.RawURLEncoding.DecodeString(envelope.Protected)
+	if err != nil {
+		return SelectorsFromSignatures{}, fmt.Errorf("Error decoding protected header: %w", err)
+	}
+	var header notationProtectedHeader
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return SelectorsFromSignatures{}, fmt.Errorf("Error parsing protected header: %w", err)
+	}
+	if header.ContentType != notationPayloadContentType {
+		return SelectorsFromSignatures{}, fmt.Errorf("Unsupported payload content type %q", header.ContentType)
+	}
+	if header.SigningScheme != notationSigningSchemeX509 {
+		return SelectorsFromSignatures{}, fmt.Errorf("Unsupported signing scheme %q", header.SigningScheme)
+	}
+	critical := make(map[string]bool)
+	for _, h := range header.Critical {
+		if !notationCriticalHeaders[h] {
+			return SelectorsFromSignatures{}, fmt.Errorf("Unsupported critical header %q", h)
+		}
+		critical[h] = true
+	}
+	if !critical[notationHeaderSigningScheme] {
+		return SelectorsFromSignatures{}, fmt.Errorf("Header %q is not marked as critical", notationHeaderSigningScheme)
+	}
+	if header.Expiry != "" && !critical[notationHeaderExpiry] {
+		return SelectorsFromSignatures{}, fmt.Errorf("Header %q is not marked as critical", notationHeaderExpiry)
+	}
+
+	certs, err := parseCertChain(envelope.Header.CertChain)
+	if err != nil {
+		return SelectorsFromSignatures{}, err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(envelope.Signature)
+	if err != nil {
+		return SelectorsFromSignatures{}, fmt.Errorf("Error decoding signature: %w", err)
+	}
+	signingInput := []byte(envelope.Protected + "." + envelope.Payload)
+	if err := verifyJWSSignature(header.Algorithm, certs[0].PublicKey, signingInput, signature); err != nil {
+		return SelectorsFromSignatures{}, err
+	}
+
+	// Timestamp countersignatures are not supported, so the certificate
+	// chain must be valid at verification time, and the signing time
+	// claimed by the signer must be within the signing certificate validity.
+	if err := verifySigningTime(header.SigningTime, certs[0]); err != nil {
+		return SelectorsFromSignatures{}, err
+	}
+	trustStore, chain, err := verifyNotationCertChain(policy, certs, v.now())
+	if err != nil {
+		return SelectorsFromSignatures{}, err
+	}
+	if !policy.trustsIdentity(certs[0]) {
+		return SelectorsFromSignatures{}, fmt.Errorf("Signing certificate subject %q is not a trusted identity", certs[0].Subject.String())
+	}
+	if err := v.checkRevocation(ctx, chain); err != nil {
+		return SelectorsFromSignatures{}, err
+	}
+
+	if header.Expiry != "" {
+		expiry, err := time.Parse(time.RFC3339, header.Expiry)
+		if err != nil {
+			return SelectorsFromSignatures{}, fmt.Errorf("Error parsing signature expiry: %w", err)
+		}
+		if !v.now().Before(expiry) {
+			return SelectorsFromSignatures{}, fmt.Errorf("Signature expired at %s", header.Expiry)
+		}
+	}
+
+	payloadJSON, err := base64.RawURLEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return SelectorsFromSignatures{}, fmt.Errorf("Error decoding payload: %w", err)
+	}
+	var payload notationPayload
+	if err := json.Unmarshal(payloadJSON, &payload); err != nil {
+		return SelectorsFromSignatures{}, fmt.Errorf("Error parsing payload: %w", err)
+	}
+	if payload.TargetArtifact.Digest != ref.DigestStr() {
+		return SelectorsFromSignatures{}, fmt.Errorf("Signed digest %s does not match %s", payload.TargetArtifact.Digest, ref.DigestStr())
+	}
+
+	return SelectorsFromSignatures{
+		Prefix:     notationSelectorPrefix,
+		Subject:    certs[0].Subject.String(),
+		TrustStore: trustStore,
+		Issuer:     certs[0].Issuer.String(),
+		KeyID:      certKeyID(certs[0]),
+		Verified:   true,
+	}, nil
+}
+
+// trustsIdentity returns true if the certificate subject contains all the
+// attributes of any trusted identity of the policy.
+func (policy *notationPolicy) trustsIdentity(cert *x509.Certificate) bool {
+	if policy.anyIdentity {
+		return true
+	}
+	subject := make(map[string]string)
+	for _, attribute := range cert.Subject.Names {
+		subject[attribute.Type.String()] = fmt.Sprint(attribute.Value)
+	}
+	for _, identity := range policy.identities {
+		matches := true
+		for key, value := range identity {
+			if subject[notationSubjectAttributes[key]] != value {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyNotationCertChain verifies the signing certificate chain against the
+// trust stores of the policy and returns the name of the trust store that
+// verified it, along with the verified chain.
+func verifyNotationCertChain(policy *notationPolicy, certs []*x509.Certificate, now time.Time) (string, []*x509.Certificate, error) {
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	for _, store := range policy.trustStores {
+		chains, err := certs[0].Verify(x509.VerifyOptions{
+			Roots:         store.roots,
+			Intermediates: intermediates,
+			CurrentTime:   now,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		})
+		if err == nil {
+			return store.name, chains[0], nil
+		}
+	}
+	return "", nil, fmt.Errorf("Signing certificate %q is not trusted by policy %q", certs[0].Subject.String(), policy.name)
+}
+
+// verifySigningTime checks that the signing time header is set and within
+// the validity of the signing certificate.
+func verifySigningTime(signingTime string, cert *x509.Certificate) error {
+	if signingTime == "" {
+		return fmt.Errorf("Signature has no %q header", notationHeaderSigningTime)
+	}
+	t, err := time.Parse(time.RFC3339, signingTime)
+	if err != nil {
+		return fmt.Errorf("Error parsing signing time: %w", err)
+	}
+	if t.Before(cert.NotBefore) || t.After(cert.NotAfter) {
+		return fmt.Errorf("Signing time %s is outside of the signing certificate validity", signingTime)
+	}
+	return nil
+}
+
+// checkRevocation checks the revocation status of the certificates of the
+// verified chain with their OCSP responders, as the strict verification level
+// enforces. Certificates without an OCSP responder are not checked. A
+// certificate that is revoked, or whose status cannot be determined, fails
+// the verification.
+func (v *notationVerifier) checkRevocation(ctx context.Context, chain []*x509.Certificate) error {
+	client := &http.Client{
+		Transport: v.transport,
+		Timeout:   ocspTimeout,
+	}
+	for i := 0; i+1 < len(chain); i++ {
+		cert, issuer := chain[i], chain[i+1]
+		if len(cert.OCSPServer) == 0 {
+			continue
+		}
+		if err := checkOCSP(ctx, client, cert, issuer, v.now()); err != nil {
+			return fmt.Errorf("Revocation check of certificate %q failed: %w", cert.Subject.String(), err)
+		}
+	}
+	return nil
+}
+
+// checkOCSP queries the OCSP responders of the certificate, in order, until
+// one returns a valid response.
+func checkOCSP(ctx context.Context, client *http.Client, cert, issuer *x509.Certificate, now time.Time) error {
+	request, err := ocsp.CreateRequest(cert, issuer, nil)
+	if err != nil {
+		return err
+	}
+	var errs []string
+	for _, server := range cert.OCSPServer {
+		resp, err := queryOCSP(ctx, client, server, request, issuer)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		switch {
+		case resp.SerialNumber.Cmp(cert.SerialNumber) != 0:
+			errs = append(errs, fmt.Sprintf("%s: response for another certificate", server))
+			continue
+		case !resp.NextUpdate.IsZero() && now.After(resp.NextUpdate):
+			errs = append(errs, fmt.Sprintf("%s: response is outdated", server))
+			continue
+		}
+		switch resp.Status {
+		case ocsp.Good:
+			return nil
+		case ocsp.Revoked:
+			return fmt.Errorf("certificate revoked at %s", resp.RevokedAt.Format(time.RFC3339))
+		default:
+			return errors.New("certificate status is unknown")
+		}
+	}
+	return fmt.Errorf("no valid OCSP response: %s", strings.Join(errs, "; "))
+}
+
+func queryOCSP(ctx context.Context, client *http.Client, server string, request []byte, issuer *x509.Certificate) (*ocsp.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server, bytes.NewReader(request))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/ocsp-request")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", server, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: unexpected status code %d", server, resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxRegistryResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", server, err)
+	}
+	ocspResp, err := ocsp.ParseResponse(body, issuer)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", server, err)
+	}
+	return ocspResp, nil
+}
+
+func parseCertChain(encoded []string) ([]*x509.Certificate, error) {
+	if len(encoded) == 0 {
+		return nil, errors.New("Signature envelope has no certificate chain")
+	}
+	var certs []*x509.Certificate
+	for _, certB64 := range encoded {
+		certDER, err := base64.StdEncoding.DecodeString(certB64)
+		if err != nil {
+			return nil, fmt.Errorf("Error decoding certificate: %w", err)
+		}
+		cert, err := x509.ParseCertificate(certDER)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+// notationAlgorithmCurves maps the ECDSA signature algorithms to the curve of
+// the key they are defined for.
+var notationAlgorithmCurves = map[string]elliptic.Curve{
+	"ES256": elliptic.P256(),
+	"ES384": elliptic.P384(),
+	"ES512": elliptic.P521(),
+}
+
+// verifyJWSSignature verifies a JWS signature made with one of the algorithms
+// allowed by the notation signature specification.
+func verifyJWSSignature(algorithm string, publicKey crypto.PublicKey, signingInput, signature []byte) error {
+	var hash crypto.Hash
+	switch algorithm {
+	case "ES256", "PS256":
+		hash = crypto.SHA256
+	case "ES384", "PS384":
+		hash = crypto.SHA384
+	case "ES512", "PS512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("Unsupported signature algorithm %q", algorithm)
+	}
+	hasher := hash.New()
+	hasher.Write(signingInput)
+	digest := hasher.Sum(nil)
+
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		if notationAlgorithmCurves[algorithm] != key.Curve {
+			return fmt.Errorf("Algorithm %s does not match the %s curve of the signing key", algorithm, key.Curve.Params().Name)
+		}
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return fmt.Errorf("Invalid %s signature for ECDSA key", algorithm)
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(key, digest, r, s) {
+			return errors.New("Signature verification failed")
+		}
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(algorithm, "PS") {
+			return fmt.Errorf("Invalid %s signature for RSA key", algorithm)
+		}
+		if err := rsa.VerifyPSS(key, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}); err != nil {
+			return errors.New("Signature verification failed")
+		}
+	default:
+		return fmt.Errorf("Unsupported public key type %T", publicKey)
+	}
+	return nil
+}
+
+// registryGet performs a GET request against the registry, returning the
+// response body and status code.
+func registryGet(ctx context.Context, client *http.Client, u url.URL, accept string) ([]byte, int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error performing registry request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxRegistryResponseSize))
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error reading registry response: %w", err)
+	}
+	return body, resp.StatusCode, nil
+}
+
+// registryGetDigest fetches content addressed by digest from the registry,
+// validating that the content matches the digest.
+func registryGetDigest(ctx context.Context, client *http.Client, u url.URL, digest, accept string) ([]byte, error) {
+	body, statusCode, err := registryGet(ctx, client, u, accept)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("Unexpected status code fetching %s: %d", digest, statusCode)
+	}
+	sum := sha256.Sum256(body)
+	if "sha256:"+hex.EncodeToString(sum[:]) != digest {
+		return nil, fmt.Errorf("Content does not match digest %s", digest)
+	}
+	return body, nil
+}
@@ -0,0 +1,757 @@
+package sigstore
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/hashicorp/go-hclog"
+	"github.com/spiffe/spire/pkg/common/pemutil"
+	"golang.org/x/crypto/ocsp"
+)
+
+var (
+	notationNow = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type notationCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newNotationCA(t *testing.T, commonName string) notationCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             notationNow.Add(-time.Hour),
+		NotAfter:              notationNow.Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return notationCA{cert: cert, key: key}
+}
+
+func (ca notationCA) issueSigner(t *testing.T, subject pkix.Name, ocspServers ...string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	return ca.issueSignerWithCurve(t, subject, elliptic.P256(), ocspServers...)
+}
+
+func (ca notationCA) issueSignerWithCurve(t *testing.T, subject pkix.Name, curve elliptic.Curve, ocspServers ...string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      subject,
+		NotBefore:    notationNow.Add(-time.Hour),
+		NotAfter:     notationNow.Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		OCSPServer:   ocspServers,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, key.Public(), ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+type notationEnvelopeOptions struct {
+	digest      string
+	expiry      string
+	critical    []string
+	algorithm   string
+	signingTime string
+	// noCritical leaves the signing scheme and expiry headers out of the
+	// critical headers
+	noCritical bool
+}
+
+func newNotationEnvelope(t *testing.T, cert *x509.Certificate, key *ecdsa.PrivateKey, opts notationEnvelopeOptions) []byte {
+	algorithm := opts.algorithm
+	if algorithm == "" {
+		algorithm = "ES256"
+	}
+	signingTime := opts.signingTime
+	if signingTime == "" {
+		signingTime = notationNow.Format(time.RFC3339)
+	}
+	critical := opts.critical
+	if !opts.noCritical {
+		critical = append([]string{notationHeaderSigningScheme}, critical...)
+	}
+	header := map[string]interface{}{
+		"alg":                          algorithm,
+		"cty":                          notationPayloadContentType,
+		"crit":                         critical,
+		"io.cncf.notary.signingScheme": notationSigningSchemeX509,
+		"io.cncf.notary.signingTime":   signingTime,
+	}
+	if opts.expiry != "" {
+		header["io.cncf.notary.expiry"] = opts.expiry
+		if !opts.noCritical {
+			header["crit"] = append(critical, notationHeaderExpiry)
+		}
+	}
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payloadJSON, err := json.Marshal(notationPayload{
+		TargetArtifact: ociDescriptor{
+			MediaType: ociImageManifestMediaType,
+			Digest:    opts.digest,
+			Size:      8,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	protected := base64.RawURLEncoding.EncodeToString(headerJSON)
+	payload := base64.RawURLEncoding.EncodeToString(payloadJSON)
+	hash := crypto.SHA256
+	switch algorithm {
+	case "ES384":
+		hash = crypto.SHA384
+	case "ES512":
+		hash = crypto.SHA512
+	}
+	hasher := hash.New()
+	hasher.Write([]byte(protected + "." + payload))
+	r, s, err := ecdsa.Sign(rand.Reader, key, hasher.Sum(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	size := (key.Curve.Params().BitSize + 7) / 8
+	signature := make([]byte, 2*size)
+	r.FillBytes(signature[:size])
+	s.FillBytes(signature[size:])
+
+	envelope := jwsEnvelope{
+		Payload:   payload,
+		Protected: protected,
+		Signature: base64.RawURLEncoding.EncodeToString(signature),
+	}
+	envelope.Header.CertChain = []string{base64.StdEncoding.EncodeToString(cert.Raw)}
+	envelopeJSON, err := json.Marshal(envelope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return envelopeJSON
+}
+
+func contentDigest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// fakeRegistry serves notation signatures for a single image through either
+// the referrers API or the referrers tag schema.
+type fakeRegistry struct {
+	repository    string
+	digest        string
+	referrersTag  bool
+	envelopes     [][]byte
+	manifests     map[string][]byte
+	blobs         map[string][]byte
+	referrersJSON []byte
+}
+
+func newFakeRegistry(t *testing.T, repository, digest string, referrersTag bool, envelopes ...[]byte) *httptest.Server {
+	registry := &fakeRegistry{
+		repository:   repository,
+		digest:       digest,
+		referrersTag: referrersTag,
+		envelopes:    envelopes,
+		manifests:    make(map[string][]byte),
+		blobs:        make(map[string][]byte),
+	}
+
+	index := ociIndex{}
+	for _, envelope := range envelopes {
+		envelopeDigest := contentDigest(envelope)
+		registry.blobs[envelopeDigest] = envelope
+		manifestJSON, err := json.Marshal(ociManifest{
+			ArtifactType: notationSignatureArtifactType,
+			Layers: []ociDescriptor{
+				{
+					MediaType: notationJWSMediaType,
+					Digest:    envelopeDigest,
+					Size:      int64(len(envelope)),
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		manifestDigest := contentDigest(manifestJSON)
+		registry.manifests[manifestDigest] = manifestJSON
+		index.Manifests = append(index.Manifests, ociDescriptor{
+			MediaType:    ociImageManifestMediaType,
+			ArtifactType: notationSignatureArtifactType,
+			Digest:       manifestDigest,
+			Size:         int64(len(manifestJSON)),
+		})
+	}
+	// Signatures of other types must be ignored
+	index.Manifests = append(index.Manifests, ociDescriptor{
+		MediaType:    ociImageManifestMediaType,
+		ArtifactType: "application/vnd.dev.cosign.artifact.sig.v1+json",
+		Digest:       testDigest,
+	})
+	referrersJSON, err := json.Marshal(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry.referrersJSON = referrersJSON
+
+	server := httptest.NewServer(registry)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	prefix := "/v2/" + r.repository
+	switch {
+	case req.URL.Path == "/v2/":
+		w.WriteHeader(http.StatusOK)
+	case req.URL.Path == prefix+"/referrers/"+r.digest && !r.referrersTag:
+		if req.URL.Query().Get("artifactType") != notationSignatureArtifactType {
+			http.Error(w, "unexpected artifact type", http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write(r.referrersJSON)
+	case req.URL.Path == prefix+"/manifests/"+strings.Replace(r.digest, ":", "-", 1) && r.referrersTag:
+		_, _ = w.Write(r.referrersJSON)
+	case strings.HasPrefix(req.URL.Path, prefix+"/manifests/"):
+		manifest, ok := r.manifests[strings.TrimPrefix(req.URL.Path, prefix+"/manifests/")]
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		_, _ = w.Write(manifest)
+	case strings.HasPrefix(req.URL.Path, prefix+"/blobs/"):
+		blob, ok := r.blobs[strings.TrimPrefix(req.URL.Path, prefix+"/blobs/")]
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		_, _ = w.Write(blob)
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+// newOCSPResponder serves OCSP responses with the given status for the
+// certificates issued by the CA.
+func newOCSPResponder(t *testing.T, ca notationCA, status int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		ocspReq, err := ocsp.ParseRequest(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		template := ocsp.Response{
+			Status:       status,
+			SerialNumber: ocspReq.SerialNumber,
+			ThisUpdate:   notationNow.Add(-time.Minute),
+			NextUpdate:   notationNow.Add(time.Hour),
+		}
+		if status == ocsp.Revoked {
+			template.RevokedAt = notationNow.Add(-time.Minute)
+		}
+		resp, err := ocsp.CreateResponse(ca.cert, ca.cert, template, ca.key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write(resp)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func writeNotationTrustPolicy(t *testing.T, dir string, policy string) string {
+	policyPath := filepath.Join(dir, "trustpolicy.json")
+	if err := os.WriteFile(policyPath, []byte(policy), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return policyPath
+}
+
+func writeNotationTrustStore(t *testing.T, dir, storeType, storeName string, certs ...*x509.Certificate) {
+	storeDir := filepath.Join(dir, "x509", storeType, storeName)
+	if err := os.MkdirAll(storeDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(storeDir, "certs.pem"), pemutil.EncodeCertificates(certs), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNotationVerifier_Verify(t *testing.T) {
+	ca := newNotationCA(t, "acme-rockets CA")
+	untrustedCA := newNotationCA(t, "untrusted CA")
+	signerSubject := pkix.Name{
+		Country:      []string{"US"},
+		Province:     []string{"WA"},
+		Organization: []string{"acme-rockets.io"},
+		CommonName:   "signer",
+	}
+	signerCert, signerKey := ca.issueSigner(t, signerSubject)
+	otherSignerCert, otherSignerKey := ca.issueSigner(t, pkix.Name{
+		Country:      []string{"US"},
+		Province:     []string{"WA"},
+		Organization: []string{"other.io"},
+		CommonName:   "other",
+	})
+	p384SignerCert, p384SignerKey := ca.issueSignerWithCurve(t, signerSubject, elliptic.P384())
+	goodOCSPCert, goodOCSPKey := ca.issueSigner(t, signerSubject, newOCSPResponder(t, ca, ocsp.Good).URL)
+	revokedCert, revokedKey := ca.issueSigner(t, signerSubject, newOCSPResponder(t, ca, ocsp.Revoked).URL)
+	unknownOCSPCert, unknownOCSPKey := ca.issueSigner(t, signerSubject, newOCSPResponder(t, ca, ocsp.Unknown).URL)
+	untrustedCert, untrustedKey := untrustedCA.issueSigner(t, pkix.Name{
+		Organization: []string{"acme-rockets.io"},
+	})
+
+	dir := t.TempDir()
+	writeNotationTrustStore(t, dir, "ca", "acme-rockets", ca.cert)
+	policyPath := writeNotationTrustPolicy(t, dir, `{
+		"version": "1.0",
+		"trustPolicies": [
+			{
+				"name": "acme-rockets-images",
+				"registryScopes": ["*"],
+				"signatureVerification": {"level": "strict"},
+				"trustStores": ["ca:acme-rockets"],
+				"trustedIdentities": ["x509.subject: C=US, ST=WA, O=acme-rockets.io"]
+			}
+		]
+	}`)
+
+	tests := []struct {
+		name         string
+		envelopes    [][]byte
+		referrersTag bool
+		want         []SelectorsFromSignatures
+		wantErr      string
+	}{
+		{
+			name: "verified signature",
+			envelopes: [][]byte{
+				newNotationEnvelope(t, signerCert, signerKey, notationEnvelopeOptions{digest: testDigest}),
+			},
+			want: []SelectorsFromSignatures{
+				{
+					Prefix:     notationSelectorPrefix,
+					Subject:    "CN=signer,O=acme-rockets.io,ST=WA,C=US",
+					TrustStore: "ca:acme-rockets",
+					Issuer:     "CN=acme-rockets CA",
+					Verified:   true,
+				},
+			},
+		},
+		{
+			name: "verified signature through referrers tag schema",
+			envelopes: [][]byte{
+				newNotationEnvelope(t, signerCert, signerKey, notationEnvelopeOptions{digest: testDigest}),
+			},
+			referrersTag: true,
+			want: []SelectorsFromSignatures{
+				{
+					Prefix:     notationSelectorPrefix,
+					Subject:    "CN=signer,O=acme-rockets.io,ST=WA,C=US",
+					TrustStore: "ca:acme-rockets",
+					Issuer:     "CN=acme-rockets CA",
+					Verified:   true,
+				},
+			},
+		},
+		{
+			name: "only verified signatures are returned",
+			envelopes: [][]byte{
+				newNotationEnvelope(t, untrustedCert, untrustedKey, notationEnvelopeOptions{digest: testDigest}),
+				newNotationEnvelope(t, signerCert, signerKey, notationEnvelopeOptions{digest: testDigest, expiry: notationNow.Add(time.Hour).Format(time.RFC3339)}),
+			},
+			want: []SelectorsFromSignatures{
+				{
+					Prefix:     notationSelectorPrefix,
+					Subject:    "CN=signer,O=acme-rockets.io,ST=WA,C=US",
+					TrustStore: "ca:acme-rockets",
+					Issuer:     "CN=acme-rockets CA",
+					Verified:   true,
+				},
+			},
+		},
+		{
+			name:    "no signatures",
+			wantErr: "No notation signatures found",
+		},
+		{
+			name: "signature for another digest",
+			envelopes: [][]byte{
+				newNotationEnvelope(t, signerCert, signerKey, notationEnvelopeOptions{digest: "sha256:0cfdaced91cb46dd7af48309799a3c351e4ca2d5e1ee9737ca0cbd932cb79898"}),
+			},
+			wantErr: "does not match",
+		},
+		{
+			name: "untrusted signing certificate",
+			envelopes: [][]byte{
+				newNotationEnvelope(t, untrustedCert, untrustedKey, notationEnvelopeOptions{digest: testDigest}),
+			},
+			wantErr: "is not trusted by policy",
+		},
+		{
+			name: "untrusted identity",
+			envelopes: [][]byte{
+				newNotationEnvelope(t, otherSignerCert, otherSignerKey, notationEnvelopeOptions{digest: testDigest}),
+			},
+			wantErr: "is not a trusted identity",
+		},
+		{
+			name: "expired signature",
+			envelopes: [][]byte{
+				newNotationEnvelope(t, signerCert, signerKey, notationEnvelopeOptions{digest: testDigest, expiry: notationNow.Add(-time.Minute).Format(time.RFC3339)}),
+			},
+			wantErr: "Signature expired",
+		},
+		{
+			name: "unsupported critical header",
+			envelopes: [][]byte{
+				newNotationEnvelope(t, signerCert, signerKey, notationEnvelopeOptions{digest: testDigest, critical: []string{"io.cncf.notary.unknown"}}),
+			},
+			wantErr: "Unsupported critical header",
+		},
+		{
+			name: "signing scheme not marked as critical",
+			envelopes: [][]byte{
+				newNotationEnvelope(t, signerCert, signerKey, notationEnvelopeOptions{digest: testDigest, noCritical: true}),
+			},
+			wantErr: "is not marked as critical",
+		},
+		{
+			name: "expiry not marked as critical",
+			envelopes: [][]byte{
+				newNotationEnvelope(t, signerCert, signerKey, notationEnvelopeOptions{digest: testDigest, expiry: notationNow.Add(time.Hour).Format(time.RFC3339), noCritical: true, critical: []string{notationHeaderSigningScheme}}),
+			},
+			wantErr: "\"io.cncf.notary.expiry\" is not marked as critical",
+		},
+		{
+			name: "verified signature with P-384 key",
+			envelopes: [][]byte{
+				newNotationEnvelope(t, p384SignerCert, p384SignerKey, notationEnvelopeOptions{digest: testDigest, algorithm: "ES384"}),
+			},
+			want: []SelectorsFromSignatures{
+				{
+					Prefix:     notationSelectorPrefix,
+					Subject:    "CN=signer,O=acme-rockets.io,ST=WA,C=US",
+					TrustStore: "ca:acme-rockets",
+					Issuer:     "CN=acme-rockets CA",
+					Verified:   true,
+				},
+			},
+		},
+		{
+			name: "algorithm does not match the key curve",
+			envelopes: [][]byte{
+				newNotationEnvelope(t, p384SignerCert, p384SignerKey, notationEnvelopeOptions{digest: testDigest, algorithm: "ES256"}),
+			},
+			wantErr: "does not match the P-384 curve",
+		},
+		{
+			name: "signing time outside of the certificate validity",
+			envelopes: [][]byte{
+				newNotationEnvelope(t, signerCert, signerKey, notationEnvelopeOptions{digest: testDigest, signingTime: notationNow.Add(-2 * time.Hour).Format(time.RFC3339)}),
+			},
+			wantErr: "outside of the signing certificate validity",
+		},
+		{
+			name: "certificate not revoked",
+			envelopes: [][]byte{
+				newNotationEnvelope(t, goodOCSPCert, goodOCSPKey, notationEnvelopeOptions{digest: testDigest}),
+			},
+			want: []SelectorsFromSignatures{
+				{
+					Prefix:     notationSelectorPrefix,
+					Subject:    "CN=signer,O=acme-rockets.io,ST=WA,C=US",
+					TrustStore: "ca:acme-rockets",
+					Issuer:     "CN=acme-rockets CA",
+					Verified:   true,
+				},
+			},
+		},
+		{
+			name: "revoked certificate",
+			envelopes: [][]byte{
+				newNotationEnvelope(t, revokedCert, revokedKey, notationEnvelopeOptions{digest: testDigest}),
+			},
+			wantErr: "certificate revoked",
+		},
+		{
+			name: "unknown revocation status",
+			envelopes: [][]byte{
+				newNotationEnvelope(t, unknownOCSPCert, unknownOCSPKey, notationEnvelopeOptions{digest: testDigest}),
+			},
+			wantErr: "certificate status is unknown",
+		},
+		{
+			name: "signature signed by another key",
+			envelopes: [][]byte{
+				newNotationEnvelope(t, signerCert, otherSignerKey, notationEnvelopeOptions{digest: testDigest}),
+			},
+			wantErr: "Signature verification failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeRegistry(t, "some/image", testDigest, tt.referrersTag, tt.envelopes...)
+
+			verifier, err := NewImageVerifier("notation", &VerifierConfig{
+				Type:            NotationVerifierType,
+				ImagePatterns:   []string{"*"},
+				TrustPolicyPath: policyPath,
+				TrustStoreDir:   dir,
+			}, hclog.NewNullLogger())
+			if err != nil {
+				t.Fatal(err)
+			}
+			verifier.(*notationVerifier).now = func() time.Time { return notationNow }
+
+			ref, err := name.NewDigest(fmt.Sprintf("%s/some/image@%s", strings.TrimPrefix(server.URL, "http://"), testDigest))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := verifier.Verify(context.Background(), ref)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("notationVerifier.Verify() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("notationVerifier.Verify() unexpected error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("notationVerifier.Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_loadNotationTrustPolicy(t *testing.T) {
+	ca := newNotationCA(t, "acme-rockets CA")
+	dir := t.TempDir()
+	writeNotationTrustStore(t, dir, "ca", "acme-rockets", ca.cert)
+
+	tests := []struct {
+		name    string
+		policy  string
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "valid policy",
+			policy: `{
+				"version": "1.0",
+				"trustPolicies": [
+					{
+						"name": "scoped",
+						"registryScopes": ["registry.example.com/some/image"],
+						"trustStores": ["ca:acme-rockets"],
+						"trustedIdentities": ["x509.subject: C=US, ST=WA, O=acme-rockets.io"]
+					},
+					{
+						"name": "wildcard",
+						"registryScopes": ["*"],
+						"signatureVerification": {"level": "strict"},
+						"trustStores": ["ca:acme-rockets"],
+						"trustedIdentities": ["*"]
+					}
+				]
+			}`,
+			want: []string{"scoped", "wildcard"},
+		},
+		{
+			name:    "invalid json",
+			policy:  `{`,
+			wantErr: "Error parsing trust policy",
+		},
+		{
+			name:    "unsupported version",
+			policy:  `{"version": "2.0", "trustPolicies": []}`,
+			wantErr: "Unsupported trust policy version",
+		},
+		{
+			name:    "no policies",
+			policy:  `{"version": "1.0", "trustPolicies": []}`,
+			wantErr: "Trust policy has no policies",
+		},
+		{
+			name:    "no registry scopes",
+			policy:  `{"version": "1.0", "trustPolicies": [{"name": "p", "trustStores": ["ca:acme-rockets"], "trustedIdentities": ["*"]}]}`,
+			wantErr: "has no registry scopes",
+		},
+		{
+			name:    "unsupported verification level",
+			policy:  `{"version": "1.0", "trustPolicies": [{"name": "p", "registryScopes": ["*"], "signatureVerification": {"level": "audit"}, "trustStores": ["ca:acme-rockets"], "trustedIdentities": ["*"]}]}`,
+			wantErr: "unsupported verification level",
+		},
+		{
+			name:    "missing trust store",
+			policy:  `{"version": "1.0", "trustPolicies": [{"name": "p", "registryScopes": ["*"], "trustStores": ["ca:missing"], "trustedIdentities": ["*"]}]}`,
+			wantErr: "Unable to read trust store",
+		},
+		{
+			name:    "invalid trust store name",
+			policy:  `{"version": "1.0", "trustPolicies": [{"name": "p", "registryScopes": ["*"], "trustStores": ["acme-rockets"], "trustedIdentities": ["*"]}]}`,
+			wantErr: "Invalid trust store",
+		},
+		{
+			name:    "no trusted identities",
+			policy:  `{"version": "1.0", "trustPolicies": [{"name": "p", "registryScopes": ["*"], "trustStores": ["ca:acme-rockets"]}]}`,
+			wantErr: "has no trusted identities",
+		},
+		{
+			name:    "unsupported trusted identity",
+			policy:  `{"version": "1.0", "trustPolicies": [{"name": "p", "registryScopes": ["*"], "trustStores": ["ca:acme-rockets"], "trustedIdentities": ["x509.san: example.com"]}]}`,
+			wantErr: "Unsupported trusted identity",
+		},
+		{
+			name:    "trusted identity without required attributes",
+			policy:  `{"version": "1.0", "trustPolicies": [{"name": "p", "registryScopes": ["*"], "trustStores": ["ca:acme-rockets"], "trustedIdentities": ["x509.subject: C=US, O=acme-rockets.io"]}]}`,
+			wantErr: "must have the C, ST, O attributes",
+		},
+		{
+			name:    "duplicated trusted identity attribute",
+			policy:  `{"version": "1.0", "trustPolicies": [{"name": "p", "registryScopes": ["*"], "trustStores": ["ca:acme-rockets"], "trustedIdentities": ["x509.subject: C=US, ST=WA, O=acme-rockets.io, O=other.io"]}]}`,
+			wantErr: "Duplicated attribute",
+		},
+		{
+			name:    "invalid registry scope",
+			policy:  `{"version": "1.0", "trustPolicies": [{"name": "p", "registryScopes": ["Registry.example.com/Some/Image"], "trustStores": ["ca:acme-rockets"], "trustedIdentities": ["*"]}]}`,
+			wantErr: "invalid registry scope",
+		},
+		{
+			name:    "unsupported trusted identity attribute",
+			policy:  `{"version": "1.0", "trustPolicies": [{"name": "p", "registryScopes": ["*"], "trustStores": ["ca:acme-rockets"], "trustedIdentities": ["x509.subject: UID=1"]}]}`,
+			wantErr: "Unsupported attribute",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policyPath := writeNotationTrustPolicy(t, t.TempDir(), tt.policy)
+			got, err := loadNotationTrustPolicy(policyPath, dir)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("loadNotationTrustPolicy() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("loadNotationTrustPolicy() unexpected error = %v", err)
+				return
+			}
+			var names []string
+			for _, policy := range got {
+				names = append(names, policy.name)
+			}
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("loadNotationTrustPolicy() = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func Test_loadNotationTrustPolicyNormalizesScopes(t *testing.T) {
+	ca := newNotationCA(t, "acme-rockets CA")
+	dir := t.TempDir()
+	writeNotationTrustStore(t, dir, "ca", "acme-rockets", ca.cert)
+	policyPath := writeNotationTrustPolicy(t, dir, `{
+		"version": "1.0",
+		"trustPolicies": [
+			{
+				"name": "docker-hub",
+				"registryScopes": ["docker.io/library/nginx", "registry.example.com/some/image"],
+				"trustStores": ["ca:acme-rockets"],
+				"trustedIdentities": ["*"]
+			}
+		]
+	}`)
+
+	policies, err := loadNotationTrustPolicy(policyPath, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifier := &notationVerifier{policies: policies}
+	for _, repository := range []string{"index.docker.io/library/nginx", "registry.example.com/some/image"} {
+		if policy, err := verifier.policyForRepository(repository); err != nil || policy.name != "docker-hub" {
+			t.Errorf("policyForRepository(%q) = %v, %v, want docker-hub", repository, policy, err)
+		}
+	}
+}
+
+func TestNotationVerifier_policyForRepository(t *testing.T) {
+	verifier := &notationVerifier{
+		policies: []notationPolicy{
+			{name: "wildcard", scopes: []string{"*"}},
+			{name: "scoped", scopes: []string{"registry.example.com/some/image"}},
+		},
+	}
+
+	policy, err := verifier.policyForRepository("registry.example.com/some/image")
+	if err != nil || policy.name != "scoped" {
+		t.Errorf("policyForRepository() = %v, %v, want scoped", policy, err)
+	}
+	policy, err = verifier.policyForRepository("registry.example.com/other/image")
+	if err != nil || policy.name != "wildcard" {
+		t.Errorf("policyForRepository() = %v, %v, want wildcard", policy, err)
+	}
+
+	verifier.policies = verifier.policies[1:]
+	if _, err := verifier.policyForRepository("registry.example.com/other/image"); err == nil {
+		t.Errorf("policyForRepository() expected error for unscoped repository")
+	}
+}
@@ -0,0 +1,237 @@
+package sigstore
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	defaultRevocationListReloadInterval = time.Minute
+)
+
+// SignaturePolicyConfig restricts the signatures accepted for an image based
+// on the time they were integrated into the transparency log and on a list
+// of revoked signatures.
+type SignaturePolicyConfig struct {
+	// MaxSignatureAge rejects signatures integrated into the transparency
+	// log longer ago than this duration. Zero disables the check.
+	MaxSignatureAge time.Duration
+
+	// SignedAfter rejects signatures integrated into the transparency log
+	// before this time, e.g. to invalidate everything signed before a key
+	// compromise. The zero time disables the check.
+	SignedAfter time.Time
+
+	// RevocationListPath is the path to a JSON file listing the revoked
+	// signature subjects and transparency log entries. The file is reloaded
+	// every ReloadInterval.
+	RevocationListPath string
+
+	// ReloadInterval controls how often the revocation list is checked for
+	// changes. Defaults to one minute.
+	ReloadInterval time.Duration
+}
+
+// revocationList is the format of the revocation list file
+type revocationList struct {
+	// Subjects are the revoked signature subjects
+	Subjects []string `json:"subjects"`
+
+	// LogEntries are the revoked transparency log entries
+	LogEntries []revokedLogEntry `json:"log_entries"`
+}
+
+// revokedLogEntry identifies a transparency log entry. A log index is only
+// unique within a log, so the entry is identified by both.
+type revokedLogEntry struct {
+	LogID    string `json:"log_id"`
+	LogIndex int64  `json:"log_index"`
+}
+
+type revocations struct {
+	subjects   map[string]bool
+	logEntries map[revokedLogEntry]bool
+}
+
+// signaturePolicy enforces a SignaturePolicyConfig on the selectors of the
+// verified signatures.
+type signaturePolicy struct {
+	maxSignatureAge    time.Duration
+	signedAfter        time.Time
+	revocationListPath string
+	reloadInterval     time.Duration
+	now                func() time.Time
+	logger             hclog.Logger
+
+	mu          sync.Mutex
+	revocations revocations
+	lastReload  time.Time
+}
+
+func newSignaturePolicy(config *SignaturePolicyConfig, logger hclog.Logger) (*signaturePolicy, error) {
+	if config.MaxSignatureAge < 0 {
+		return nil, fmt.Errorf("Invalid max signature age %s", config.MaxSignatureAge)
+	}
+	reloadInterval := config.ReloadInterval
+	if reloadInterval <= 0 {
+		reloadInterval = defaultRevocationListReloadInterval
+	}
+
+	p := &signaturePolicy{
+		maxSignatureAge:    config.MaxSignatureAge,
+		signedAfter:        config.SignedAfter,
+		revocationListPath: config.RevocationListPath,
+		reloadInterval:     reloadInterval,
+		now:                time.Now,
+		logger:             logger,
+	}
+	if p.revocationListPath != "" {
+		// The revocation list must be valid on startup, later errors keep
+		// the previously loaded list.
+		if err := p.loadRevocationList(); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Filter returns the selectors of the signatures accepted by the policy
+func (p *signaturePolicy) Filter(selectors []SelectorsFromSignatures) []SelectorsFromSignatures {
+	revoked := p.currentRevocations()
+
+	var accepted []SelectorsFromSignatures
+	for _, selector := range selectors {
+		if err := p.check(selector, revoked); err != nil {
+			p.logger.Warn("Signature rejected by policy", "subject", selector.Subject, "logID", selector.LogID, "logIndex", selector.LogIndex, "reason", err.Error())
+			continue
+		}
+		accepted = append(accepted, selector)
+	}
+	return accepted
+}
+
+func (p *signaturePolicy) check(selector SelectorsFromSignatures, revoked revocations) error {
+	if revoked.subjects[selector.Subject] {
+		return fmt.Errorf("subject %q is revoked", selector.Subject)
+	}
+	if selector.LogID != "" && revoked.logEntries[revokedLogEntry{LogID: selector.LogID, LogIndex: selector.LogIndex}] {
+		return fmt.Errorf("log entry %d of log %s is revoked", selector.LogIndex, selector.LogID)
+	}
+
+	// The digest allow-list does not verify signatures, there is no
+	// signature time to check. The other signatures without an integrated
+	// time, i.e. that were not uploaded to a transparency log, are rejected
+	// by the time checks, since their signing time cannot be trusted.
+	if selector.Prefix == digestAllowListSelectorPrefix || (p.maxSignatureAge == 0 && p.signedAfter.IsZero()) {
+		return nil
+	}
+	if selector.IntegratedTime == "" {
+		return fmt.Errorf("signature has no transparency log integrated time")
+	}
+	seconds, err := strconv.ParseInt(selector.IntegratedTime, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid integrated time %q: %w", selector.IntegratedTime, err)
+	}
+	integratedTime := time.Unix(seconds, 0)
+	if !p.signedAfter.IsZero() && integratedTime.Before(p.signedAfter) {
+		return fmt.Errorf("signature integrated at %s, before %s", integratedTime.UTC().Format(time.RFC3339), p.signedAfter.UTC().Format(time.RFC3339))
+	}
+	if p.maxSignatureAge > 0 && p.now().Sub(integratedTime) > p.maxSignatureAge {
+		return fmt.Errorf("signature integrated at %s is older than %s", integratedTime.UTC().Format(time.RFC3339), p.maxSignatureAge)
+	}
+	return nil
+}
+
+// currentRevocations returns the revocation list, reloading it from disk
+// when the reload interval has elapsed. Only the caller that claims the
+// reload reads the file, without holding the lock, and the other callers
+// keep using the current list meanwhile.
+func (p *signaturePolicy) currentRevocations() revocations {
+	if p.revocationListPath == "" {
+		return revocations{}
+	}
+
+	if p.claimReload() {
+		revoked, err := p.readRevocationList()
+		if err != nil {
+			p.logger.Warn("Unable to reload revocation list, keeping the previous one", "path", p.revocationListPath, "err", err)
+		} else {
+			p.setRevocations(revoked)
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.revocations
+}
+
+// claimReload returns whether the reload interval has elapsed, in which case
+// the caller reloads the revocation list.
+func (p *signaturePolicy) claimReload() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	if now.Sub(p.lastReload) < p.reloadInterval {
+		return false
+	}
+	p.lastReload = now
+	return true
+}
+
+func (p *signaturePolicy) loadRevocationList() error {
+	p.mu.Lock()
+	p.lastReload = p.now()
+	p.mu.Unlock()
+
+	revoked, err := p.readRevocationList()
+	if err != nil {
+		return err
+	}
+	p.setRevocations(revoked)
+	return nil
+}
+
+func (p *signaturePolicy) setRevocations(revoked revocations) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.revocations = revoked
+}
+
+func (p *signaturePolicy) readRevocationList() (revocations, error) {
+	data, err := os.ReadFile(p.revocationListPath)
+	if err != nil {
+		return revocations{}, fmt.Errorf("Unable to read revocation list: %w", err)
+	}
+	// Unknown fields are rejected, so that a misspelled field does not
+	// silently leave signatures unrevoked
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	list := new(revocationList)
+	if err := decoder.Decode(list); err != nil {
+		return revocations{}, fmt.Errorf("Unable to parse revocation list: %w", err)
+	}
+
+	revoked := revocations{
+		subjects:   make(map[string]bool),
+		logEntries: make(map[revokedLogEntry]bool),
+	}
+	for _, subject := range list.Subjects {
+		revoked.subjects[subject] = true
+	}
+	for _, logEntry := range list.LogEntries {
+		if logEntry.LogID == "" {
+			return revocations{}, errors.New("Invalid revocation list: revoked log entry has no log ID")
+		}
+		revoked.logEntries[logEntry] = true
+	}
+	p.logger.Debug("Loaded revocation list", "path", p.revocationListPath, "subjects", len(list.Subjects), "logEntries", len(list.LogEntries))
+	return revoked, nil
+}
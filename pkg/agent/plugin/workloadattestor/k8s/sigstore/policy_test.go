@@ -0,0 +1,231 @@
+package sigstore
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func writeRevocationList(t *testing.T, path, content string) {
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSignaturePolicy_Filter(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	revocationListPath := filepath.Join(t.TempDir(), "revocations.json")
+	writeRevocationList(t, revocationListPath, `{"subjects": ["revoked@example.com"], "log_entries": [{"log_id": "samplelogID", "log_index": 42}]}`)
+
+	fresh := SelectorsFromSignatures{
+		Subject:        "spirex@example.com",
+		LogID:          "samplelogID",
+		LogIndex:       1,
+		IntegratedTime: "1699990000",
+	}
+	old := SelectorsFromSignatures{
+		Subject:        "spirex@example.com",
+		LogID:          "samplelogID",
+		LogIndex:       2,
+		IntegratedTime: "1600000000",
+	}
+	revokedSubject := SelectorsFromSignatures{
+		Subject:        "revoked@example.com",
+		LogID:          "samplelogID",
+		LogIndex:       3,
+		IntegratedTime: "1699990000",
+	}
+	revokedLogEntry := SelectorsFromSignatures{
+		Subject:        "spirex@example.com",
+		LogID:          "samplelogID",
+		LogIndex:       42,
+		IntegratedTime: "1699990000",
+	}
+	otherLogEntry := SelectorsFromSignatures{
+		Subject:        "spirex@example.com",
+		LogID:          "otherlogID",
+		LogIndex:       42,
+		IntegratedTime: "1699990000",
+	}
+	notLogged := SelectorsFromSignatures{
+		Prefix:   cosignKeySelectorPrefix,
+		Subject:  "cosign-key",
+		LogIndex: 42,
+	}
+	notation := SelectorsFromSignatures{
+		Prefix:  notationSelectorPrefix,
+		Subject: "CN=signer,O=example",
+	}
+	digestAllowed := SelectorsFromSignatures{
+		Prefix:  digestAllowListSelectorPrefix,
+		Subject: "sha256:0cfdaced91cb46dd7af48309799a3c351e4ca2d5e1ee9737ca0cbd932cb79898",
+	}
+
+	tests := []struct {
+		name      string
+		config    SignaturePolicyConfig
+		selectors []SelectorsFromSignatures
+		want      []SelectorsFromSignatures
+	}{
+		{
+			name:      "no policy",
+			selectors: []SelectorsFromSignatures{fresh, old, notLogged},
+			want:      []SelectorsFromSignatures{fresh, old, notLogged},
+		},
+		{
+			name:      "max signature age",
+			config:    SignaturePolicyConfig{MaxSignatureAge: 90 * 24 * time.Hour},
+			selectors: []SelectorsFromSignatures{fresh, old},
+			want:      []SelectorsFromSignatures{fresh},
+		},
+		{
+			name:      "signed after",
+			config:    SignaturePolicyConfig{SignedAfter: time.Unix(1650000000, 0)},
+			selectors: []SelectorsFromSignatures{old, fresh},
+			want:      []SelectorsFromSignatures{fresh},
+		},
+		{
+			name:      "max signature age rejects signatures without integrated time",
+			config:    SignaturePolicyConfig{MaxSignatureAge: time.Hour * 24},
+			selectors: []SelectorsFromSignatures{notLogged, notation, digestAllowed},
+			want:      []SelectorsFromSignatures{digestAllowed},
+		},
+		{
+			name:      "signed after rejects signatures without integrated time",
+			config:    SignaturePolicyConfig{SignedAfter: time.Unix(1650000000, 0)},
+			selectors: []SelectorsFromSignatures{notLogged, notation, fresh},
+			want:      []SelectorsFromSignatures{fresh},
+		},
+		{
+			name:      "revoked subjects and log entries",
+			config:    SignaturePolicyConfig{RevocationListPath: revocationListPath},
+			selectors: []SelectorsFromSignatures{fresh, revokedSubject, revokedLogEntry, otherLogEntry, notLogged},
+			want:      []SelectorsFromSignatures{fresh, otherLogEntry, notLogged},
+		},
+		{
+			name:      "all signatures rejected",
+			config:    SignaturePolicyConfig{SignedAfter: now},
+			selectors: []SelectorsFromSignatures{fresh, old},
+			want:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tt.config
+			p, err := newSignaturePolicy(&config, hclog.NewNullLogger())
+			if err != nil {
+				t.Fatalf("newSignaturePolicy() unexpected error = %v", err)
+			}
+			p.now = func() time.Time { return now }
+
+			if got := p.Filter(tt.selectors); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Filter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignaturePolicy_ReloadRevocationList(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	revocationListPath := filepath.Join(t.TempDir(), "revocations.json")
+	writeRevocationList(t, revocationListPath, `{"subjects": []}`)
+
+	p, err := newSignaturePolicy(&SignaturePolicyConfig{
+		RevocationListPath: revocationListPath,
+		ReloadInterval:     time.Minute,
+	}, hclog.NewNullLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.now = func() time.Time { return now }
+	p.lastReload = now
+
+	selectors := []SelectorsFromSignatures{{Subject: "spirex@example.com"}}
+	assertAccepted := func(want int) {
+		t.Helper()
+		if got := p.Filter(selectors); len(got) != want {
+			t.Errorf("Filter() accepted %d signatures, want %d", len(got), want)
+		}
+	}
+
+	// The list is not reloaded before the reload interval elapses
+	writeRevocationList(t, revocationListPath, `{"subjects": ["spirex@example.com"]}`)
+	assertAccepted(1)
+
+	now = now.Add(time.Minute)
+	assertAccepted(0)
+
+	// An invalid list keeps the previously loaded one
+	writeRevocationList(t, revocationListPath, `{"subjects": [`)
+	now = now.Add(time.Minute)
+	assertAccepted(0)
+
+	writeRevocationList(t, revocationListPath, `{"subjects": []}`)
+	now = now.Add(time.Minute)
+	assertAccepted(1)
+}
+
+func Test_newSignaturePolicy(t *testing.T) {
+	dir := t.TempDir()
+	invalidPath := filepath.Join(dir, "invalid.json")
+	writeRevocationList(t, invalidPath, `{`)
+	unknownFieldPath := filepath.Join(dir, "unknown-field.json")
+	writeRevocationList(t, unknownFieldPath, `{"log_indexes": [42]}`)
+	missingLogIDPath := filepath.Join(dir, "missing-log-id.json")
+	writeRevocationList(t, missingLogIDPath, `{"log_entries": [{"log_index": 42}]}`)
+
+	tests := []struct {
+		name    string
+		config  *SignaturePolicyConfig
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			config: &SignaturePolicyConfig{MaxSignatureAge: time.Hour},
+		},
+		{
+			name:    "negative max signature age",
+			config:  &SignaturePolicyConfig{MaxSignatureAge: -time.Hour},
+			wantErr: "Invalid max signature age",
+		},
+		{
+			name:    "missing revocation list",
+			config:  &SignaturePolicyConfig{RevocationListPath: filepath.Join(dir, "missing.json")},
+			wantErr: "Unable to read revocation list",
+		},
+		{
+			name:    "invalid revocation list",
+			config:  &SignaturePolicyConfig{RevocationListPath: invalidPath},
+			wantErr: "Unable to parse revocation list",
+		},
+		{
+			name:    "revocation list with unknown field",
+			config:  &SignaturePolicyConfig{RevocationListPath: unknownFieldPath},
+			wantErr: "Unable to parse revocation list",
+		},
+		{
+			name:    "revocation list entry without log ID",
+			config:  &SignaturePolicyConfig{RevocationListPath: missingLogIDPath},
+			wantErr: "revoked log entry has no log ID",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newSignaturePolicy(tt.config, hclog.NewNullLogger())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("newSignaturePolicy() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("newSignaturePolicy() unexpected error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,310 @@
+package sigstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/hashicorp/go-hclog"
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultRemoteMaxWait    = 10 * time.Second
+	defaultRemoteBackoff    = 30 * time.Second
+	defaultRemoteMaxBackoff = 10 * time.Minute
+)
+
+var (
+	// ErrRemoteUnavailable is wrapped by the errors of the verifications
+	// that did not reach the registries or the rekor log because of the
+	// configured limits.
+	ErrRemoteUnavailable = errors.New("Remote services are unavailable")
+
+	errCircuitOpen = fmt.Errorf("%w: circuit breaker is open", ErrRemoteUnavailable)
+)
+
+// RemoteLimitsConfig limits the verifications that reach out to the
+// registries and the rekor log, so that a burst of new workloads does not get
+// the agent rate limited by the public instances.
+type RemoteLimitsConfig struct {
+	// MaxConcurrent bounds the verifications in flight. Zero means no limit.
+	MaxConcurrent int
+
+	// RateLimit is the number of verifications started per second, with
+	// bursts of up to RateBurst. Zero means no limit.
+	RateLimit float64
+	RateBurst int
+
+	// MaxWait bounds how long a verification waits for a concurrency slot
+	// or a rate limit token. Defaults to 10s.
+	MaxWait time.Duration
+
+	// FailureThreshold is the number of consecutive failures reaching the
+	// remote services that opens the circuit. Zero disables the breaker.
+	FailureThreshold int
+
+	// Backoff is how long the circuit stays open the first time. It doubles
+	// every time the probe verification fails, up to MaxBackoff. Defaults
+	// to 30s and 10m.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+
+	// CacheTTL is how long verification results are served from the cache
+	// before the image is verified again. Zero keeps them until evicted.
+	CacheTTL time.Duration
+
+	// ServeStale serves expired cached results when the remote services
+	// cannot be reached, instead of failing the verification.
+	ServeStale bool
+}
+
+// remoteLimits enforces the remote limits configuration. A nil remoteLimits
+// does not limit anything.
+type remoteLimits struct {
+	slots      chan struct{}
+	limiter    *rate.Limiter
+	breaker    *circuitBreaker
+	maxWait    time.Duration
+	cacheTTL   time.Duration
+	serveStale bool
+	now        func() time.Time
+}
+
+func newRemoteLimits(config *RemoteLimitsConfig, logger hclog.Logger) (*remoteLimits, error) {
+	switch {
+	case config.MaxConcurrent < 0:
+		return nil, errors.New("Maximum concurrent verifications cannot be negative")
+	case config.RateLimit < 0 || config.RateBurst < 0:
+		return nil, errors.New("Verification rate limit cannot be negative")
+	case config.FailureThreshold < 0:
+		return nil, errors.New("Failure threshold cannot be negative")
+	case config.MaxWait < 0 || config.Backoff < 0 || config.MaxBackoff < 0 || config.CacheTTL < 0:
+		return nil, errors.New("Remote limits durations cannot be negative")
+	case config.ServeStale && config.CacheTTL == 0:
+		return nil, errors.New("Serving stale results requires a cache TTL")
+	}
+
+	limits := &remoteLimits{
+		maxWait:    config.MaxWait,
+		cacheTTL:   config.CacheTTL,
+		serveStale: config.ServeStale,
+		now:        time.Now,
+	}
+	if limits.maxWait == 0 {
+		limits.maxWait = defaultRemoteMaxWait
+	}
+	if config.MaxConcurrent > 0 {
+		limits.slots = make(chan struct{}, config.MaxConcurrent)
+	}
+	if config.RateLimit > 0 {
+		burst := config.RateBurst
+		if burst == 0 {
+			burst = int(math.Ceil(config.RateLimit))
+		}
+		limits.limiter = rate.NewLimiter(rate.Limit(config.RateLimit), burst)
+	}
+	if config.FailureThreshold > 0 {
+		backoff := config.Backoff
+		if backoff == 0 {
+			backoff = defaultRemoteBackoff
+		}
+		maxBackoff := config.MaxBackoff
+		if maxBackoff == 0 {
+			maxBackoff = defaultRemoteMaxBackoff
+		}
+		if maxBackoff < backoff {
+			return nil, errors.New("Maximum backoff cannot be less than the backoff")
+		}
+		limits.breaker = &circuitBreaker{
+			threshold:   config.FailureThreshold,
+			backoff:     backoff,
+			maxBackoff:  maxBackoff,
+			nextBackoff: backoff,
+			now:         limits.nowFunc,
+			logger:      logger,
+		}
+	}
+	return limits, nil
+}
+
+func (l *remoteLimits) nowFunc() time.Time {
+	return l.now()
+}
+
+// Do runs the verification once allowed by the circuit breaker, the
+// concurrency limit and the rate limit.
+func (l *remoteLimits) Do(verify func() error) error {
+	if l == nil {
+		return verify()
+	}
+	if err := l.breaker.allow(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), l.maxWait)
+	defer cancel()
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			defer func() { <-l.slots }()
+		case <-ctx.Done():
+			l.breaker.cancel()
+			return fmt.Errorf("%w: too many concurrent verifications", ErrRemoteUnavailable)
+		}
+	}
+	if l.limiter != nil {
+		if err := l.limiter.Wait(ctx); err != nil {
+			l.breaker.cancel()
+			return fmt.Errorf("%w: verification rate limit exceeded", ErrRemoteUnavailable)
+		}
+	}
+
+	err := verify()
+	l.breaker.record(isRemoteUnavailable(err))
+	return err
+}
+
+// expired returns whether the cached item must be verified again
+func (l *remoteLimits) expired(item *Item) bool {
+	if l == nil || l.cacheTTL == 0 || item.Result == nil {
+		return false
+	}
+	return l.now().Sub(item.Result.VerifiedAt) >= l.cacheTTL
+}
+
+// canServeStale returns whether an expired cached result can be served instead
+// of the verification error.
+func (l *remoteLimits) canServeStale(err error) bool {
+	return l != nil && l.serveStale && isRemoteUnavailable(err)
+}
+
+// isRemoteUnavailable returns whether the error is due to the registries or
+// the rekor log being unreachable, overloaded or rate limiting, rather than
+// to the verification itself.
+func isRemoteUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrRemoteUnavailable) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	var transportErr *transport.Error
+	if errors.As(err, &transportErr) {
+		return isUnavailableStatusCode(transportErr.StatusCode)
+	}
+	// Errors of the generated rekor client carry the response status code
+	var codeErr interface{ Code() int }
+	if errors.As(err, &codeErr) {
+		return isUnavailableStatusCode(codeErr.Code())
+	}
+	return false
+}
+
+func isUnavailableStatusCode(code int) bool {
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+// circuitBreaker stops the verifications after consecutive failures reaching
+// the remote services, and lets a single probe verification through once the
+// backoff elapses. A nil circuitBreaker is always closed.
+type circuitBreaker struct {
+	threshold  int
+	backoff    time.Duration
+	maxBackoff time.Duration
+	now        func() time.Time
+	logger     hclog.Logger
+
+	mu          sync.Mutex
+	failures    int
+	openUntil   time.Time
+	nextBackoff time.Duration
+	probing     bool
+}
+
+func (b *circuitBreaker) allow() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch {
+	case b.openUntil.IsZero():
+		return nil
+	case b.now().Before(b.openUntil), b.probing:
+		return errCircuitOpen
+	default:
+		b.probing = true
+		return nil
+	}
+}
+
+// cancel releases the probe of a verification that did not run
+func (b *circuitBreaker) cancel() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+func (b *circuitBreaker) record(unavailable bool) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	probe := b.probing
+	b.probing = false
+	if !unavailable {
+		if !b.openUntil.IsZero() {
+			b.logger.Info("Remote services are available again, closing the circuit breaker")
+		}
+		b.failures = 0
+		b.openUntil = time.Time{}
+		b.nextBackoff = b.backoff
+		return
+	}
+
+	b.failures++
+	if probe || (b.openUntil.IsZero() && b.failures >= b.threshold) {
+		b.openUntil = b.now().Add(b.nextBackoff)
+		b.logger.Warn("Remote services are unavailable, opening the circuit breaker", "failures", b.failures, "backoff", b.nextBackoff)
+		b.nextBackoff *= 2
+		if b.nextBackoff > b.maxBackoff {
+			b.nextBackoff = b.maxBackoff
+		}
+	}
+}
+
+// SetRemoteLimits sets the limits of the verifications reaching out to the
+// registries and the rekor log. A nil config disables them.
+func (sigstore *Sigstoreimpl) SetRemoteLimits(config *RemoteLimitsConfig) error {
+	if config == nil {
+		sigstore.remoteLimits = nil
+		return nil
+	}
+	logger := sigstore.logger
+	if logger == nil {
+		logger = hclog.NewNullLogger()
+	}
+	remoteLimits, err := newRemoteLimits(config, logger)
+	if err != nil {
+		return err
+	}
+	sigstore.remoteLimits = remoteLimits
+	return nil
+}
@@ -0,0 +1,306 @@
+package sigstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/hashicorp/go-hclog"
+	"github.com/sigstore/cosign/pkg/cosign"
+	"github.com/sigstore/cosign/pkg/oci"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type rekorCodeError struct {
+	code int
+}
+
+func (e *rekorCodeError) Error() string {
+	return fmt.Sprintf("rekor error %d", e.code)
+}
+
+func (e *rekorCodeError) Code() int {
+	return e.code
+}
+
+func TestNewRemoteLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  RemoteLimitsConfig
+		wantErr string
+	}{
+		{
+			name: "all limits",
+			config: RemoteLimitsConfig{
+				MaxConcurrent:    4,
+				RateLimit:        2.5,
+				FailureThreshold: 3,
+				CacheTTL:         time.Hour,
+				ServeStale:       true,
+			},
+		},
+		{
+			name:    "negative concurrency",
+			config:  RemoteLimitsConfig{MaxConcurrent: -1},
+			wantErr: "Maximum concurrent verifications cannot be negative",
+		},
+		{
+			name:    "negative rate",
+			config:  RemoteLimitsConfig{RateLimit: -1},
+			wantErr: "Verification rate limit cannot be negative",
+		},
+		{
+			name:    "negative duration",
+			config:  RemoteLimitsConfig{CacheTTL: -time.Second},
+			wantErr: "Remote limits durations cannot be negative",
+		},
+		{
+			name:    "stale without TTL",
+			config:  RemoteLimitsConfig{ServeStale: true},
+			wantErr: "Serving stale results requires a cache TTL",
+		},
+		{
+			name:    "maximum backoff less than backoff",
+			config:  RemoteLimitsConfig{FailureThreshold: 1, Backoff: time.Minute, MaxBackoff: time.Second},
+			wantErr: "Maximum backoff cannot be less than the backoff",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limits, err := newRemoteLimits(&tt.config, hclog.NewNullLogger())
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("newRemoteLimits() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if limits.limiter.Burst() != 3 {
+				t.Errorf("newRemoteLimits() burst = %d, want 3", limits.limiter.Burst())
+			}
+			if limits.maxWait != defaultRemoteMaxWait || limits.breaker.backoff != defaultRemoteBackoff || limits.breaker.maxBackoff != defaultRemoteMaxBackoff {
+				t.Errorf("newRemoteLimits() did not apply the defaults")
+			}
+		})
+	}
+}
+
+func TestRemoteLimits_CircuitBreaker(t *testing.T) {
+	now := time.Unix(1000, 0)
+	limits, err := newRemoteLimits(&RemoteLimitsConfig{
+		FailureThreshold: 2,
+		Backoff:          time.Minute,
+		MaxBackoff:       3 * time.Minute,
+	}, hclog.NewNullLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	limits.now = func() time.Time { return now }
+
+	outage := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+	calls := 0
+	fail := func() error {
+		calls++
+		return outage
+	}
+	succeed := func() error {
+		calls++
+		return nil
+	}
+	rejected := func() error {
+		calls++
+		return errors.New("no matching signatures")
+	}
+
+	// Verification failures do not open the circuit
+	for i := 0; i < 3; i++ {
+		_ = limits.Do(rejected)
+	}
+	_ = limits.Do(fail)
+	if err := limits.Do(succeed); err != nil {
+		t.Fatalf("Do() error = %v, want the circuit closed", err)
+	}
+
+	_ = limits.Do(fail)
+	_ = limits.Do(fail)
+	calls = 0
+	if err := limits.Do(succeed); !errors.Is(err, ErrRemoteUnavailable) {
+		t.Fatalf("Do() error = %v, want the circuit open", err)
+	}
+	if calls != 0 {
+		t.Fatalf("Do() ran the verification with the circuit open")
+	}
+
+	// The failed probe doubles the backoff
+	now = now.Add(time.Minute)
+	if err := limits.Do(fail); !errors.Is(err, outage) {
+		t.Fatalf("Do() error = %v, want the probe to run", err)
+	}
+	now = now.Add(time.Minute)
+	if err := limits.Do(succeed); !errors.Is(err, ErrRemoteUnavailable) {
+		t.Fatalf("Do() error = %v, want the circuit open", err)
+	}
+
+	// The successful probe closes the circuit
+	now = now.Add(time.Minute)
+	if err := limits.Do(succeed); err != nil {
+		t.Fatalf("Do() error = %v, want the probe to run", err)
+	}
+	if err := limits.Do(succeed); err != nil {
+		t.Fatalf("Do() error = %v, want the circuit closed", err)
+	}
+	if limits.breaker.nextBackoff != time.Minute {
+		t.Errorf("Do() backoff = %v, want it reset", limits.breaker.nextBackoff)
+	}
+}
+
+func TestRemoteLimits_Concurrency(t *testing.T) {
+	limits, err := newRemoteLimits(&RemoteLimitsConfig{
+		MaxConcurrent: 1,
+		MaxWait:       10 * time.Millisecond,
+	}, hclog.NewNullLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	running := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- limits.Do(func() error {
+			close(running)
+			<-release
+			return nil
+		})
+	}()
+	<-running
+
+	if err := limits.Do(func() error { return nil }); !errors.Is(err, ErrRemoteUnavailable) {
+		t.Errorf("Do() error = %v, want the concurrency limit exceeded", err)
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if err := limits.Do(func() error { return nil }); err != nil {
+		t.Errorf("Do() error = %v, want the slot released", err)
+	}
+}
+
+func TestRemoteLimits_RateLimit(t *testing.T) {
+	limits, err := newRemoteLimits(&RemoteLimitsConfig{
+		RateLimit: 0.1,
+		RateBurst: 2,
+		MaxWait:   10 * time.Millisecond,
+	}, hclog.NewNullLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := limits.Do(func() error { return nil }); err != nil {
+			t.Fatalf("Do() error = %v, want the burst allowed", err)
+		}
+	}
+	if err := limits.Do(func() error { return nil }); !errors.Is(err, ErrRemoteUnavailable) {
+		t.Errorf("Do() error = %v, want the rate limit exceeded", err)
+	}
+}
+
+func Test_isRemoteUnavailable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "no error"},
+		{name: "verification error", err: errors.New("no matching signatures")},
+		{name: "limits", err: errCircuitOpen, want: true},
+		{name: "network", err: fmt.Errorf("Error verifying signature: %w", &net.OpError{Op: "dial", Err: errors.New("connection refused")}), want: true},
+		{name: "deadline", err: fmt.Errorf("fetching: %w", context.DeadlineExceeded), want: true},
+		{name: "registry rate limit", err: &transport.Error{StatusCode: http.StatusTooManyRequests}, want: true},
+		{name: "registry unavailable", err: &transport.Error{StatusCode: http.StatusServiceUnavailable}, want: true},
+		{name: "registry not found", err: &transport.Error{StatusCode: http.StatusNotFound}},
+		{name: "rekor rate limit", err: &rekorCodeError{code: http.StatusTooManyRequests}, want: true},
+		{name: "rekor bad request", err: &rekorCodeError{code: http.StatusBadRequest}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRemoteUnavailable(tt.err); got != tt.want {
+				t.Errorf("isRemoteUnavailable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSigstoreimpl_ServeStale(t *testing.T) {
+	for _, serveStale := range []bool{false, true} {
+		t.Run(fmt.Sprintf("serve stale %v", serveStale), func(t *testing.T) {
+			outage := false
+			sigstore := &Sigstoreimpl{
+				verifyFunction: func(ctx context.Context, ref name.Reference, co *cosign.CheckOpts) ([]oci.Signature, bool, error) {
+					if outage {
+						return nil, false, &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+					}
+					return []oci.Signature{
+						signature{
+							payload: []byte(`{"critical": {"identity": {"docker-reference": "docker-registry.com/some/image"},"image": {"docker-manifest-digest": "` + testDigest + `"},"type": "some type"},"optional": {"subject": "spirex@example.com"}}`),
+						},
+					}, true, nil
+				},
+				fetchImageManifestFunction: func(ref name.Reference, options ...remote.Option) (*remote.Descriptor, error) {
+					return &remote.Descriptor{
+						Manifest: []byte("sometext"),
+					}, nil
+				},
+				sigstorecache: NewCache(maximumAmountCache),
+				logger:        hclog.NewNullLogger(),
+			}
+			if err := sigstore.SetRemoteLimits(&RemoteLimitsConfig{
+				CacheTTL:   time.Minute,
+				ServeStale: serveStale,
+			}); err != nil {
+				t.Fatal(err)
+			}
+			now := time.Now()
+			sigstore.remoteLimits.now = func() time.Time { return now }
+
+			status := &corev1.ContainerStatus{
+				ImageID:     "docker-registry.com/some/image@" + testDigest,
+				ContainerID: "000000",
+			}
+			want, err := sigstore.AttestContainerSignatures(status)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Fresh results are served from the cache during an outage
+			outage = true
+			got, err := sigstore.AttestContainerSignatures(status)
+			if err != nil || !reflect.DeepEqual(got, want) {
+				t.Fatalf("Sigstoreimpl.AttestContainerSignatures() = %v, %v, want the cached result", got, err)
+			}
+
+			// Expired results are only served if allowed
+			now = now.Add(2 * time.Minute)
+			got, err = sigstore.AttestContainerSignatures(status)
+			if serveStale {
+				if err != nil || !reflect.DeepEqual(got, want) {
+					t.Errorf("Sigstoreimpl.AttestContainerSignatures() = %v, %v, want the stale result", got, err)
+				}
+			} else if err == nil {
+				t.Errorf("Sigstoreimpl.AttestContainerSignatures() expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package sigstore
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sigstore/cosign/pkg/oci"
+)
+
+const (
+	// failedVerificationsCacheSize bounds the failed verification results
+	// kept for inspection
+	failedVerificationsCacheSize = 100
+)
+
+var (
+	// fulcioIssuerOID is the certificate extension where Fulcio records the
+	// OIDC issuer of the signer identity
+	fulcioIssuerOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+
+	// publishedVerifiers are the verifiers in use by the plugins running in
+	// the process, by plugin name
+	publishedVerifiers   = make(map[string]Verifier)
+	publishedVerifiersMu sync.RWMutex
+)
+
+// VerificationResult records the outcome of the signature verification of an
+// image, so that operators can inspect why an image got its selectors.
+type VerificationResult struct {
+	// ImageID is the digest reference of the verified image
+	ImageID string
+
+	// Verifier is the name of the configured verifier that matched the
+	// image, empty for the default cosign keyless verification.
+	Verifier string
+
+	// VerifiedAt is when the verification took place
+	VerifiedAt time.Time
+
+	// Error is the reason the verification failed, if it did
+	Error string
+
+	// Signatures are the results of the individual signatures
+	Signatures []SignatureResult
+}
+
+// SignatureResult records the verification of a single signature
+type SignatureResult struct {
+	VerifierType   string
+	Subject        string
+	Issuer         string
+	KeyID          string
+	RekorUUID      string
+	LogIndex       int64
+	IntegratedTime int64
+
+	// FailureReason is the reason the signature was rejected, if it was
+	FailureReason string
+}
+
+// VerificationResults returns the cached verification results, most recently
+// used first, followed by the failed verifications of images that have no
+// cached result.
+func (sigstore *Sigstoreimpl) VerificationResults() []VerificationResult {
+	var results []VerificationResult
+	cached := make(map[string]bool)
+	if sigstore.sigstorecache != nil {
+		for _, item := range sigstore.sigstorecache.ListSignatures() {
+			if item.Result != nil {
+				results = append(results, *item.Result)
+				cached[item.Key] = true
+			}
+		}
+	}
+	for _, item := range sigstore.getFailedVerifications().ListSignatures() {
+		if item.Result != nil && !cached[item.Key] {
+			results = append(results, *item.Result)
+		}
+	}
+	return results
+}
+
+// Publish makes the verification results of the verifier in use by the plugin
+// available through PublishedVerificationResults, replacing the verifier the
+// plugin published before. A nil verifier withdraws it.
+func Publish(pluginName string, verifier Verifier) {
+	publishedVerifiersMu.Lock()
+	defer publishedVerifiersMu.Unlock()
+
+	if verifier == nil {
+		delete(publishedVerifiers, pluginName)
+		return
+	}
+	publishedVerifiers[pluginName] = verifier
+}
+
+// PublishedVerificationResults returns the verification results of the
+// published verifiers, ordered by plugin name. Only the plugins running in
+// the agent process, i.e. the built-in ones, can publish their verifiers.
+func PublishedVerificationResults() []VerificationResult {
+	publishedVerifiersMu.RLock()
+	defer publishedVerifiersMu.RUnlock()
+
+	pluginNames := make([]string, 0, len(publishedVerifiers))
+	for pluginName := range publishedVerifiers {
+		pluginNames = append(pluginNames, pluginName)
+	}
+	sort.Strings(pluginNames)
+
+	var results []VerificationResult
+	for _, pluginName := range pluginNames {
+		results = append(results, publishedVerifiers[pluginName].VerificationResults()...)
+	}
+	return results
+}
+
+func (sigstore *Sigstoreimpl) getFailedVerifications() Cache {
+	sigstore.failedVerificationsOnce.Do(func() {
+		sigstore.failedVerifications = NewCache(failedVerificationsCacheSize)
+	})
+	return sigstore.failedVerifications
+}
+
+func newVerificationResult(imageID string, pattern *imagePattern, selectors []SelectorsFromSignatures, rejected []SignatureResult, verifiedAt time.Time) *VerificationResult {
+	result := &VerificationResult{
+		ImageID:    imageID,
+		VerifiedAt: verifiedAt,
+	}
+	if pattern != nil {
+		result.Verifier = pattern.name
+	}
+	for _, selector := range selectors {
+		result.Signatures = append(result.Signatures, newSignatureResult(selector))
+	}
+	result.Signatures = append(result.Signatures, rejected...)
+	return result
+}
+
+func newSignatureResult(selectors SelectorsFromSignatures) SignatureResult {
+	integratedTime, _ := strconv.ParseInt(selectors.IntegratedTime, 10, 64)
+	return SignatureResult{
+		VerifierType:   verifierTypeOfPrefix(selectors.Prefix),
+		Subject:        selectors.Subject,
+		Issuer:         selectors.Issuer,
+		KeyID:          selectors.KeyID,
+		RekorUUID:      selectors.RekorUUID,
+		LogIndex:       selectors.LogIndex,
+		IntegratedTime: integratedTime,
+	}
+}
+
+func verifierTypeOfPrefix(prefix string) string {
+	switch prefix {
+	case cosignKeySelectorPrefix:
+		return CosignKeyVerifierType
+	case digestAllowListSelectorPrefix:
+		return DigestAllowListVerifierType
+	case notationSelectorPrefix:
+		return NotationVerifierType
+	default:
+		return CosignKeylessVerifierType
+	}
+}
+
+// certIssuer returns the OIDC issuer of a Fulcio certificate
+func certIssuer(cert *x509.Certificate) string {
+	if cert == nil {
+		return ""
+	}
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(fulcioIssuerOID) {
+			return string(ext.Value)
+		}
+	}
+	return ""
+}
+
+// certKeyID returns the hex encoded subject key ID of the certificate
+func certKeyID(cert *x509.Certificate) string {
+	if cert == nil {
+		return ""
+	}
+	return hex.EncodeToString(cert.SubjectKeyId)
+}
+
+// rekorEntryUUID returns the UUID of the rekor entry of the bundle, which is
+// the hex encoded Merkle tree leaf hash of the entry body.
+func rekorEntryUUID(bundle *oci.Bundle) string {
+	body64, ok := bundle.Payload.Body.(string)
+	if !ok {
+		return ""
+	}
+	body, err := base64.StdEncoding.DecodeString(body64)
+	if err != nil {
+		return ""
+	}
+	leaf := sha256.Sum256(append([]byte{0}, body...))
+	return hex.EncodeToString(leaf[:])
+}
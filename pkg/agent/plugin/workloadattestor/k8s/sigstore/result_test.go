@@ -0,0 +1,163 @@
+package sigstore
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/hashicorp/go-hclog"
+	"github.com/sigstore/cosign/pkg/cosign"
+	"github.com/sigstore/cosign/pkg/oci"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestSigstoreimpl_VerificationResults(t *testing.T) {
+	imageID := "docker-registry.com/some/image@" + testDigest
+	failingImageID := "docker-registry.com/other/image@" + testDigest
+
+	sigstore := New(NewCache(maximumAmountCache), hclog.NewNullLogger()).(*Sigstoreimpl)
+	sigstore.fetchImageManifestFunction = func(ref name.Reference, options ...remote.Option) (*remote.Descriptor, error) {
+		return &remote.Descriptor{
+			Manifest: []byte("sometext"),
+		}, nil
+	}
+	sigstore.verifyFunction = func(ctx context.Context, ref name.Reference, co *cosign.CheckOpts) ([]oci.Signature, bool, error) {
+		if ref.Context().Name() != "docker-registry.com/some/image" {
+			return nil, false, errors.New("no matching signatures")
+		}
+		return []oci.Signature{
+			signature{
+				payload: []byte(`{"critical": {"identity": {"docker-reference": "docker-registry.com/some/image"},"image": {"docker-manifest-digest": "` + testDigest + `"},"type": "some type"},"optional": {"subject": "spirex@example.com"}}`),
+				bundle: &oci.Bundle{
+					Payload: oci.BundlePayload{
+						Body:           "ewogICJzcGVjIjogewogICAgInNpZ25hdHVyZSI6IHsKICAgICAgImNvbnRlbnQiOiAiTUVVQ0lRQ3llbThHY3Iwc1BGTVA3ZlRYYXpDTjU3TmNONStNanhKdzlPbzB4MmVNK0FJZ2RnQlA5NkJPMVRlL05kYmpIYlVlYjBCVXllNmRlUmdWdFFFdjVObzVzbUE9IgogICAgfQogIH0KfQ==",
+						LogID:          "samplelogID",
+						LogIndex:       42,
+						IntegratedTime: 12345,
+					},
+				},
+			},
+			signature{
+				payload: []byte(`{"critical": {"identity": {"docker-reference": "docker-registry.com/some/image"},"image": {"docker-manifest-digest": "` + testDigest + `"},"type": "some type"},"optional": {"subject": "someone@example.com"}}`),
+			},
+		}, true, nil
+	}
+	sigstore.EnableAllowSubjectList(true)
+	sigstore.AddAllowedSubject("spirex@example.com")
+
+	if _, err := sigstore.AttestContainerSignatures(&corev1.ContainerStatus{ImageID: failingImageID, ContainerID: "000000"}); err == nil {
+		t.Fatal("Sigstoreimpl.AttestContainerSignatures() expected an error")
+	}
+	if _, err := sigstore.AttestContainerSignatures(&corev1.ContainerStatus{ImageID: imageID, ContainerID: "000000"}); err != nil {
+		t.Fatal(err)
+	}
+
+	results := sigstore.VerificationResults()
+	if len(results) != 2 {
+		t.Fatalf("Sigstoreimpl.VerificationResults() returned %d results, want 2", len(results))
+	}
+	for i := range results {
+		if results[i].VerifiedAt.IsZero() {
+			t.Errorf("Sigstoreimpl.VerificationResults() result of %s has no verification time", results[i].ImageID)
+		}
+		results[i].VerifiedAt = time.Time{}
+	}
+
+	want := []VerificationResult{
+		{
+			ImageID: imageID,
+			Signatures: []SignatureResult{
+				{
+					VerifierType:   CosignKeylessVerifierType,
+					Subject:        "spirex@example.com",
+					RekorUUID:      "eb48155e4b08e1d0c69fdf91bb803b735e0c38c64bdcd1c08ea1b9774ec7b44d",
+					LogIndex:       42,
+					IntegratedTime: 12345,
+				},
+				{
+					VerifierType:  CosignKeylessVerifierType,
+					Subject:       "someone@example.com",
+					FailureReason: "Signature subject is not allowed",
+				},
+			},
+		},
+		{
+			ImageID: failingImageID,
+			Error:   "Error verifying signature: no matching signatures",
+		},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("Sigstoreimpl.VerificationResults() = %+v, want %+v", results, want)
+	}
+}
+
+func TestSigstoreimpl_VerificationResultsWithVerifier(t *testing.T) {
+	sigstore := New(NewCache(maximumAmountCache), hclog.NewNullLogger()).(*Sigstoreimpl)
+	if err := sigstore.SetVerifiers(map[string]*VerifierConfig{
+		"allowed": {
+			Type:          DigestAllowListVerifierType,
+			ImagePatterns: []string{"docker-registry.com/some/*"},
+			Digests:       []string{testDigest},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	imageID := "docker-registry.com/some/image@" + testDigest
+	if _, err := sigstore.AttestContainerSignatures(&corev1.ContainerStatus{ImageID: imageID, ContainerID: "000000"}); err != nil {
+		t.Fatal(err)
+	}
+
+	results := sigstore.VerificationResults()
+	if len(results) != 1 {
+		t.Fatalf("Sigstoreimpl.VerificationResults() returned %d results, want 1", len(results))
+	}
+	want := VerificationResult{
+		ImageID:    imageID,
+		Verifier:   "allowed",
+		VerifiedAt: results[0].VerifiedAt,
+		Signatures: []SignatureResult{
+			{
+				VerifierType: DigestAllowListVerifierType,
+				Subject:      "allowed",
+			},
+		},
+	}
+	if !reflect.DeepEqual(results[0], want) {
+		t.Errorf("Sigstoreimpl.VerificationResults() = %+v, want %+v", results[0], want)
+	}
+}
+
+func TestPublishedVerificationResults(t *testing.T) {
+	first := New(NewCache(maximumAmountCache), hclog.NewNullLogger()).(*Sigstoreimpl)
+	second := New(NewCache(maximumAmountCache), hclog.NewNullLogger()).(*Sigstoreimpl)
+	first.sigstorecache.PutSignature(Item{Key: "first", Result: &VerificationResult{ImageID: "first"}})
+	second.sigstorecache.PutSignature(Item{Key: "second", Result: &VerificationResult{ImageID: "second"}})
+
+	Publish("b", second)
+	Publish("a", first)
+	defer Publish("a", nil)
+	defer Publish("b", nil)
+
+	want := []VerificationResult{{ImageID: "first"}, {ImageID: "second"}}
+	if results := PublishedVerificationResults(); !reflect.DeepEqual(results, want) {
+		t.Errorf("PublishedVerificationResults() = %+v, want %+v", results, want)
+	}
+
+	// Publishing again replaces the verifier of the plugin
+	Publish("a", second)
+	want = []VerificationResult{{ImageID: "second"}, {ImageID: "second"}}
+	if results := PublishedVerificationResults(); !reflect.DeepEqual(results, want) {
+		t.Errorf("PublishedVerificationResults() = %+v, want %+v", results, want)
+	}
+
+	Publish("a", nil)
+	want = []VerificationResult{{ImageID: "second"}}
+	if results := PublishedVerificationResults(); !reflect.DeepEqual(results, want) {
+		t.Errorf("PublishedVerificationResults() = %+v, want %+v", results, want)
+	}
+}
@@ -0,0 +1,131 @@
+package sigstore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+)
+
+// Selector kinds that can be emitted for the verified signatures
+const (
+	SubjectSelectorKind         = "subject"
+	IssuerSelectorKind          = "issuer"
+	KeySelectorKind             = "key"
+	DigestSelectorKind          = "digest"
+	PredicateTypeSelectorKind   = "predicate-type"
+	ContentSelectorKind         = "content"
+	ContentHashSelectorKind     = "content-hash"
+	LogIDSelectorKind           = "logid"
+	IntegratedTimeSelectorKind  = "integrated-time"
+	TrustStoreSelectorKind      = "trust-store"
+	DockerReferenceSelectorKind = "docker-reference"
+	AnnotationSelectorKind      = "annotation"
+)
+
+// defaultSelectorKinds are the selector kinds emitted when none are configured
+var defaultSelectorKinds = selectorKinds{
+	SubjectSelectorKind:         true,
+	ContentSelectorKind:         true,
+	LogIDSelectorKind:           true,
+	IntegratedTimeSelectorKind:  true,
+	TrustStoreSelectorKind:      true,
+	DockerReferenceSelectorKind: true,
+	AnnotationSelectorKind:      true,
+}
+
+var knownSelectorKinds = map[string]bool{
+	SubjectSelectorKind:         true,
+	IssuerSelectorKind:          true,
+	KeySelectorKind:             true,
+	DigestSelectorKind:          true,
+	PredicateTypeSelectorKind:   true,
+	ContentSelectorKind:         true,
+	ContentHashSelectorKind:     true,
+	LogIDSelectorKind:           true,
+	IntegratedTimeSelectorKind:  true,
+	TrustStoreSelectorKind:      true,
+	DockerReferenceSelectorKind: true,
+	AnnotationSelectorKind:      true,
+}
+
+// selectorKinds is the set of selector kinds to emit. A nil set emits the
+// default selector kinds.
+type selectorKinds map[string]bool
+
+func newSelectorKinds(kinds []string) (selectorKinds, error) {
+	if len(kinds) == 0 {
+		return nil, nil
+	}
+	enabled := make(selectorKinds, len(kinds))
+	for _, kind := range kinds {
+		if !knownSelectorKinds[kind] {
+			return nil, fmt.Errorf("Unknown signature selector kind %q", kind)
+		}
+		enabled[kind] = true
+	}
+	return enabled, nil
+}
+
+func (kinds selectorKinds) enabled(kind string) bool {
+	if kinds == nil {
+		return defaultSelectorKinds[kind]
+	}
+	return kinds[kind]
+}
+
+// SetSelectorKinds sets the selector kinds emitted for the verified
+// signatures. No kinds restores the default ones.
+func (sigstore *Sigstoreimpl) SetSelectorKinds(kinds []string) error {
+	selectorKinds, err := newSelectorKinds(kinds)
+	if err != nil {
+		return err
+	}
+	sigstore.selectorKinds = selectorKinds
+	return nil
+}
+
+func selectorsToString(selectors SelectorsFromSignatures, containerID string, kinds selectorKinds) []string {
+	prefix := selectors.Prefix
+	if prefix == "" {
+		prefix = cosignKeylessSelectorPrefix
+	}
+	var selectorsString []string
+	add := func(kind, name, value string) {
+		if value != "" && kinds.enabled(kind) {
+			selectorsString = append(selectorsString, fmt.Sprintf("%s:%s-%s:%s", containerID, prefix, name, value))
+		}
+	}
+
+	add(SubjectSelectorKind, "subject", selectors.Subject)
+	add(IssuerSelectorKind, "issuer", selectors.Issuer)
+	add(KeySelectorKind, "key-id", selectors.KeyID)
+	add(DigestSelectorKind, "digest", selectors.Digest)
+	add(PredicateTypeSelectorKind, "predicate-type", selectors.PredicateType)
+	add(ContentSelectorKind, "content", selectors.Content)
+	if selectors.Content != "" {
+		add(ContentHashSelectorKind, "content-hash", contentHash(selectors.Content))
+	}
+	add(LogIDSelectorKind, "logid", selectors.LogID)
+	add(IntegratedTimeSelectorKind, "integrated-time", selectors.IntegratedTime)
+	add(TrustStoreSelectorKind, "trust-store", selectors.TrustStore)
+	add(DockerReferenceSelectorKind, "docker-reference", selectors.DockerReference)
+	if kinds.enabled(AnnotationSelectorKind) {
+		annotationKeys := make([]string, 0, len(selectors.Annotations))
+		for key := range selectors.Annotations {
+			annotationKeys = append(annotationKeys, key)
+		}
+		sort.Strings(annotationKeys)
+		for _, key := range annotationKeys {
+			add(AnnotationSelectorKind, "annotation", key+":"+selectors.Annotations[key])
+		}
+	}
+	return selectorsString
+}
+
+// contentHash returns the sha256 hash of the signature content, much shorter
+// than the base64 encoded signature itself.
+func contentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
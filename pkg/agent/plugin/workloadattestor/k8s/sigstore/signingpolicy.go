@@ -0,0 +1,313 @@
+package sigstore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	defaultSigningPolicyReloadInterval = time.Minute
+)
+
+// SigningPolicy is the signature verification policy loaded from a YAML or
+// JSON file, e.g. a mounted ConfigMap. It replaces the skip list, allowed
+// subjects and signature verifiers of the plugin configuration.
+type SigningPolicy struct {
+	// SkippedImages is the list of image IDs that skip signature verification
+	SkippedImages []string `json:"skipped_images"`
+
+	// EnforceAllowedSubjects restricts the accepted signature subjects to
+	// AllowedSubjects and AllowedSubjectPatterns.
+	EnforceAllowedSubjects bool `json:"enforce_allowed_subjects"`
+
+	// AllowedSubjects are the accepted signature subjects
+	AllowedSubjects []string `json:"allowed_subjects"`
+
+	// AllowedSubjectPatterns are regular expressions matched against the
+	// whole signature subject.
+	AllowedSubjectPatterns []string `json:"allowed_subject_patterns"`
+
+	// SignatureVerifiers configures the signature verification backends, e.g.
+	// the trusted keys of cosign-key verifiers. Relative paths are resolved
+	// against the directory of the policy file.
+	SignatureVerifiers map[string]*VerifierConfig `json:"signature_verifiers"`
+}
+
+// SigningPolicyFileConfig configures the file the signing policy is loaded from
+type SigningPolicyFileConfig struct {
+	// Path is the path to the policy file
+	Path string
+
+	// ReloadInterval controls how often the file is checked for changes.
+	// Defaults to one minute.
+	ReloadInterval time.Duration
+}
+
+// signingPolicyFile tracks the policy file and the content last read from it
+type signingPolicyFile struct {
+	path           string
+	reloadInterval time.Duration
+	now            func() time.Time
+
+	mu         sync.Mutex
+	lastReload time.Time
+	lastDigest string
+}
+
+// claimReload returns whether the reload interval has elapsed, in which case
+// the caller reloads the file. The other attestations do not wait for the
+// reload and keep using the current policy meanwhile.
+func (f *signingPolicyFile) claimReload() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	now := f.now()
+	if now.Sub(f.lastReload) < f.reloadInterval {
+		return false
+	}
+	f.lastReload = now
+	return true
+}
+
+// digestChanged returns whether the file contents changed since they were
+// last loaded successfully.
+func (f *signingPolicyFile) digestChanged(digest string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return digest != f.lastDigest
+}
+
+// recordDigest records the digest of the file contents once they were loaded
+// successfully.
+func (f *signingPolicyFile) recordDigest(digest string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.lastDigest = digest
+}
+
+// compiledSigningPolicy is a validated signing policy, ready to be applied,
+// or a snapshot of the policy in force.
+type compiledSigningPolicy struct {
+	digest           string
+	skippedImages    map[string]bool
+	allowListEnabled bool
+	subjectAllowList map[string]bool
+	subjectPatterns  []*regexp.Regexp
+	imagePatterns    []imagePattern
+}
+
+// SetSigningPolicyFile loads the signing policy from the configured file and
+// keeps it in sync with the file contents. A nil config stops using the file.
+func (sigstore *Sigstoreimpl) SetSigningPolicyFile(config *SigningPolicyFileConfig) error {
+	if config == nil {
+		sigstore.mu.Lock()
+		sigstore.signingPolicyFile = nil
+		sigstore.subjectPatterns = nil
+		sigstore.signingPolicyDigest = ""
+		sigstore.mu.Unlock()
+		return nil
+	}
+	if config.Path == "" {
+		return fmt.Errorf("Signing policy file path is empty")
+	}
+	reloadInterval := config.ReloadInterval
+	if reloadInterval <= 0 {
+		reloadInterval = defaultSigningPolicyReloadInterval
+	}
+
+	policyFile := &signingPolicyFile{
+		path:           config.Path,
+		reloadInterval: reloadInterval,
+		now:            time.Now,
+	}
+	policyFile.lastReload = policyFile.now()
+	policy, err := sigstore.loadSigningPolicy(policyFile)
+	if err != nil {
+		return err
+	}
+
+	sigstore.mu.Lock()
+	defer sigstore.mu.Unlock()
+	sigstore.signingPolicyFile = policyFile
+	sigstore.applySigningPolicy(policy)
+	return nil
+}
+
+// reloadSigningPolicy reloads the signing policy file when the reload
+// interval has elapsed. A policy that fails validation is logged and the
+// previous policy stays in force.
+//
+// The file is polled on the attestation path, like the revocation list,
+// rather than watched from a goroutine: the plugin has no lifecycle hook to
+// stop a watcher when it is reconfigured or unloaded, and a reload costs a
+// file read at most once per reload interval. Only the attestation that
+// claims the reload reads the file, without holding the sigstore lock, which
+// is only taken to swap the compiled policy in.
+func (sigstore *Sigstoreimpl) reloadSigningPolicy() {
+	sigstore.mu.RLock()
+	policyFile := sigstore.signingPolicyFile
+	sigstore.mu.RUnlock()
+	if policyFile == nil || !policyFile.claimReload() {
+		return
+	}
+
+	// Invalid contents are reported on every reload, since the digest is
+	// only recorded once a policy loads. This way a policy that failed
+	// because of a file it refers to, like a missing public key, applies
+	// once that file is fixed, without touching the policy.
+	policy, err := sigstore.loadSigningPolicy(policyFile)
+	switch {
+	case err != nil:
+		sigstore.logger.Error("Unable to reload signing policy, keeping the previous one", "path", policyFile.path, "err", err)
+	case policy != nil:
+		sigstore.mu.Lock()
+		sigstore.applySigningPolicy(policy)
+		sigstore.mu.Unlock()
+		sigstore.logger.Info("Signing policy reloaded", "path", policyFile.path, "digest", policy.digest)
+	}
+}
+
+// loadSigningPolicy reads and validates the policy file. It returns a nil
+// policy if the file contents did not change since they were last loaded.
+func (sigstore *Sigstoreimpl) loadSigningPolicy(policyFile *signingPolicyFile) (*compiledSigningPolicy, error) {
+	data, err := os.ReadFile(policyFile.path)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read signing policy: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	digest := hex.EncodeToString(sum[:])
+	if !policyFile.digestChanged(digest) {
+		return nil, nil
+	}
+
+	policy := new(SigningPolicy)
+	if err := yaml.UnmarshalStrict(data, policy); err != nil {
+		return nil, fmt.Errorf("Unable to parse signing policy: %w", err)
+	}
+	resolveSigningPolicyPaths(policy, filepath.Dir(policyFile.path))
+	compiled, err := sigstore.compileSigningPolicy(policy, digest)
+	if err != nil {
+		return nil, err
+	}
+	policyFile.recordDigest(digest)
+	return compiled, nil
+}
+
+func (sigstore *Sigstoreimpl) compileSigningPolicy(policy *SigningPolicy, digest string) (*compiledSigningPolicy, error) {
+	compiled := &compiledSigningPolicy{
+		digest:           digest,
+		allowListEnabled: policy.EnforceAllowedSubjects,
+	}
+	for _, imageID := range policy.SkippedImages {
+		if imageID == "" {
+			return nil, fmt.Errorf("Signing policy has an empty skipped image")
+		}
+		if compiled.skippedImages == nil {
+			compiled.skippedImages = make(map[string]bool)
+		}
+		compiled.skippedImages[imageID] = true
+	}
+	for _, subject := range policy.AllowedSubjects {
+		if compiled.subjectAllowList == nil {
+			compiled.subjectAllowList = make(map[string]bool)
+		}
+		compiled.subjectAllowList[subject] = true
+	}
+	for _, pattern := range policy.AllowedSubjectPatterns {
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("Signing policy has invalid subject pattern %q: %w", pattern, err)
+		}
+		compiled.subjectPatterns = append(compiled.subjectPatterns, re)
+	}
+	imagePatterns, err := newImagePatterns(policy.SignatureVerifiers, sigstore.verifierOptions())
+	if err != nil {
+		return nil, fmt.Errorf("Signing policy has invalid signature verifiers: %w", err)
+	}
+	compiled.imagePatterns = imagePatterns
+	return compiled, nil
+}
+
+// currentPolicy returns the settings controlled by the signing policy, so
+// that an attestation runs with the same policy from start to end without
+// holding the lock. The policy maps and slices are replaced, never modified,
+// when a new policy is applied.
+func (sigstore *Sigstoreimpl) currentPolicy() *compiledSigningPolicy {
+	sigstore.mu.RLock()
+	defer sigstore.mu.RUnlock()
+	return &compiledSigningPolicy{
+		digest:           sigstore.signingPolicyDigest,
+		skippedImages:    sigstore.skippedImages,
+		allowListEnabled: sigstore.allowListEnabled,
+		subjectAllowList: sigstore.subjectAllowList,
+		subjectPatterns:  sigstore.subjectPatterns,
+		imagePatterns:    sigstore.imagePatterns,
+	}
+}
+
+// shouldSkipImage returns whether the image skips signature verification
+func (policy *compiledSigningPolicy) shouldSkipImage(imageID string) (bool, error) {
+	if policy.skippedImages == nil {
+		return false, nil
+	}
+	if imageID == "" {
+		return false, errors.New("Image ID is empty")
+	}
+	if _, ok := policy.skippedImages[imageID]; ok {
+		return true, nil
+	}
+	return false, nil
+}
+
+// isSubjectAllowed checks the subject against the allowed subjects and
+// subject patterns.
+func (policy *compiledSigningPolicy) isSubjectAllowed(subject string) bool {
+	if _, ok := policy.subjectAllowList[subject]; ok {
+		return true
+	}
+	for _, pattern := range policy.subjectPatterns {
+		if pattern.MatchString(subject) {
+			return true
+		}
+	}
+	return false
+}
+
+// applySigningPolicy swaps in the compiled policy. Must be called with the
+// sigstore lock held.
+func (sigstore *Sigstoreimpl) applySigningPolicy(policy *compiledSigningPolicy) {
+	sigstore.signingPolicyDigest = policy.digest
+	sigstore.skippedImages = policy.skippedImages
+	sigstore.allowListEnabled = policy.allowListEnabled
+	sigstore.subjectAllowList = policy.subjectAllowList
+	sigstore.subjectPatterns = policy.subjectPatterns
+	sigstore.imagePatterns = policy.imagePatterns
+}
+
+// resolveSigningPolicyPaths makes the verifier paths of the policy relative
+// to the policy file directory, so that keys can be shipped alongside the
+// policy in the same ConfigMap.
+func resolveSigningPolicyPaths(policy *SigningPolicy, dir string) {
+	resolve := func(path string) string {
+		if path == "" || filepath.IsAbs(path) {
+			return path
+		}
+		return filepath.Join(dir, path)
+	}
+	for _, config := range policy.SignatureVerifiers {
+		if config == nil {
+			continue
+		}
+		config.PublicKeyPath = resolve(config.PublicKeyPath)
+		config.TrustPolicyPath = resolve(config.TrustPolicyPath)
+		config.TrustStoreDir = resolve(config.TrustStoreDir)
+	}
+}
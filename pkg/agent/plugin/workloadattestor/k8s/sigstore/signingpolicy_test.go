@@ -0,0 +1,341 @@
+package sigstore
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func writeSigningPolicy(t *testing.T, path, content string) {
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSigstoreimpl_loadSigningPolicy(t *testing.T) {
+	keyPath := writePublicKey(t)
+	dir := filepath.Dir(keyPath)
+
+	tests := []struct {
+		name                string
+		content             string
+		wantSkippedImages   map[string]bool
+		wantAllowList       bool
+		wantAllowed         []string
+		wantNotAllowed      []string
+		wantVerifierPattern []string
+		wantErr             string
+	}{
+		{
+			name: "yaml policy",
+			content: `
+skipped_images:
+  - docker-registry.com/some/image@sha256:0cfdaced91cb46dd7af48309799a3c351e4ca2d5e1ee9737ca0cbd932cb79898
+enforce_allowed_subjects: true
+allowed_subjects:
+  - spirex@example.com
+allowed_subject_patterns:
+  - '.*@ci\.example\.com'
+  - 'https://github\.com/example/.*'
+signature_verifiers:
+  trusted-key:
+    type: cosign-key
+    image_patterns: ["docker-registry.com/signed/*"]
+    public_key_path: cosign.pub
+`,
+			wantSkippedImages: map[string]bool{
+				"docker-registry.com/some/image@sha256:0cfdaced91cb46dd7af48309799a3c351e4ca2d5e1ee9737ca0cbd932cb79898": true,
+			},
+			wantAllowList:       true,
+			wantAllowed:         []string{"spirex@example.com", "builder@ci.example.com", "https://github.com/example/repo/.github/workflows/release.yaml@refs/heads/main"},
+			wantNotAllowed:      []string{"spirex1@example.com", "builder@ci.example.com.evil.com", "https://github.com/other/repo"},
+			wantVerifierPattern: []string{"docker-registry.com/signed/*"},
+		},
+		{
+			name:           "json policy",
+			content:        `{"enforce_allowed_subjects": true, "allowed_subjects": ["spirex@example.com"]}`,
+			wantAllowList:  true,
+			wantAllowed:    []string{"spirex@example.com"},
+			wantNotAllowed: []string{"builder@ci.example.com"},
+		},
+		{
+			name:    "empty policy",
+			content: ``,
+		},
+		{
+			name:    "unknown field",
+			content: `allowed_subject_list: ["spirex@example.com"]`,
+			wantErr: "Unable to parse signing policy",
+		},
+		{
+			name:    "invalid subject pattern",
+			content: `allowed_subject_patterns: ["(unclosed"]`,
+			wantErr: "Signing policy has invalid subject pattern",
+		},
+		{
+			name:    "empty skipped image",
+			content: `skipped_images: [""]`,
+			wantErr: "Signing policy has an empty skipped image",
+		},
+		{
+			name: "invalid signature verifier",
+			content: `
+signature_verifiers:
+  trusted-key:
+    type: cosign-key
+    image_patterns: ["docker-registry.com/signed/*"]
+    public_key_path: missing.pub
+`,
+			wantErr: "Signing policy has invalid signature verifiers",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policyPath := filepath.Join(dir, "policy.yaml")
+			writeSigningPolicy(t, policyPath, tt.content)
+
+			sigstore := New(nil, hclog.NewNullLogger()).(*Sigstoreimpl)
+			policy, err := sigstore.loadSigningPolicy(&signingPolicyFile{path: policyPath, now: time.Now})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("loadSigningPolicy() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("loadSigningPolicy() unexpected error = %v", err)
+			}
+			sigstore.applySigningPolicy(policy)
+
+			if !reflect.DeepEqual(sigstore.skippedImages, tt.wantSkippedImages) {
+				t.Errorf("skippedImages = %v, want %v", sigstore.skippedImages, tt.wantSkippedImages)
+			}
+			if sigstore.allowListEnabled != tt.wantAllowList {
+				t.Errorf("allowListEnabled = %v, want %v", sigstore.allowListEnabled, tt.wantAllowList)
+			}
+			for _, subject := range tt.wantAllowed {
+				if !sigstore.currentPolicy().isSubjectAllowed(subject) {
+					t.Errorf("isSubjectAllowed(%q) = false, want true", subject)
+				}
+			}
+			for _, subject := range tt.wantNotAllowed {
+				if sigstore.currentPolicy().isSubjectAllowed(subject) {
+					t.Errorf("isSubjectAllowed(%q) = true, want false", subject)
+				}
+			}
+			var patterns []string
+			for _, pattern := range sigstore.imagePatterns {
+				patterns = append(patterns, pattern.pattern)
+			}
+			if !reflect.DeepEqual(patterns, tt.wantVerifierPattern) {
+				t.Errorf("image patterns = %v, want %v", patterns, tt.wantVerifierPattern)
+			}
+		})
+	}
+}
+
+func TestSigstoreimpl_SetSigningPolicyFile(t *testing.T) {
+	policyPath := filepath.Join(t.TempDir(), "policy.yaml")
+	allowedPolicy := `
+signature_verifiers:
+  allowed:
+    type: digest-allowlist
+    image_patterns: ["docker-registry.com/some/*"]
+    digests: ["` + testDigest + `"]
+`
+	writeSigningPolicy(t, policyPath, allowedPolicy)
+
+	sigstore := New(NewCache(maximumAmountCache), hclog.NewNullLogger()).(*Sigstoreimpl)
+	if err := sigstore.SetSigningPolicyFile(&SigningPolicyFileConfig{
+		Path:           policyPath,
+		ReloadInterval: time.Minute,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	sigstore.signingPolicyFile.now = func() time.Time { return now }
+
+	status := &corev1.ContainerStatus{
+		Image:       "docker-registry.com/some/image:v1",
+		ImageID:     "docker-registry.com/some/image@" + testDigest,
+		ContainerID: "000000",
+	}
+	attest := func(want []string, wantErr bool) {
+		t.Helper()
+		got, err := sigstore.AttestContainerSignatures(status)
+		if (err != nil) != wantErr {
+			t.Errorf("Sigstoreimpl.AttestContainerSignatures() error = %v, wantErr %v", err, wantErr)
+			return
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Sigstoreimpl.AttestContainerSignatures() = %v, want %v", got, want)
+		}
+	}
+	allowed := []string{"000000:digest-allowlist-subject:allowed", "sigstore-validation:passed"}
+	attest(allowed, false)
+
+	// The file is not reloaded before the reload interval elapses
+	writeSigningPolicy(t, policyPath, `
+signature_verifiers:
+  allowed:
+    type: digest-allowlist
+    image_patterns: ["docker-registry.com/some/*"]
+    digests: ["sha256:0cfdaced91cb46dd7af48309799a3c351e4ca2d5e1ee9737ca0cbd932cb79898"]
+`)
+	attest(allowed, false)
+
+	// The new policy applies, the result cached under the previous one is not reused
+	now = now.Add(time.Minute)
+	attest(nil, true)
+
+	// An invalid policy keeps the previous one in force
+	writeSigningPolicy(t, policyPath, `skipped_images: "not a list"`)
+	now = now.Add(time.Minute)
+	attest(nil, true)
+
+	writeSigningPolicy(t, policyPath, `skipped_images: ["docker-registry.com/some/image@`+testDigest+`"]`)
+	now = now.Add(time.Minute)
+	attest([]string{"sigstore-validation:passed"}, false)
+
+	writeSigningPolicy(t, policyPath, allowedPolicy)
+	now = now.Add(time.Minute)
+	attest(allowed, false)
+
+	if err := sigstore.SetSigningPolicyFile(nil); err != nil {
+		t.Fatal(err)
+	}
+	if sigstore.signingPolicyFile != nil || sigstore.signingPolicyDigest != "" {
+		t.Errorf("SetSigningPolicyFile(nil) did not stop using the policy file")
+	}
+
+	if err := sigstore.SetSigningPolicyFile(&SigningPolicyFileConfig{Path: filepath.Join(t.TempDir(), "missing.yaml")}); err == nil || !strings.Contains(err.Error(), "Unable to read signing policy") {
+		t.Errorf("SetSigningPolicyFile() error = %v, want missing file error", err)
+	}
+}
+
+func TestSigstoreimpl_ReloadSigningPolicyAfterFixingKeyFile(t *testing.T) {
+	dir := t.TempDir()
+	policyPath := filepath.Join(dir, "policy.yaml")
+	writeSigningPolicy(t, policyPath, `skipped_images: ["docker-registry.com/some/image@`+testDigest+`"]`)
+
+	sigstore := New(NewCache(maximumAmountCache), hclog.NewNullLogger()).(*Sigstoreimpl)
+	if err := sigstore.SetSigningPolicyFile(&SigningPolicyFileConfig{
+		Path:           policyPath,
+		ReloadInterval: time.Minute,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	sigstore.signingPolicyFile.now = func() time.Time { return now }
+
+	imagePatterns := func() []string {
+		sigstore.mu.RLock()
+		defer sigstore.mu.RUnlock()
+		var patterns []string
+		for _, pattern := range sigstore.imagePatterns {
+			patterns = append(patterns, pattern.pattern)
+		}
+		return patterns
+	}
+
+	// The policy refers to a public key that is not there yet
+	writeSigningPolicy(t, policyPath, `
+signature_verifiers:
+  trusted-key:
+    type: cosign-key
+    image_patterns: ["docker-registry.com/signed/*"]
+    public_key_path: cosign.pub
+`)
+	now = now.Add(time.Minute)
+	sigstore.reloadSigningPolicy()
+	if patterns := imagePatterns(); patterns != nil {
+		t.Fatalf("image patterns = %v, want the previous policy to stay in force", patterns)
+	}
+
+	// Adding the key applies the policy, without touching the policy file
+	keyPEM, err := os.ReadFile(writePublicKey(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "cosign.pub"), keyPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(time.Minute)
+	sigstore.reloadSigningPolicy()
+	if patterns, want := imagePatterns(), []string{"docker-registry.com/signed/*"}; !reflect.DeepEqual(patterns, want) {
+		t.Errorf("image patterns = %v, want %v", patterns, want)
+	}
+}
+
+func TestSigstoreimpl_ReloadSigningPolicyConcurrently(t *testing.T) {
+	policyPath := filepath.Join(t.TempDir(), "policy.yaml")
+	allowedPolicy := `
+signature_verifiers:
+  allowed:
+    type: digest-allowlist
+    image_patterns: ["docker-registry.com/some/*"]
+    digests: ["` + testDigest + `"]
+`
+	skippedPolicy := allowedPolicy + `
+skipped_images: ["docker-registry.com/some/image@` + testDigest + `"]
+`
+	// The policy is replaced atomically, so that it is never read half written
+	replacePolicy := func(content string) {
+		tmpPath := policyPath + ".tmp"
+		writeSigningPolicy(t, tmpPath, content)
+		if err := os.Rename(tmpPath, policyPath); err != nil {
+			t.Error(err)
+		}
+	}
+	replacePolicy(allowedPolicy)
+
+	sigstore := New(NewCache(maximumAmountCache), hclog.NewNullLogger()).(*Sigstoreimpl)
+	if err := sigstore.SetSigningPolicyFile(&SigningPolicyFileConfig{
+		Path:           policyPath,
+		ReloadInterval: time.Nanosecond,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	status := &corev1.ContainerStatus{
+		Image:       "docker-registry.com/some/image:v1",
+		ImageID:     "docker-registry.com/some/image@" + testDigest,
+		ContainerID: "000000",
+	}
+	allowed := []string{"000000:digest-allowlist-subject:allowed", "sigstore-validation:passed"}
+	skipped := []string{"sigstore-validation:passed"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				got, err := sigstore.AttestContainerSignatures(status)
+				if err != nil {
+					t.Errorf("Sigstoreimpl.AttestContainerSignatures() unexpected error = %v", err)
+					return
+				}
+				if !reflect.DeepEqual(got, allowed) && !reflect.DeepEqual(got, skipped) {
+					t.Errorf("Sigstoreimpl.AttestContainerSignatures() = %v, want %v or %v", got, allowed, skipped)
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 20; i++ {
+		if i%2 == 0 {
+			replacePolicy(skippedPolicy)
+		} else {
+			replacePolicy(allowedPolicy)
+		}
+	}
+	wg.Wait()
+}
@@ -10,6 +10,10 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
@@ -28,18 +32,50 @@ const (
 	signatureVerifiedSelector = "sigstore-validation:passed"
 )
 
+var (
+	// imageIDSchemeRe matches the scheme some container runtimes prepend to
+	// the image ID, e.g. "docker-pullable://" or "docker://" for dockershim.
+	imageIDSchemeRe = regexp.MustCompile(`^[a-z0-9-]+://`)
+
+	// bareDigestRe matches an image ID that carries a digest but no
+	// repository, as reported by containerd and CRI-O for some images.
+	bareDigestRe = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+)
+
+// Sigstore verifies image signatures with the configured settings
 type Sigstore interface {
+	Verifier
+	Config
+}
+
+// Verifier verifies the signatures of container images and OCI artifacts
+type Verifier interface {
 	AttestContainerSignatures(status *corev1.ContainerStatus) ([]string, error)
+	AttestArtifactSignatures(artifact string) ([]string, error)
 	FetchImageSignatures(imageName string) ([]oci.Signature, error)
 	SelectorValuesFromSignature(oci.Signature, string) SelectorsFromSignatures
 	ExtractSelectorsFromSignatures(signatures []oci.Signature, containerID string) []SelectorsFromSignatures
 	ShouldSkipImage(imageID string) (bool, error)
+	VerificationResults() []VerificationResult
+}
+
+// Config configures the signature verification. The settings must be applied
+// before the verifier is used: a verifier in use is replaced by a new one
+// rather than reconfigured, so that attestations in progress keep consistent
+// settings. Only the signing policy file is reloaded while in use.
+type Config interface {
 	AddSkippedImage(imageID string)
 	ClearSkipList()
 	AddAllowedSubject(subject string)
 	EnableAllowSubjectList(bool)
 	ClearAllowedSubjects()
 	SetRekorURL(rekorURL string) error
+	SetVerifiers(configs map[string]*VerifierConfig) error
+	SetTransparencyLog(config *TransparencyLogConfig) error
+	SetSignaturePolicy(config *SignaturePolicyConfig) error
+	SetSigningPolicyFile(config *SigningPolicyFileConfig) error
+	SetSelectorKinds(kinds []string) error
+	SetRemoteLimits(config *RemoteLimitsConfig) error
 	SetLogger(logger hclog.Logger)
 }
 
@@ -52,6 +88,23 @@ type Sigstoreimpl struct {
 	rekorURL                   url.URL
 	logger                     hclog.Logger
 	sigstorecache              Cache
+	imagePatterns              []imagePattern
+	transparencyLog            *transparencyLog
+	signaturePolicy            *signaturePolicy
+	selectorKinds              selectorKinds
+	remoteLimits               *remoteLimits
+
+	// failedVerifications keeps the results of the failed verifications,
+	// created on first use.
+	failedVerifications     Cache
+	failedVerificationsOnce sync.Once
+
+	// mu guards the settings swapped by the signing policy file: the skip
+	// list, the allowed subjects and the image verifiers.
+	mu                  sync.RWMutex
+	subjectPatterns     []*regexp.Regexp
+	signingPolicyFile   *signingPolicyFile
+	signingPolicyDigest string
 }
 
 func New(cache Cache, logger hclog.Logger) Sigstore {
@@ -84,10 +137,9 @@ func (sigstore *Sigstoreimpl) FetchImageSignatures(imageName string) ([]oci.Sign
 		return nil, errors.New(message)
 	}
 
-	_, err = sigstore.ValidateImage(ref)
+	digest, err := sigstore.resolveDigest(ref)
 	if err != nil {
-		message := fmt.Sprint("Could not validate image reference digest: ", err.Error())
-		return nil, errors.New(message)
+		return nil, fmt.Errorf("Could not validate image reference digest: %w", err)
 	}
 
 	co := &cosign.CheckOpts{}
@@ -97,17 +149,26 @@ func (sigstore *Sigstoreimpl) FetchImageSignatures(imageName string) ([]oci.Sign
 
 	co.RootCerts = fulcio.GetRoots()
 
+	// Only accept signatures whose payload was signed for this image
+	co.ClaimVerifier = signedImageClaimVerifier(digest)
+
 	ctx := context.Background()
-	sigs, ok, err := sigstore.verifyFunction(ctx, ref, co)
+	sigs, ok, err := sigstore.verifyFunction(ctx, digest, co)
 	if err != nil {
-		message := fmt.Sprint("Error verifying signature: ", err.Error())
-		return nil, errors.New(message)
+		return nil, fmt.Errorf("Error verifying signature: %w", err)
 	}
 	if !ok {
 		message := "Bundle not verified for " + imageName
 		return nil, errors.New(message)
 	}
 
+	if sigstore.transparencyLog != nil {
+		sigs, err = sigstore.transparencyLog.VerifySignatures(ctx, sigs)
+		if err != nil {
+			return nil, fmt.Errorf("Error verifying transparency log inclusion: %w", err)
+		}
+	}
+
 	return sigs, nil
 }
 
@@ -119,15 +180,29 @@ func (sigstore *Sigstoreimpl) ExtractSelectorsFromSignatures(signatures []oci.Si
 	if signatures == nil {
 		return nil
 	}
+	selectors, _ := sigstore.extractSignatures(sigstore.currentPolicy(), signatures, containerID)
+	return selectors
+}
+
+// extractSignatures extracts the selectors of the verified signatures, and
+// the results of the rejected ones.
+func (sigstore *Sigstoreimpl) extractSignatures(policy *compiledSigningPolicy, signatures []oci.Signature, containerID string) ([]SelectorsFromSignatures, []SignatureResult) {
 	var selectors []SelectorsFromSignatures
+	var rejected []SignatureResult
 	for _, sig := range signatures {
 		// verify which subject
-		sigSelectors := sigstore.SelectorValuesFromSignature(sig, containerID)
+		sigSelectors, reason := sigstore.selectorValuesFromSignature(policy, sig, containerID)
 		if sigSelectors.Verified {
 			selectors = append(selectors, sigSelectors)
+		} else {
+			rejected = append(rejected, SignatureResult{
+				VerifierType:  CosignKeylessVerifierType,
+				Subject:       sigSelectors.Subject,
+				FailureReason: reason,
+			})
 		}
 	}
-	return selectors
+	return selectors, rejected
 }
 
 func getSignatureSubject(signature oci.Signature) (string, error) {
@@ -208,76 +283,168 @@ func getBundleSignatureContent(bundle *oci.Bundle) (string, error) {
 }
 
 type SelectorsFromSignatures struct {
+	// Prefix is the selector prefix of the verifier that produced the
+	// selectors. Defaults to the cosign keyless prefix when empty.
+	Prefix         string
 	Subject        string
 	Content        string
 	LogID          string
+	LogIndex       int64
 	IntegratedTime string
-	Verified       bool
+	TrustStore     string
+	// DockerReference is the repository the signed payload was issued for
+	DockerReference string
+	// Annotations are the optional string annotations of the signed payload
+	Annotations map[string]string
+	// Issuer is the OIDC issuer or certificate issuer of the signer identity
+	Issuer string
+	// KeyID identifies the key that verified the signature
+	KeyID string
+	// RekorUUID is the UUID of the rekor entry of the signature
+	RekorUUID string
+	// Digest is the digest of the verified image
+	Digest string
+	// PredicateType is the type of the signed payload
+	PredicateType string
+	Verified      bool
 }
 
 // SelectorValuesFromSignature extracts selectors from a signature.
 // returns a list of selectors.
 func (sigstore *Sigstoreimpl) SelectorValuesFromSignature(signature oci.Signature, containerID string) SelectorsFromSignatures {
+	selectorsFromSignatures, _ := sigstore.selectorValuesFromSignature(sigstore.currentPolicy(), signature, containerID)
+	if !selectorsFromSignatures.Verified {
+		return SelectorsFromSignatures{}
+	}
+	return selectorsFromSignatures
+}
+
+// selectorValuesFromSignature extracts selectors from a signature. It also
+// returns the reason the signature was rejected, if it was.
+func (sigstore *Sigstoreimpl) selectorValuesFromSignature(policy *compiledSigningPolicy, signature oci.Signature, containerID string) (SelectorsFromSignatures, string) {
 	subject, err := getSignatureSubject(signature)
 	var selectorsFromSignatures SelectorsFromSignatures
 
 	if err != nil {
 		sigstore.logger.Error("Error getting signature subject: ", err.Error())
-		return selectorsFromSignatures
+		return selectorsFromSignatures, fmt.Sprint("Error getting signature subject: ", err.Error())
 	}
 
 	if subject == "" {
 		sigstore.logger.Error("Error getting signature subject: empty subject")
-		return selectorsFromSignatures
+		return selectorsFromSignatures, "Empty signature subject"
 	}
 
-	suppress := false
-	if sigstore.allowListEnabled {
-		if _, ok := sigstore.subjectAllowList[subject]; !ok {
-			suppress = true
-		}
+	if policy.allowListEnabled && !policy.isSubjectAllowed(subject) {
+		return SelectorsFromSignatures{Subject: subject}, "Signature subject is not allowed"
 	}
 
-	if !suppress {
-		selectorsFromSignatures.Subject = subject
-		selectorsFromSignatures.Verified = true
+	selectorsFromSignatures.Subject = subject
+	selectorsFromSignatures.Verified = true
+	addPayloadSelectors(signature, &selectorsFromSignatures, sigstore.logger)
+	addBundleSelectors(signature, &selectorsFromSignatures, sigstore.logger)
+	addCertSelectors(signature, &selectorsFromSignatures)
+	return selectorsFromSignatures, ""
+}
+
+// signedImageClaimVerifier returns a cosign claim verifier that, on top of
+// the manifest digest check, requires the docker-reference of the signed
+// payload to match the repository of the image, so that a signature cannot be
+// reused for the same digest pushed to another repository.
+func signedImageClaimVerifier(ref name.Reference) func(oci.Signature, v1.Hash, map[string]interface{}) error {
+	return func(sig oci.Signature, imageDigest v1.Hash, annotations map[string]interface{}) error {
+		if err := cosign.SimpleClaimVerifier(sig, imageDigest, annotations); err != nil {
+			return err
+		}
 
-		bundle, err := signature.Bundle()
+		ss, err := signedPayload(sig)
 		if err != nil {
-			sigstore.logger.Error("Error getting signature bundle: ", err.Error())
-		} else {
-			sigContent, err := getBundleSignatureContent(bundle)
-			if err != nil {
-				sigstore.logger.Error("Error getting signature content: ", err.Error())
-			} else {
-				selectorsFromSignatures.Content = sigContent
-			}
-			if bundle.Payload.LogID != "" {
-				selectorsFromSignatures.LogID = bundle.Payload.LogID
-			}
-			if bundle.Payload.IntegratedTime != 0 {
-				selectorsFromSignatures.IntegratedTime = fmt.Sprintf("%d", bundle.Payload.IntegratedTime)
-			}
+			return err
 		}
+		signedRef, err := name.ParseReference(ss.Critical.Identity.DockerReference)
+		if err != nil {
+			return fmt.Errorf("Error parsing signed docker-reference %q: %w", ss.Critical.Identity.DockerReference, err)
+		}
+		if signedRef.Context().Name() != ref.Context().Name() {
+			return fmt.Errorf("Signed docker-reference %s does not match image repository %s", signedRef.Context().Name(), ref.Context().Name())
+		}
+		return nil
 	}
-	return selectorsFromSignatures
 }
 
-func selectorsToString(selectors SelectorsFromSignatures, containerID string) []string {
-	var selectorsString []string
-	if selectors.Subject != "" {
-		selectorsString = append(selectorsString, fmt.Sprintf("%s:image-signature-subject:%s", containerID, selectors.Subject))
+func signedPayload(signature oci.Signature) (*payload.SimpleContainerImage, error) {
+	pl, err := signature.Payload()
+	if err != nil {
+		return nil, err
 	}
-	if selectors.Content != "" {
-		selectorsString = append(selectorsString, fmt.Sprintf("%s:image-signature-content:%s", containerID, selectors.Content))
+	ss := &payload.SimpleContainerImage{}
+	if err := json.Unmarshal(pl, ss); err != nil {
+		return nil, err
 	}
-	if selectors.LogID != "" {
-		selectorsString = append(selectorsString, fmt.Sprintf("%s:image-signature-logid:%s", containerID, selectors.LogID))
+	return ss, nil
+}
+
+// addPayloadSelectors adds the docker-reference and the optional string
+// annotations of the signed payload to the selectors. The "subject"
+// annotation is skipped, since it is already used as the signature subject.
+func addPayloadSelectors(signature oci.Signature, selectors *SelectorsFromSignatures, logger hclog.Logger) {
+	ss, err := signedPayload(signature)
+	if err != nil {
+		logger.Error("Error getting signature payload: ", err.Error())
+		return
 	}
-	if selectors.IntegratedTime != "" {
-		selectorsString = append(selectorsString, fmt.Sprintf("%s:image-signature-integrated-time:%s", containerID, selectors.IntegratedTime))
+
+	selectors.DockerReference = ss.Critical.Identity.DockerReference
+	selectors.PredicateType = ss.Critical.Type
+	for key, value := range ss.Optional {
+		annotation, ok := value.(string)
+		if !ok || key == "subject" {
+			continue
+		}
+		if selectors.Annotations == nil {
+			selectors.Annotations = make(map[string]string)
+		}
+		selectors.Annotations[key] = annotation
 	}
-	return selectorsString
+}
+
+// addBundleSelectors adds the signature content, log ID and integrated time
+// from the signature bundle, if any, to the selectors.
+func addBundleSelectors(signature oci.Signature, selectors *SelectorsFromSignatures, logger hclog.Logger) {
+	bundle, err := signature.Bundle()
+	switch {
+	case err != nil:
+		logger.Error("Error getting signature bundle: ", err.Error())
+		return
+	case bundle == nil:
+		return
+	}
+
+	sigContent, err := getBundleSignatureContent(bundle)
+	if err != nil {
+		logger.Error("Error getting signature content: ", err.Error())
+	} else {
+		selectors.Content = sigContent
+	}
+	if bundle.Payload.LogID != "" {
+		selectors.LogID = bundle.Payload.LogID
+		selectors.LogIndex = bundle.Payload.LogIndex
+		selectors.RekorUUID = rekorEntryUUID(bundle)
+	}
+	if bundle.Payload.IntegratedTime != 0 {
+		selectors.IntegratedTime = fmt.Sprintf("%d", bundle.Payload.IntegratedTime)
+	}
+}
+
+// addCertSelectors adds the issuer and key ID of the signing certificate, if
+// any, to the selectors.
+func addCertSelectors(signature oci.Signature, selectors *SelectorsFromSignatures) {
+	cert, err := signature.Cert()
+	if err != nil || cert == nil {
+		return
+	}
+	selectors.Issuer = certIssuer(cert)
+	selectors.KeyID = certKeyID(cert)
 }
 
 func certSubject(c *x509.Certificate) string {
@@ -298,16 +465,7 @@ func certSubject(c *x509.Certificate) string {
 // If the image ID is found in the skip list, it returns true.
 // If the image ID is not found in the skip list, it returns false.
 func (sigstore *Sigstoreimpl) ShouldSkipImage(imageID string) (bool, error) {
-	if sigstore.skippedImages == nil {
-		return false, nil
-	}
-	if imageID == "" {
-		return false, errors.New("Image ID is empty")
-	}
-	if _, ok := sigstore.skippedImages[imageID]; ok {
-		return true, nil
-	}
-	return false, nil
+	return sigstore.currentPolicy().shouldSkipImage(imageID)
 }
 
 // AddSkippedImage adds the image ID and selectors to the skip list.
@@ -326,31 +484,54 @@ func (sigstore *Sigstoreimpl) ClearSkipList() {
 	sigstore.skippedImages = nil
 }
 
-// Validates if the image manifest hash matches the digest in the image reference
+// Validates if the image manifest hash matches the digest in the image
+// reference. Tag references are accepted as long as their manifest can be
+// fetched, see resolveDigest.
 func (sigstore *Sigstoreimpl) ValidateImage(ref name.Reference) (bool, error) {
-	desc, err := sigstore.fetchImageManifestFunction(ref)
-	if err != nil {
+	if _, err := sigstore.resolveDigest(ref); err != nil {
 		return false, err
 	}
+	return true, nil
+}
+
+// resolveDigest fetches the image manifest and returns the digest reference
+// of the image. Digest references must match the manifest hash, while tag
+// references are pinned to the manifest they currently point to, so that the
+// signatures are verified against the image that was validated.
+func (sigstore *Sigstoreimpl) resolveDigest(ref name.Reference) (name.Digest, error) {
+	return resolveDigest(sigstore.fetchImageManifestFunction, ref)
+}
+
+func resolveDigest(fetchImageManifest func(ref name.Reference, options ...remote.Option) (*remote.Descriptor, error), ref name.Reference) (name.Digest, error) {
+	desc, err := fetchImageManifest(ref)
+	if err != nil {
+		return name.Digest{}, err
+	}
 	if desc.Manifest == nil {
-		return false, errors.New("Manifest is nil")
+		return name.Digest{}, errors.New("Manifest is nil")
 	}
 	hash, _, err := v1.SHA256(bytes.NewReader(desc.Manifest))
 	if err != nil {
-		return false, err
+		return name.Digest{}, err
 	}
 
-	return validateRefDigest(ref, hash.String())
+	if err := validateRefDigest(ref, hash.String()); err != nil {
+		return name.Digest{}, err
+	}
+	return ref.Context().Digest(hash.String()), nil
 }
 
-func validateRefDigest(ref name.Reference, digest string) (bool, error) {
-	if dgst, ok := ref.(name.Digest); ok {
-		if dgst.DigestStr() == digest {
-			return true, nil
+func validateRefDigest(ref name.Reference, digest string) error {
+	switch ref := ref.(type) {
+	case name.Digest:
+		if ref.DigestStr() != digest {
+			return fmt.Errorf("Digest %s does not match %s", digest, ref.DigestStr())
 		}
-		return false, fmt.Errorf("Digest %s does not match %s", digest, dgst.DigestStr())
+		return nil
+	case name.Tag:
+		return nil
 	}
-	return false, fmt.Errorf("Reference %s is not a digest", ref.String())
+	return fmt.Errorf("Reference %s is neither a digest nor a tag", ref.String())
 }
 
 func (sigstore *Sigstoreimpl) AddAllowedSubject(subject string) {
@@ -371,63 +552,274 @@ func (sigstore *Sigstoreimpl) EnableAllowSubjectList(flag bool) {
 	sigstore.allowListEnabled = flag
 }
 
+// ImageReference normalizes the image ID reported by the container runtime
+// into a digest reference suitable for signature lookup. The known formats are:
+//   - dockershim: "docker-pullable://repo@sha256:..." or "docker://sha256:..."
+//   - containerd: "repo@sha256:..." or "sha256:..."
+//   - CRI-O: "repo@sha256:..."
+//
+// An image ID without repository takes the repository of the image reported
+// in the container status, which may be tagged or pinned to the same digest.
+// On containerd it is the digest of the image config rather than the
+// manifest, in which case no signature is found for it.
+func ImageReference(status *corev1.ContainerStatus) (name.Digest, error) {
+	imageID := imageIDSchemeRe.ReplaceAllString(status.ImageID, "")
+	if imageID == "" {
+		return name.Digest{}, errors.New("Image ID is empty")
+	}
+
+	if bareDigestRe.MatchString(imageID) {
+		image := imageIDSchemeRe.ReplaceAllString(status.Image, "")
+		if image == "" || bareDigestRe.MatchString(image) {
+			return name.Digest{}, fmt.Errorf("Unable to resolve repository for image ID %s", status.ImageID)
+		}
+		ref, err := name.ParseReference(image)
+		if err != nil {
+			return name.Digest{}, fmt.Errorf("Error parsing image %s: %w", status.Image, err)
+		}
+		if pinned, ok := ref.(name.Digest); ok && pinned.DigestStr() != imageID {
+			return name.Digest{}, fmt.Errorf("Image ID %s does not match the digest image %s is pinned to", status.ImageID, status.Image)
+		}
+		imageID = ref.Context().Name() + "@" + imageID
+	}
+
+	digest, err := name.NewDigest(imageID)
+	if err != nil {
+		return name.Digest{}, fmt.Errorf("Error parsing image ID %s: %w", status.ImageID, err)
+	}
+	return digest, nil
+}
+
 func (sigstore *Sigstoreimpl) AttestContainerSignatures(status *corev1.ContainerStatus) ([]string, error) {
-	skip, _ := sigstore.ShouldSkipImage(status.ImageID)
+	sigstore.reloadSigningPolicy()
+
+	// The whole attestation runs with the same signing policy
+	policy := sigstore.currentPolicy()
+
+	skip, _ := policy.shouldSkipImage(status.ImageID)
 	if skip {
 		return []string{signatureVerifiedSelector}, nil
 	}
 
-	imageID := status.ImageID
+	ref, err := ImageReference(status)
+	if err != nil {
+		return nil, err
+	}
 
+	imageID := ref.String()
+
+	skip, _ = policy.shouldSkipImage(imageID)
+	if skip {
+		return []string{signatureVerifiedSelector}, nil
+	}
+
+	selectors, err := sigstore.verifySignatures(policy, ref, status.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var selectorsString []string
+	if len(selectors) > 0 {
+		for _, selector := range selectors {
+			toString := selectorsToString(selector, status.ContainerID, sigstore.selectorKinds)
+			selectorsString = append(selectorsString, toString...)
+		}
+		selectorsString = append(selectorsString, signatureVerifiedSelector)
+	}
+
+	return selectorsString, nil
+}
+
+// verifySignatures verifies the signatures of the image or artifact with the
+// verifier matching its repository, or cosign keyless if none does, and
+// returns the selectors of the signatures accepted by the signature policy.
+func (sigstore *Sigstoreimpl) verifySignatures(policy *compiledSigningPolicy, ref name.Digest, containerID string) ([]SelectorsFromSignatures, error) {
+	imageID := ref.String()
 	cacheKey := imageID
 
+	// Images matching a configured verifier are cached separately per
+	// verifier, so that changing the verifiers does not serve stale results.
+	pattern := matchImagePattern(policy.imagePatterns, ref)
+	if pattern != nil {
+		cacheKey = pattern.name + "|" + imageID
+	}
+	// Results verified under a previous signing policy are not reused
+	if policy.digest != "" {
+		cacheKey = policy.digest + "|" + cacheKey
+	}
+
 	cachedSignature := sigstore.sigstorecache.GetSignature(cacheKey)
-	if cachedSignature != nil {
+	if cachedSignature != nil && !sigstore.remoteLimits.expired(cachedSignature) {
 		sigstore.logger.Debug("Found cached signature", "imageId", imageID)
 	} else {
-		signatures, err := sigstore.FetchImageSignatures(status.ImageID)
-		if err != nil {
+		verifiedAt := time.Now()
+		var selectors []SelectorsFromSignatures
+		var rejected []SignatureResult
+		err := sigstore.remoteLimits.Do(func() error {
+			var err error
+			if pattern != nil {
+				sigstore.logger.Debug("Verifying image with configured verifier", "imageId", imageID, "verifier", pattern.name)
+				selectors, err = pattern.verifier.Verify(context.Background(), ref)
+				return err
+			}
+			var signatures []oci.Signature
+			signatures, err = sigstore.FetchImageSignatures(imageID)
+			if err == nil {
+				selectors, rejected = sigstore.extractSignatures(policy, signatures, containerID)
+			}
+			return err
+		})
+		switch {
+		case err != nil && cachedSignature != nil && sigstore.remoteLimits.canServeStale(err):
+			sigstore.logger.Warn("Serving expired cached signature, remote services are unavailable", "imageId", imageID, "error", err)
+		case err != nil:
+			// Failures are not cached with the selectors, so that the
+			// verification is retried, but are kept for inspection.
+			result := newVerificationResult(imageID, pattern, selectors, rejected, verifiedAt)
+			result.Error = err.Error()
+			sigstore.getFailedVerifications().PutSignature(Item{
+				Key:    cacheKey,
+				Result: result,
+			})
 			return nil, err
+		default:
+			for i := range selectors {
+				selectors[i].Digest = ref.DigestStr()
+			}
+			cachedSignature = &Item{
+				Key:    cacheKey,
+				Value:  selectors,
+				Result: newVerificationResult(imageID, pattern, selectors, rejected, verifiedAt),
+			}
+
+			sigstore.logger.Debug("Caching signature", "imageID", imageID)
+			sigstore.sigstorecache.PutSignature(*cachedSignature)
 		}
+	}
 
-		selectors := sigstore.ExtractSelectorsFromSignatures(signatures, status.ContainerID)
+	// The policy is enforced on every attestation, since signatures age and
+	// get revoked while their verification result is cached.
+	selectors := cachedSignature.Value
+	if sigstore.signaturePolicy != nil {
+		selectors = sigstore.signaturePolicy.Filter(selectors)
+	}
+	return selectors, nil
+}
 
-		cachedSignature = &Item{
-			Key:   cacheKey,
-			Value: selectors,
-		}
+// AttestArtifactSignatures verifies the signatures of the OCI artifact, e.g. a
+// WASM module or a configuration bundle, with the same policy as the container
+// images, and returns the sorted subjects of the accepted signatures. The
+// artifact must be referenced by digest.
+func (sigstore *Sigstoreimpl) AttestArtifactSignatures(artifact string) ([]string, error) {
+	sigstore.reloadSigningPolicy()
+	policy := sigstore.currentPolicy()
 
-		sigstore.logger.Debug("Caching signature", "imageID", imageID)
-		sigstore.sigstorecache.PutSignature(*cachedSignature)
+	ref, err := name.NewDigest(artifact)
+	if err != nil {
+		return nil, fmt.Errorf("Artifact reference %s is not a digest reference: %w", artifact, err)
 	}
 
-	var selectorsString []string
-	if len(cachedSignature.Value) > 0 {
-		for _, selector := range cachedSignature.Value {
-			toString := selectorsToString(selector, status.ContainerID)
-			selectorsString = append(selectorsString, toString...)
-		}
-		selectorsString = append(selectorsString, signatureVerifiedSelector)
+	selectors, err := sigstore.verifySignatures(policy, ref, "")
+	if err != nil {
+		return nil, err
 	}
 
-	return selectorsString, nil
+	seen := make(map[string]bool)
+	var subjects []string
+	for _, selector := range selectors {
+		if selector.Subject != "" && !seen[selector.Subject] {
+			seen[selector.Subject] = true
+			subjects = append(subjects, selector.Subject)
+		}
+	}
+	sort.Strings(subjects)
+	return subjects, nil
 }
 
 func (sigstore *Sigstoreimpl) SetRekorURL(rekorURL string) error {
+	rekorURI, err := parseRekorURL(rekorURL)
+	if err != nil {
+		return err
+	}
+	sigstore.rekorURL = *rekorURI
+	return nil
+}
+
+// SetTransparencyLog enables the verification of the inclusion proofs of
+// the signatures against the signed tree heads of the configured rekor
+// server. A nil config disables it. It must be called after SetRekorURL and
+// before SetVerifiers, since the cosign keyless verifiers share it.
+func (sigstore *Sigstoreimpl) SetTransparencyLog(config *TransparencyLogConfig) error {
+	if config == nil {
+		sigstore.transparencyLog = nil
+		return nil
+	}
+	transparencyLog, err := newTransparencyLog(sigstore.rekorURL.String(), config, sigstore.logger)
+	if err != nil {
+		return err
+	}
+	sigstore.transparencyLog = transparencyLog
+	return nil
+}
+
+// SetSignaturePolicy sets the freshness and revocation policy enforced on
+// the verified signatures. A nil config disables it.
+func (sigstore *Sigstoreimpl) SetSignaturePolicy(config *SignaturePolicyConfig) error {
+	if config == nil {
+		sigstore.signaturePolicy = nil
+		return nil
+	}
+	signaturePolicy, err := newSignaturePolicy(config, sigstore.logger)
+	if err != nil {
+		return err
+	}
+	sigstore.signaturePolicy = signaturePolicy
+	return nil
+}
+
+// SetVerifiers replaces the configured image verifiers. Images that do not
+// match any verifier image pattern are verified with cosign keyless.
+func (sigstore *Sigstoreimpl) SetVerifiers(configs map[string]*VerifierConfig) error {
+	imagePatterns, err := newImagePatterns(configs, sigstore.verifierOptions())
+	if err != nil {
+		return err
+	}
+	sigstore.imagePatterns = imagePatterns
+	return nil
+}
+
+// verifierOptions returns the settings shared with the configured verifiers
+func (sigstore *Sigstoreimpl) verifierOptions() verifierOptions {
+	return verifierOptions{
+		logger:          sigstore.logger,
+		transparencyLog: sigstore.transparencyLog,
+	}
+}
+
+func parseRekorURL(rekorURL string) (*url.URL, error) {
 	if rekorURL == "" {
-		return errors.New("Rekor URL is empty")
+		return nil, errors.New("Rekor URL is empty")
 	}
 	rekorURI, err := url.Parse(rekorURL)
 	if err != nil {
 		message := fmt.Sprint("Error parsing rekor URI: ", err.Error())
-		return errors.New(message)
+		return nil, errors.New(message)
 	}
 	if rekorURI.Scheme != "" && rekorURI.Scheme != "https" {
-		return errors.New("Invalid rekor URL Scheme: " + rekorURI.Scheme)
+		return nil, errors.New("Invalid rekor URL Scheme: " + rekorURI.Scheme)
 	}
 	if rekorURI.Host == "" {
-		return errors.New("Invalid rekor URL Host: " + rekorURI.Host)
+		return nil, errors.New("Invalid rekor URL Host: " + rekorURI.Host)
 	}
-	sigstore.rekorURL = *rekorURI
-	return nil
+	return rekorURI, nil
+}
+
+// sameRekorURL reports whether both URLs refer to the same rekor server
+func sameRekorURL(a, b string) bool {
+	urlA, errA := url.Parse(a)
+	urlB, errB := url.Parse(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return urlA.Host == urlB.Host && strings.TrimSuffix(urlA.Path, "/") == strings.TrimSuffix(urlB.Path, "/")
 }
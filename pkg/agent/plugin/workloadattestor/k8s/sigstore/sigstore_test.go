@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
@@ -348,11 +349,14 @@ func TestSigstoreimpl_ExtractSelectorsFromSignatures(t *testing.T) {
 			containerID: "000000",
 			want: []SelectorsFromSignatures{
 				{
-					Subject:        "spirex@example.com",
-					Content:        "MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5+MjxJw9Oo0x2eM+AIgdgBP96BO1Te/NdbjHbUeb0BUye6deRgVtQEv5No5smA=",
-					LogID:          "samplelogID",
-					IntegratedTime: "12345",
-					Verified:       true,
+					Subject:         "spirex@example.com",
+					DockerReference: "docker-registry.com/some/image",
+					PredicateType:   "some type",
+					Content:         "MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5+MjxJw9Oo0x2eM+AIgdgBP96BO1Te/NdbjHbUeb0BUye6deRgVtQEv5No5smA=",
+					LogID:           "samplelogID",
+					RekorUUID:       "eb48155e4b08e1d0c69fdf91bb803b735e0c38c64bdcd1c08ea1b9774ec7b44d",
+					IntegratedTime:  "12345",
+					Verified:        true,
 				},
 			},
 		},
@@ -388,18 +392,26 @@ func TestSigstoreimpl_ExtractSelectorsFromSignatures(t *testing.T) {
 			containerID: "111111",
 			want: []SelectorsFromSignatures{
 				{
-					Subject:        "spirex1@example.com",
-					Content:        "MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5+MjxJw9Oo0x2eM+AIgdgBP96BO1Te/NdbjHbUeb0BUye6deRgVtQEv5No5smA=",
-					LogID:          "samplelogID1",
-					IntegratedTime: "12345",
-					Verified:       true,
+					Subject:         "spirex1@example.com",
+					DockerReference: "docker-registry.com/some/image",
+					PredicateType:   "some type",
+					Annotations:     map[string]string{"key2": "value 2", "key3": "value 3"},
+					Content:         "MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5+MjxJw9Oo0x2eM+AIgdgBP96BO1Te/NdbjHbUeb0BUye6deRgVtQEv5No5smA=",
+					LogID:           "samplelogID1",
+					RekorUUID:       "eb48155e4b08e1d0c69fdf91bb803b735e0c38c64bdcd1c08ea1b9774ec7b44d",
+					IntegratedTime:  "12345",
+					Verified:        true,
 				},
 				{
-					Subject:        "spirex2@example.com",
-					Content:        "MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5+MjxJw9Oo0x2eM+AIgdgBP96BO1Te/NdbjHbUeb0BUye6deRgVtQEv5No5smB=",
-					LogID:          "samplelogID2",
-					IntegratedTime: "12346",
-					Verified:       true,
+					Subject:         "spirex2@example.com",
+					DockerReference: "docker-registry.com/some/image",
+					PredicateType:   "some type",
+					Annotations:     map[string]string{"key2": "value 2", "key3": "value 3"},
+					Content:         "MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5+MjxJw9Oo0x2eM+AIgdgBP96BO1Te/NdbjHbUeb0BUye6deRgVtQEv5No5smB=",
+					LogID:           "samplelogID2",
+					RekorUUID:       "e632b034127a0164d208a78edd850ff9dff1e38e6b4d33cdb25590f24651e9a8",
+					IntegratedTime:  "12346",
+					Verified:        true,
 				},
 			},
 		},
@@ -446,11 +458,14 @@ func TestSigstoreimpl_ExtractSelectorsFromSignatures(t *testing.T) {
 			containerID: "333333",
 			want: []SelectorsFromSignatures{
 				{
-					Subject:        "spirex@example.com",
-					Content:        "MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5+MjxJw9Oo0x2eM+AIgdgBP96BO1Te/NdbjHbUeb0BUye6deRgVtQEv5No5smA=",
-					LogID:          "samplelogID",
-					IntegratedTime: "12345",
-					Verified:       true,
+					Subject:         "spirex@example.com",
+					DockerReference: "some reference",
+					PredicateType:   "some type",
+					Content:         "MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5+MjxJw9Oo0x2eM+AIgdgBP96BO1Te/NdbjHbUeb0BUye6deRgVtQEv5No5smA=",
+					LogID:           "samplelogID",
+					RekorUUID:       "eb48155e4b08e1d0c69fdf91bb803b735e0c38c64bdcd1c08ea1b9774ec7b44d",
+					IntegratedTime:  "12345",
+					Verified:        true,
 				},
 			},
 		},
@@ -490,11 +505,14 @@ func TestSigstoreimpl_ExtractSelectorsFromSignatures(t *testing.T) {
 			containerID: "444444",
 			want: []SelectorsFromSignatures{
 				{
-					Subject:        "https://www.example.com/somepath1",
-					Content:        "MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5+MjxJw9Oo0x2eM+AIgdgBP96BO1Te/NdbjHbUeb0BUye6deRgVtQEv5No5smA=",
-					LogID:          "samplelogID",
-					IntegratedTime: "12345",
-					Verified:       true,
+					Subject:         "https://www.example.com/somepath1",
+					DockerReference: "some reference",
+					PredicateType:   "some type",
+					Content:         "MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5+MjxJw9Oo0x2eM+AIgdgBP96BO1Te/NdbjHbUeb0BUye6deRgVtQEv5No5smA=",
+					LogID:           "samplelogID",
+					RekorUUID:       "eb48155e4b08e1d0c69fdf91bb803b735e0c38c64bdcd1c08ea1b9774ec7b44d",
+					IntegratedTime:  "12345",
+					Verified:        true,
 				},
 			},
 		},
@@ -1062,6 +1080,40 @@ func TestSigstoreimpl_ValidateImage(t *testing.T) {
 			want:    true,
 			wantErr: false,
 		},
+		{
+			name: "validate tagged image",
+			fields: fields{
+				verifyFunction: nil,
+				fetchImageManifestFunction: func(ref name.Reference, options ...remote.Option) (*remote.Descriptor, error) {
+					return &remote.Descriptor{
+						Manifest: []byte(`sometext`),
+					}, nil
+				},
+				skippedImages: nil,
+			},
+			args: args{
+				ref: func(t name.Tag, err error) name.Tag { return t }(name.NewTag("example.com/sampleimage:latest")),
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "digest does not match image manifest",
+			fields: fields{
+				verifyFunction: nil,
+				fetchImageManifestFunction: func(ref name.Reference, options ...remote.Option) (*remote.Descriptor, error) {
+					return &remote.Descriptor{
+						Manifest: []byte(`othertext`),
+					}, nil
+				},
+				skippedImages: nil,
+			},
+			args: args{
+				ref: func(d name.Digest, err error) name.Digest { return d }(name.NewDigest("example.com/sampleimage@sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505")),
+			},
+			want:    false,
+			wantErr: true,
+		},
 		{
 			name: "error on image manifest fetch",
 			fields: fields{
@@ -1327,11 +1379,15 @@ func TestSigstoreimpl_SelectorValuesFromSignature(t *testing.T) {
 			},
 			containerID: "000000",
 			want: SelectorsFromSignatures{
-				Subject:        "spirex@example.com",
-				Content:        "MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5+MjxJw9Oo0x2eM+AIgdgBP96BO1Te/NdbjHbUeb0BUye6deRgVtQEv5No5smA=",
-				LogID:          "samplelogID",
-				IntegratedTime: "12345",
-				Verified:       true,
+				Subject:         "spirex@example.com",
+				DockerReference: "docker-registry.com/some/image",
+				PredicateType:   "some type",
+				Annotations:     map[string]string{"key2": "value 2", "key3": "value 3"},
+				Content:         "MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5+MjxJw9Oo0x2eM+AIgdgBP96BO1Te/NdbjHbUeb0BUye6deRgVtQEv5No5smA=",
+				LogID:           "samplelogID",
+				RekorUUID:       "eb48155e4b08e1d0c69fdf91bb803b735e0c38c64bdcd1c08ea1b9774ec7b44d",
+				IntegratedTime:  "12345",
+				Verified:        true,
 			},
 		},
 		{
@@ -1394,11 +1450,15 @@ func TestSigstoreimpl_SelectorValuesFromSignature(t *testing.T) {
 			containerID: "333333",
 			want: SelectorsFromSignatures{
 
-				Subject:        "spirex@example.com",
-				Content:        "MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5+MjxJw9Oo0x2eM+AIgdgBP96BO1Te/NdbjHbUeb0BUye6deRgVtQEv5No5smA=",
-				LogID:          "samplelogID",
-				IntegratedTime: "12345",
-				Verified:       true,
+				Subject:         "spirex@example.com",
+				DockerReference: "docker-registry.com/some/image",
+				PredicateType:   "some type",
+				Annotations:     map[string]string{"key2": "value 2", "key3": "value 3"},
+				Content:         "MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5+MjxJw9Oo0x2eM+AIgdgBP96BO1Te/NdbjHbUeb0BUye6deRgVtQEv5No5smA=",
+				LogID:           "samplelogID",
+				RekorUUID:       "eb48155e4b08e1d0c69fdf91bb803b735e0c38c64bdcd1c08ea1b9774ec7b44d",
+				IntegratedTime:  "12345",
+				Verified:        true,
 			},
 		},
 		{
@@ -1423,10 +1483,14 @@ func TestSigstoreimpl_SelectorValuesFromSignature(t *testing.T) {
 			},
 			containerID: "444444",
 			want: SelectorsFromSignatures{
-				Subject:        "spirex@example.com",
-				LogID:          "samplelogID",
-				IntegratedTime: "12345",
-				Verified:       true},
+				Subject:         "spirex@example.com",
+				DockerReference: "docker-registry.com/some/image",
+				PredicateType:   "some type",
+				Annotations:     map[string]string{"key2": "value 2", "key3": "value 3"},
+				LogID:           "samplelogID",
+				RekorUUID:       "eceaabac7453841fe6afbbf97f431277ac85f0c61c1094c1e691968e8e64d5e0",
+				IntegratedTime:  "12345",
+				Verified:        true},
 		},
 
 		{
@@ -1443,8 +1507,11 @@ func TestSigstoreimpl_SelectorValuesFromSignature(t *testing.T) {
 			containerID: "555555",
 			want: SelectorsFromSignatures{
 
-				Subject:  "spirex@example.com",
-				Verified: true,
+				Subject:         "spirex@example.com",
+				DockerReference: "docker-registry.com/some/image",
+				PredicateType:   "some type",
+				Annotations:     map[string]string{"key2": "value 2", "key3": "value 3"},
+				Verified:        true,
 			},
 		},
 	}
@@ -1575,6 +1642,7 @@ func TestSigstoreimpl_AttestContainerSignatures(t *testing.T) {
 		fetchImageManifestFunction func(ref name.Reference, options ...remote.Option) (*remote.Descriptor, error)
 		skippedImages              map[string]bool
 		rekorURL                   url.URL
+		signaturePolicy            *signaturePolicy
 	}
 
 	tests := []struct {
@@ -1613,7 +1681,7 @@ func TestSigstoreimpl_AttestContainerSignatures(t *testing.T) {
 				ContainerID: "000000",
 			},
 			want: []string{
-				"000000:image-signature-subject:spirex@example.com", "000000:image-signature-content:MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5+MjxJw9Oo0x2eM+AIgdgBP96BO1Te/NdbjHbUeb0BUye6deRgVtQEv5No5smA=", "000000:image-signature-logid:samplelogID", "000000:image-signature-integrated-time:12345", "sigstore-validation:passed",
+				"000000:image-signature-subject:spirex@example.com", "000000:image-signature-content:MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5+MjxJw9Oo0x2eM+AIgdgBP96BO1Te/NdbjHbUeb0BUye6deRgVtQEv5No5smA=", "000000:image-signature-logid:samplelogID", "000000:image-signature-integrated-time:12345", "000000:image-signature-docker-reference:docker-registry.com/some/image", "000000:image-signature-annotation:key2:value 2", "000000:image-signature-annotation:key3:value 3", "sigstore-validation:passed",
 			},
 			wantErr: false,
 		},
@@ -1642,6 +1710,91 @@ func TestSigstoreimpl_AttestContainerSignatures(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Attest image with runtime prefixed image ID",
+			fields: fields{
+				verifyFunction: func(context context.Context, ref name.Reference, co *cosign.CheckOpts) ([]oci.Signature, bool, error) {
+					if ref.String() != "docker-registry.com/some/image@sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505" {
+						return nil, false, fmt.Errorf("unexpected reference %s", ref.String())
+					}
+					return []oci.Signature{
+						signature{
+							payload: []byte(`{"critical": {"identity": {"docker-reference": "docker-registry.com/some/image"},"image": {"docker-manifest-digest": "02c15a8d1735c65bb8ca86c716615d3c0d8beb87dc68ed88bb49192f90b184e2"},"type": "some type"},"optional": {"subject": "spirex@example.com","key2": "value 2","key3": "value 3"}}`),
+							bundle: &oci.Bundle{
+								Payload: oci.BundlePayload{
+									LogID:          "samplelogID",
+									IntegratedTime: 12345,
+								},
+							},
+						},
+					}, true, nil
+				},
+				fetchImageManifestFunction: func(ref name.Reference, options ...remote.Option) (*remote.Descriptor, error) {
+					return &remote.Descriptor{
+						Manifest: []byte("sometext"),
+					}, nil
+				},
+			},
+			status: corev1.ContainerStatus{
+				Image:       "docker-registry.com/some/image@sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+				ImageID:     "docker://sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+				ContainerID: "333333",
+			},
+			want: []string{
+				"333333:image-signature-subject:spirex@example.com", "333333:image-signature-logid:samplelogID", "333333:image-signature-integrated-time:12345", "333333:image-signature-docker-reference:docker-registry.com/some/image", "333333:image-signature-annotation:key2:value 2", "333333:image-signature-annotation:key3:value 3", "sigstore-validation:passed",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Attest image with signature rejected by policy",
+			fields: fields{
+				verifyFunction: func(context context.Context, ref name.Reference, co *cosign.CheckOpts) ([]oci.Signature, bool, error) {
+					return []oci.Signature{
+						signature{
+							payload: []byte(`{"critical": {"identity": {"docker-reference": "docker-registry.com/some/image"},"image": {"docker-manifest-digest": "02c15a8d1735c65bb8ca86c716615d3c0d8beb87dc68ed88bb49192f90b184e2"},"type": "some type"},"optional": {"subject": "spirex@example.com"}}`),
+							bundle: &oci.Bundle{
+								Payload: oci.BundlePayload{
+									LogID:          "samplelogID",
+									IntegratedTime: 12345,
+								},
+							},
+						},
+					}, true, nil
+				},
+				fetchImageManifestFunction: func(ref name.Reference, options ...remote.Option) (*remote.Descriptor, error) {
+					return &remote.Descriptor{
+						Manifest: []byte("sometext"),
+					}, nil
+				},
+				signaturePolicy: &signaturePolicy{
+					signedAfter: time.Unix(20000, 0),
+					now:         time.Now,
+					logger:      hclog.NewNullLogger(),
+				},
+			},
+			status: corev1.ContainerStatus{
+				Image:       "spire-agent-sigstore-5",
+				ImageID:     "docker-registry.com/some/image@sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+				ContainerID: "555555",
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "Attest image with unresolvable image ID",
+			fields: fields{
+				verifyFunction: func(context context.Context, ref name.Reference, co *cosign.CheckOpts) ([]oci.Signature, bool, error) {
+					return nil, true, nil
+				},
+			},
+			status: corev1.ContainerStatus{
+				Image:       "sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+				ImageID:     "sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+				ContainerID: "444444",
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "Attest image with no signature",
 			fields: fields{
@@ -1671,6 +1824,7 @@ func TestSigstoreimpl_AttestContainerSignatures(t *testing.T) {
 				fetchImageManifestFunction: tt.fields.fetchImageManifestFunction,
 				skippedImages:              tt.fields.skippedImages,
 				rekorURL:                   tt.fields.rekorURL,
+				signaturePolicy:            tt.fields.signaturePolicy,
 				sigstorecache:              NewCache(maximumAmountCache),
 				logger:                     hclog.Default(),
 			}
@@ -1686,6 +1840,410 @@ func TestSigstoreimpl_AttestContainerSignatures(t *testing.T) {
 	}
 }
 
+func TestSigstoreimpl_AttestArtifactSignatures(t *testing.T) {
+	subjectSignature := func(subject string) oci.Signature {
+		return signature{
+			payload: []byte(`{"critical": {"identity": {"docker-reference": "docker-registry.com/some/module"},"image": {"docker-manifest-digest": "` + testDigest + `"},"type": "some type"},"optional": {"subject": "` + subject + `"}}`),
+		}
+	}
+
+	tests := []struct {
+		name           string
+		artifact       string
+		verifyFunction func(context context.Context, ref name.Reference, co *cosign.CheckOpts) ([]oci.Signature, bool, error)
+		want           []string
+		wantErr        bool
+	}{
+		{
+			name:     "Attest artifact with signatures",
+			artifact: "docker-registry.com/some/module@" + testDigest,
+			verifyFunction: func(context context.Context, ref name.Reference, co *cosign.CheckOpts) ([]oci.Signature, bool, error) {
+				return []oci.Signature{
+					subjectSignature("spirex@example.com"),
+					subjectSignature("build@example.com"),
+					subjectSignature("spirex@example.com"),
+				}, true, nil
+			},
+			want: []string{"build@example.com", "spirex@example.com"},
+		},
+		{
+			name:     "Attest artifact referenced by tag",
+			artifact: "docker-registry.com/some/module:v1",
+			verifyFunction: func(context context.Context, ref name.Reference, co *cosign.CheckOpts) ([]oci.Signature, bool, error) {
+				return []oci.Signature{subjectSignature("spirex@example.com")}, true, nil
+			},
+			wantErr: true,
+		},
+		{
+			name:     "Attest artifact with no signature",
+			artifact: "docker-registry.com/some/module@" + testDigest,
+			verifyFunction: func(context context.Context, ref name.Reference, co *cosign.CheckOpts) ([]oci.Signature, bool, error) {
+				return nil, true, fmt.Errorf("no signature found")
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sigstore := &Sigstoreimpl{
+				verifyFunction: tt.verifyFunction,
+				fetchImageManifestFunction: func(ref name.Reference, options ...remote.Option) (*remote.Descriptor, error) {
+					return &remote.Descriptor{
+						Manifest: []byte("sometext"),
+					}, nil
+				},
+				sigstorecache: NewCache(maximumAmountCache),
+				logger:        hclog.Default(),
+			}
+			got, err := sigstore.AttestArtifactSignatures(tt.artifact)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Sigstoreimpl.AttestArtifactSignatures() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Sigstoreimpl.AttestArtifactSignatures() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSigstoreimpl_resolveDigest(t *testing.T) {
+	sigstore := &Sigstoreimpl{
+		fetchImageManifestFunction: func(ref name.Reference, options ...remote.Option) (*remote.Descriptor, error) {
+			return &remote.Descriptor{
+				Manifest: []byte(`sometext`),
+			}, nil
+		},
+	}
+
+	ref, err := name.ParseReference("example.com/sampleimage:latest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := sigstore.resolveDigest(ref)
+	if err != nil {
+		t.Fatalf("Sigstoreimpl.resolveDigest() error = %v", err)
+	}
+	want := "example.com/sampleimage@sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505"
+	if got.String() != want {
+		t.Errorf("Sigstoreimpl.resolveDigest() = %v, want %v", got.String(), want)
+	}
+}
+
+func TestImageReference(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  corev1.ContainerStatus
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "dockershim pullable image ID",
+			status: corev1.ContainerStatus{
+				Image:   "localhost/spiffe/blog:latest",
+				ImageID: "docker-pullable://localhost/spiffe/blog@sha256:0cfdaced91cb46dd7af48309799a3c351e4ca2d5e1ee9737ca0cbd932cb79898",
+			},
+			want: "localhost/spiffe/blog@sha256:0cfdaced91cb46dd7af48309799a3c351e4ca2d5e1ee9737ca0cbd932cb79898",
+		},
+		{
+			name: "dockershim image ID without repository",
+			status: corev1.ContainerStatus{
+				Image:   "docker-registry.com/some/image@sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+				ImageID: "docker://sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+			},
+			want: "docker-registry.com/some/image@sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+		},
+		{
+			name: "containerd image ID",
+			status: corev1.ContainerStatus{
+				Image:   "gcr.io/spiffe-io/spire-agent:0.8.1",
+				ImageID: "gcr.io/spiffe-io/spire-agent@sha256:1e4c481d76e9ecbd3d8684891e0e46aa021a30920ca04936e1fdcc552747d941",
+			},
+			want: "gcr.io/spiffe-io/spire-agent@sha256:1e4c481d76e9ecbd3d8684891e0e46aa021a30920ca04936e1fdcc552747d941",
+		},
+		{
+			name: "containerd image ID without repository",
+			status: corev1.ContainerStatus{
+				Image:   "docker.io/library/nginx@sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+				ImageID: "sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+			},
+			want: "index.docker.io/library/nginx@sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+		},
+		{
+			name: "image ID without repository and tagged image",
+			status: corev1.ContainerStatus{
+				Image:   "docker.io/library/nginx:latest",
+				ImageID: "sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+			},
+			want: "index.docker.io/library/nginx@sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+		},
+		{
+			name: "dockershim image ID without repository and tagged image",
+			status: corev1.ContainerStatus{
+				Image:   "quay.io/coreos/flannel:v0.9.0-amd64",
+				ImageID: "docker://sha256:1b401bf0c30bada9a539389c3be652b58fe38463361edf488e6543c8761d4970",
+			},
+			want: "quay.io/coreos/flannel@sha256:1b401bf0c30bada9a539389c3be652b58fe38463361edf488e6543c8761d4970",
+		},
+		{
+			name: "image ID without repository and image pinned by another digest",
+			status: corev1.ContainerStatus{
+				Image:   "docker.io/library/nginx@sha256:1b401bf0c30bada9a539389c3be652b58fe38463361edf488e6543c8761d4970",
+				ImageID: "sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+			},
+			wantErr: true,
+		},
+		{
+			name: "cri-o image ID",
+			status: corev1.ContainerStatus{
+				Image:   "quay.io/coreos/flannel:v0.9.0-amd64",
+				ImageID: "quay.io/coreos/flannel@sha256:1b401bf0c30bada9a539389c3be652b58fe38463361edf488e6543c8761d4970",
+			},
+			want: "quay.io/coreos/flannel@sha256:1b401bf0c30bada9a539389c3be652b58fe38463361edf488e6543c8761d4970",
+		},
+		{
+			name: "image ID without repository and image pinned by digest",
+			status: corev1.ContainerStatus{
+				Image:   "quay.io/coreos/flannel@sha256:1b401bf0c30bada9a539389c3be652b58fe38463361edf488e6543c8761d4970",
+				ImageID: "sha256:1b401bf0c30bada9a539389c3be652b58fe38463361edf488e6543c8761d4970",
+			},
+			want: "quay.io/coreos/flannel@sha256:1b401bf0c30bada9a539389c3be652b58fe38463361edf488e6543c8761d4970",
+		},
+		{
+			name: "image ID without repository and image without repository",
+			status: corev1.ContainerStatus{
+				Image:   "sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+				ImageID: "sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505",
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty image ID",
+			status: corev1.ContainerStatus{
+				Image: "docker.io/library/nginx:latest",
+			},
+			wantErr: true,
+		},
+		{
+			name: "tagged image ID",
+			status: corev1.ContainerStatus{
+				Image:   "docker.io/library/nginx:latest",
+				ImageID: "docker.io/library/nginx:latest",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ImageReference(&tt.status)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ImageReference() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("ImageReference() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func Test_signedImageClaimVerifier(t *testing.T) {
+	digest := "sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505"
+	tests := []struct {
+		name    string
+		image   string
+		payload string
+		wantErr bool
+	}{
+		{
+			name:    "matching repository and digest",
+			image:   "docker-registry.com/some/image@" + digest,
+			payload: `{"critical": {"identity": {"docker-reference": "docker-registry.com/some/image"},"image": {"docker-manifest-digest": "` + digest + `"},"type": "cosign container image signature"}}`,
+		},
+		{
+			name:    "docker hub repository is normalized",
+			image:   "index.docker.io/library/nginx@" + digest,
+			payload: `{"critical": {"identity": {"docker-reference": "nginx"},"image": {"docker-manifest-digest": "` + digest + `"},"type": "cosign container image signature"}}`,
+		},
+		{
+			name:    "signed reference with tag",
+			image:   "docker-registry.com/some/image@" + digest,
+			payload: `{"critical": {"identity": {"docker-reference": "docker-registry.com/some/image:v1"},"image": {"docker-manifest-digest": "` + digest + `"},"type": "cosign container image signature"}}`,
+		},
+		{
+			name:    "signature reused from another repository",
+			image:   "docker-registry.com/some/image@" + digest,
+			payload: `{"critical": {"identity": {"docker-reference": "docker-registry.com/other/image"},"image": {"docker-manifest-digest": "` + digest + `"},"type": "cosign container image signature"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "signature for another digest",
+			image:   "docker-registry.com/some/image@" + digest,
+			payload: `{"critical": {"identity": {"docker-reference": "docker-registry.com/some/image"},"image": {"docker-manifest-digest": "sha256:02c15a8d1735c65bb8ca86c716615d3c0d8beb87dc68ed88bb49192f90b184e2"},"type": "cosign container image signature"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid docker-reference",
+			image:   "docker-registry.com/some/image@" + digest,
+			payload: `{"critical": {"identity": {"docker-reference": "Invalid Reference"},"image": {"docker-manifest-digest": "` + digest + `"},"type": "cosign container image signature"}}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, err := name.NewDigest(tt.image)
+			if err != nil {
+				t.Fatal(err)
+			}
+			hash, err := v1.NewHash(digest)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = signedImageClaimVerifier(ref)(signature{payload: []byte(tt.payload)}, hash, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("signedImageClaimVerifier() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_selectorsToString(t *testing.T) {
+	allSelectors := SelectorsFromSignatures{
+		Subject:         "spirex@example.com",
+		Issuer:          "https://accounts.example.com",
+		KeyID:           "0a1b2c",
+		Digest:          testDigest,
+		PredicateType:   "cosign container image signature",
+		Content:         "MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5",
+		LogID:           "samplelogID",
+		IntegratedTime:  "12345",
+		DockerReference: "docker-registry.com/some/image",
+		Annotations:     map[string]string{"env": "prod"},
+	}
+
+	tests := []struct {
+		name        string
+		selectors   SelectorsFromSignatures
+		containerID string
+		kinds       selectorKinds
+		want        []string
+	}{
+		{
+			name:        "default kinds",
+			selectors:   allSelectors,
+			containerID: "000000",
+			want: []string{
+				"000000:image-signature-subject:spirex@example.com",
+				"000000:image-signature-content:MEUCIQCyem8Gcr0sPFMP7fTXazCN57NcN5",
+				"000000:image-signature-logid:samplelogID",
+				"000000:image-signature-integrated-time:12345",
+				"000000:image-signature-docker-reference:docker-registry.com/some/image",
+				"000000:image-signature-annotation:env:prod",
+			},
+		},
+		{
+			name:        "configured kinds",
+			selectors:   allSelectors,
+			containerID: "000000",
+			kinds: selectorKinds{
+				SubjectSelectorKind:       true,
+				IssuerSelectorKind:        true,
+				KeySelectorKind:           true,
+				DigestSelectorKind:        true,
+				PredicateTypeSelectorKind: true,
+				ContentHashSelectorKind:   true,
+			},
+			want: []string{
+				"000000:image-signature-subject:spirex@example.com",
+				"000000:image-signature-issuer:https://accounts.example.com",
+				"000000:image-signature-key-id:0a1b2c",
+				"000000:image-signature-digest:" + testDigest,
+				"000000:image-signature-predicate-type:cosign container image signature",
+				"000000:image-signature-content-hash:sha256:6389c3ce385d9c57ebc1aff2fb6d9e5eefc84ac83fef8c6b5af748dc68f319f8",
+			},
+		},
+		{
+			name: "payload selectors",
+			selectors: SelectorsFromSignatures{
+				Subject:         "spirex@example.com",
+				DockerReference: "docker-registry.com/some/image",
+				Annotations:     map[string]string{"env": "prod", "team": "payments"},
+			},
+			containerID: "000000",
+			want: []string{
+				"000000:image-signature-subject:spirex@example.com",
+				"000000:image-signature-docker-reference:docker-registry.com/some/image",
+				"000000:image-signature-annotation:env:prod",
+				"000000:image-signature-annotation:team:payments",
+			},
+		},
+		{
+			name: "verifier prefix",
+			selectors: SelectorsFromSignatures{
+				Prefix:          cosignKeySelectorPrefix,
+				Subject:         "prod-key",
+				DockerReference: "docker-registry.com/some/image",
+			},
+			containerID: "111111",
+			want: []string{
+				"111111:cosign-key-signature-subject:prod-key",
+				"111111:cosign-key-signature-docker-reference:docker-registry.com/some/image",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := selectorsToString(tt.selectors, tt.containerID, tt.kinds); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("selectorsToString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSigstoreimpl_SetSelectorKinds(t *testing.T) {
+	tests := []struct {
+		name    string
+		kinds   []string
+		want    selectorKinds
+		wantErr bool
+	}{
+		{
+			name: "no kinds restores the defaults",
+		},
+		{
+			name:  "configured kinds",
+			kinds: []string{SubjectSelectorKind, ContentHashSelectorKind},
+			want: selectorKinds{
+				SubjectSelectorKind:     true,
+				ContentHashSelectorKind: true,
+			},
+		},
+		{
+			name:    "unknown kind",
+			kinds:   []string{SubjectSelectorKind, "signature"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sigstore := &Sigstoreimpl{
+				selectorKinds: selectorKinds{ContentSelectorKind: true},
+			}
+			err := sigstore.SetSelectorKinds(tt.kinds)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Sigstoreimpl.SetSelectorKinds() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(sigstore.selectorKinds, tt.want) {
+				t.Errorf("Sigstoreimpl.SetSelectorKinds() = %v, want %v", sigstore.selectorKinds, tt.want)
+			}
+		})
+	}
+}
+
 func TestSigstoreimpl_SetRekorURL(t *testing.T) {
 	type fields struct {
 		rekorURL url.URL
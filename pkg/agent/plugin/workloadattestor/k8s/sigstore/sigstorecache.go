@@ -9,12 +9,15 @@ import (
 type Item struct {
 	Key   string
 	Value []SelectorsFromSignatures
+	// Result is the verification result the selectors come from
+	Result *VerificationResult
 }
 
 // Cache defines the behaviors of our cache
 type Cache interface {
 	GetSignature(key string) *Item
 	PutSignature(Item)
+	ListSignatures() []Item
 }
 
 //
@@ -83,3 +86,15 @@ func (c *Cacheimpl) PutSignature(i Item) {
 		}
 	}
 }
+
+// ListSignatures returns the cached items, most recently used first.
+func (c *Cacheimpl) ListSignatures() []Item {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	items := make([]Item, 0, c.items.Len())
+	for e := c.items.Front(); e != nil; e = e.Next() {
+		items = append(items, *c.itensMap[e.Value.(string)].item)
+	}
+	return items
+}
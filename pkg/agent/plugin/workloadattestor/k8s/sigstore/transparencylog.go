@@ -0,0 +1,427 @@
+package sigstore
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/google/trillian/merkle/logverifier"
+	"github.com/google/trillian/merkle/rfc6962"
+	"github.com/hashicorp/go-hclog"
+	"github.com/sigstore/cosign/pkg/oci"
+	rekorclient "github.com/sigstore/rekor/pkg/client"
+	rekor "github.com/sigstore/rekor/pkg/generated/client"
+	"github.com/sigstore/rekor/pkg/generated/client/entries"
+	"github.com/sigstore/rekor/pkg/generated/client/pubkey"
+	"github.com/sigstore/rekor/pkg/generated/client/tlog"
+	"github.com/sigstore/rekor/pkg/util"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	sigstoresignature "github.com/sigstore/sigstore/pkg/signature"
+	"github.com/spiffe/spire/pkg/common/diskutil"
+)
+
+// TransparencyLogConfig configures the verification of Rekor inclusion proofs
+type TransparencyLogConfig struct {
+	// CheckpointPath is the file where the last verified checkpoint of the
+	// log is persisted.
+	CheckpointPath string
+
+	// PublicKeyPath is the path to the PEM encoded public key that signs
+	// the tree heads. The key is fetched from the Rekor server if empty.
+	PublicKeyPath string
+
+	// OnInconsistency, if set, is invoked when the log is found to be
+	// inconsistent with a previously verified checkpoint.
+	OnInconsistency func(err error)
+}
+
+// ErrInconsistentLog is returned when the transparency log presents a view
+// that is not consistent with a previously verified checkpoint.
+var ErrInconsistentLog = errors.New("Transparency log is inconsistent")
+
+// checkpoint is the last verified signed tree head of a log, as persisted on
+// disk.
+type checkpoint struct {
+	RekorURL       string `json:"rekor_url"`
+	TreeSize       int64  `json:"tree_size"`
+	RootHash       string `json:"root_hash"`
+	SignedTreeHead string `json:"signed_tree_head"`
+}
+
+// transparencyLog verifies that signature bundles are included in the Rekor
+// log and that the log stays consistent with the last checkpoint seen by the
+// agent, so that a log presenting a split view is detected.
+type transparencyLog struct {
+	rekorURL        string
+	client          *rekor.Rekor
+	publicKey       sigstoresignature.Verifier
+	checkpointPath  string
+	onInconsistency func(err error)
+	logVerifier     logverifier.LogVerifier
+	logger          hclog.Logger
+
+	// mu guards the checkpoint and the public key fetched from the server.
+	// It is never held during network calls.
+	mu         sync.Mutex
+	checkpoint *checkpoint
+}
+
+func newTransparencyLog(rekorURL string, config *TransparencyLogConfig, logger hclog.Logger) (*transparencyLog, error) {
+	if config.CheckpointPath == "" {
+		return nil, errors.New("Transparency log verification requires a checkpoint path")
+	}
+	client, err := rekorclient.GetRekorClient(rekorURL)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating rekor client: %w", err)
+	}
+
+	t := &transparencyLog{
+		rekorURL:        rekorURL,
+		client:          client,
+		checkpointPath:  config.CheckpointPath,
+		onInconsistency: config.OnInconsistency,
+		logVerifier:     logverifier.New(rfc6962.DefaultHasher),
+		logger:          logger,
+	}
+
+	if config.PublicKeyPath != "" {
+		keyPEM, err := os.ReadFile(config.PublicKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to load rekor public key: %w", err)
+		}
+		if t.publicKey, err = loadRekorPublicKey(keyPEM); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := t.loadCheckpoint(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func loadRekorPublicKey(keyPEM []byte) (sigstoresignature.Verifier, error) {
+	publicKey, err := cryptoutils.UnmarshalPEMToPublicKey(keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse rekor public key: %w", err)
+	}
+	verifier, err := sigstoresignature.LoadVerifier(publicKey, crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load rekor public key: %w", err)
+	}
+	return verifier, nil
+}
+
+// loadCheckpoint reads the persisted checkpoint. Checkpoints of another log
+// are discarded.
+func (t *transparencyLog) loadCheckpoint() error {
+	data, err := os.ReadFile(t.checkpointPath)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil
+	case err != nil:
+		return fmt.Errorf("Unable to read rekor checkpoint: %w", err)
+	}
+
+	stored := new(checkpoint)
+	if err := json.Unmarshal(data, stored); err != nil {
+		return fmt.Errorf("Unable to parse rekor checkpoint: %w", err)
+	}
+	if stored.RekorURL != t.rekorURL {
+		t.logger.Warn("Discarding rekor checkpoint of another log", "checkpointRekorURL", stored.RekorURL, "rekorURL", t.rekorURL)
+		return nil
+	}
+	t.checkpoint = stored
+	return nil
+}
+
+// VerifySignatures returns the signatures whose bundle is proven to be
+// included in the log. It fails if none is, or if the log is inconsistent
+// with the last verified checkpoint.
+func (t *transparencyLog) VerifySignatures(ctx context.Context, signatures []oci.Signature) ([]oci.Signature, error) {
+	type includedSignature struct {
+		signature oci.Signature
+		treeSize  int64
+		rootHash  []byte
+	}
+
+	// Inclusion proofs are fetched before the signed tree head, so that the
+	// tree head covers every proof unless the log is misbehaving.
+	var included []includedSignature
+	for _, sig := range signatures {
+		bundle, err := sig.Bundle()
+		if err != nil || bundle == nil {
+			t.logger.Debug("Skipping signature without bundle")
+			continue
+		}
+		treeSize, rootHash, err := t.verifyInclusion(ctx, bundle)
+		if err != nil {
+			t.logger.Warn("Unable to verify signature inclusion in the transparency log", "logIndex", bundle.Payload.LogIndex, "error", err)
+			continue
+		}
+		included = append(included, includedSignature{
+			signature: sig,
+			treeSize:  treeSize,
+			rootHash:  rootHash,
+		})
+	}
+	if len(included) == 0 {
+		return nil, errors.New("No signature is included in the transparency log")
+	}
+
+	head, err := t.signedTreeHead(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.updateCheckpoint(ctx, head); err != nil {
+		return nil, err
+	}
+
+	var verified []oci.Signature
+	for _, sig := range included {
+		if err := t.verifyConsistency(ctx, sig.treeSize, sig.rootHash, head.TreeSize, head.rootHash); err != nil {
+			return nil, t.inconsistent(fmt.Errorf("inclusion proof at tree size %d: %w", sig.treeSize, err))
+		}
+		verified = append(verified, sig.signature)
+	}
+	return verified, nil
+}
+
+// verifyInclusion verifies the inclusion proof of the bundle entry and
+// returns the tree size and root hash the proof was computed for.
+func (t *transparencyLog) verifyInclusion(ctx context.Context, bundle *oci.Bundle) (int64, []byte, error) {
+	body64, ok := bundle.Payload.Body.(string)
+	if !ok {
+		return 0, nil, errors.New("Payload body is not a string")
+	}
+	body, err := base64.StdEncoding.DecodeString(body64)
+	if err != nil {
+		return 0, nil, err
+	}
+	leafHash := rfc6962.DefaultHasher.HashLeaf(body)
+	uuid := hex.EncodeToString(leafHash)
+
+	params := entries.NewGetLogEntryByUUIDParamsWithContext(ctx)
+	params.EntryUUID = uuid
+	resp, err := t.client.Entries.GetLogEntryByUUID(params)
+	if err != nil {
+		return 0, nil, fmt.Errorf("Error fetching log entry %s: %w", uuid, err)
+	}
+	entry, ok := resp.Payload[uuid]
+	if !ok {
+		return 0, nil, fmt.Errorf("Log entry %s not found", uuid)
+	}
+	if entry.Verification == nil || entry.Verification.InclusionProof == nil {
+		return 0, nil, fmt.Errorf("Log entry %s has no inclusion proof", uuid)
+	}
+	proof := entry.Verification.InclusionProof
+	if proof.LogIndex == nil || proof.TreeSize == nil || proof.RootHash == nil {
+		return 0, nil, fmt.Errorf("Log entry %s has an incomplete inclusion proof", uuid)
+	}
+	if *proof.LogIndex != bundle.Payload.LogIndex {
+		return 0, nil, fmt.Errorf("Log entry %s has index %d, bundle has %d", uuid, *proof.LogIndex, bundle.Payload.LogIndex)
+	}
+
+	hashes, err := decodeHashes(proof.Hashes)
+	if err != nil {
+		return 0, nil, err
+	}
+	rootHash, err := hex.DecodeString(*proof.RootHash)
+	if err != nil {
+		return 0, nil, fmt.Errorf("Invalid inclusion proof root hash: %w", err)
+	}
+	if err := t.logVerifier.VerifyInclusionProof(*proof.LogIndex, *proof.TreeSize, hashes, rootHash, leafHash); err != nil {
+		return 0, nil, fmt.Errorf("Error verifying inclusion proof: %w", err)
+	}
+	return *proof.TreeSize, rootHash, nil
+}
+
+type treeHead struct {
+	checkpoint
+	rootHash []byte
+}
+
+// signedTreeHead fetches the current tree head of the log and verifies its
+// signature.
+func (t *transparencyLog) signedTreeHead(ctx context.Context) (*treeHead, error) {
+	publicKey, err := t.rekorPublicKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.client.Tlog.GetLogInfo(tlog.NewGetLogInfoParamsWithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("Error fetching signed tree head: %w", err)
+	}
+	if resp.Payload == nil || resp.Payload.SignedTreeHead == nil {
+		return nil, errors.New("Log info has no signed tree head")
+	}
+
+	signed := new(util.SignedCheckpoint)
+	if err := signed.UnmarshalText([]byte(*resp.Payload.SignedTreeHead)); err != nil {
+		return nil, fmt.Errorf("Error parsing signed tree head: %w", err)
+	}
+	if !signed.Verify(publicKey) {
+		return nil, errors.New("Signed tree head signature verification failed")
+	}
+
+	return &treeHead{
+		checkpoint: checkpoint{
+			RekorURL:       t.rekorURL,
+			TreeSize:       int64(signed.Size),
+			RootHash:       hex.EncodeToString(signed.Hash),
+			SignedTreeHead: *resp.Payload.SignedTreeHead,
+		},
+		rootHash: signed.Hash,
+	}, nil
+}
+
+func (t *transparencyLog) rekorPublicKey(ctx context.Context) (sigstoresignature.Verifier, error) {
+	t.mu.Lock()
+	publicKey := t.publicKey
+	t.mu.Unlock()
+	if publicKey != nil {
+		return publicKey, nil
+	}
+
+	resp, err := t.client.Pubkey.GetPublicKey(pubkey.NewGetPublicKeyParamsWithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("Error fetching rekor public key: %w", err)
+	}
+	publicKey, err = loadRekorPublicKey([]byte(resp.Payload))
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.publicKey == nil {
+		t.publicKey = publicKey
+	}
+	return t.publicKey, nil
+}
+
+// updateCheckpoint checks that the tree head is consistent with the last
+// verified checkpoint and, if it is newer, persists it as the new
+// checkpoint. The consistency proofs are fetched without holding the lock;
+// if the checkpoint moved in the meantime, the tree head is checked again
+// against the new checkpoint.
+func (t *transparencyLog) updateCheckpoint(ctx context.Context, head *treeHead) error {
+	for {
+		t.mu.Lock()
+		current := t.checkpoint
+		t.mu.Unlock()
+
+		if current != nil {
+			upToDate, err := t.verifyCheckpointConsistency(ctx, current, head)
+			if err != nil || upToDate {
+				return err
+			}
+		}
+
+		t.mu.Lock()
+		if t.checkpoint != current {
+			t.mu.Unlock()
+			continue
+		}
+		err := t.storeCheckpoint(head)
+		t.mu.Unlock()
+		return err
+	}
+}
+
+// verifyCheckpointConsistency verifies that the tree head and the checkpoint
+// are views of the same log. A tree head older than the checkpoint, e.g.
+// fetched by a concurrent verification before the checkpoint moved, must be
+// a prefix of the checkpoint tree. It returns true if the checkpoint is not
+// older than the tree head, so it does not need to be updated.
+func (t *transparencyLog) verifyCheckpointConsistency(ctx context.Context, current *checkpoint, head *treeHead) (bool, error) {
+	checkpointRoot, err := hex.DecodeString(current.RootHash)
+	if err != nil {
+		return false, fmt.Errorf("Invalid checkpoint root hash: %w", err)
+	}
+	if head.TreeSize < current.TreeSize {
+		if err := t.verifyConsistency(ctx, head.TreeSize, head.rootHash, current.TreeSize, checkpointRoot); err != nil {
+			return false, t.inconsistent(fmt.Errorf("tree head at tree size %d is not a prefix of the checkpoint at tree size %d: %w", head.TreeSize, current.TreeSize, err))
+		}
+		return true, nil
+	}
+	if err := t.verifyConsistency(ctx, current.TreeSize, checkpointRoot, head.TreeSize, head.rootHash); err != nil {
+		return false, t.inconsistent(fmt.Errorf("checkpoint at tree size %d: %w", current.TreeSize, err))
+	}
+	return head.TreeSize == current.TreeSize, nil
+}
+
+// storeCheckpoint persists the tree head as the new checkpoint. Must be
+// called with the lock held.
+func (t *transparencyLog) storeCheckpoint(head *treeHead) error {
+	data, err := json.Marshal(head.checkpoint)
+	if err != nil {
+		return err
+	}
+	if err := diskutil.AtomicWriteFile(t.checkpointPath, data, 0600); err != nil {
+		return fmt.Errorf("Unable to persist rekor checkpoint: %w", err)
+	}
+	stored := head.checkpoint
+	t.checkpoint = &stored
+	t.logger.Debug("Updated rekor checkpoint", "treeSize", head.TreeSize)
+	return nil
+}
+
+// verifyConsistency verifies that the tree of size1 is a prefix of the tree
+// of size2, where size1 <= size2.
+func (t *transparencyLog) verifyConsistency(ctx context.Context, size1 int64, root1 []byte, size2 int64, root2 []byte) error {
+	switch {
+	case size1 > size2:
+		return fmt.Errorf("tree size %d is newer than the signed tree head of size %d", size1, size2)
+	case size1 == size2:
+		if !bytes.Equal(root1, root2) {
+			return fmt.Errorf("root hash mismatch at tree size %d", size1)
+		}
+		return nil
+	}
+
+	params := tlog.NewGetLogProofParamsWithContext(ctx)
+	params.FirstSize = &size1
+	params.LastSize = size2
+	resp, err := t.client.Tlog.GetLogProof(params)
+	if err != nil {
+		return fmt.Errorf("error fetching consistency proof: %w", err)
+	}
+	if resp.Payload == nil {
+		return errors.New("empty consistency proof")
+	}
+	hashes, err := decodeHashes(resp.Payload.Hashes)
+	if err != nil {
+		return err
+	}
+	return t.logVerifier.VerifyConsistencyProof(size1, size2, root1, root2, hashes)
+}
+
+func (t *transparencyLog) inconsistent(err error) error {
+	err = fmt.Errorf("%w: %v", ErrInconsistentLog, err)
+	t.logger.Error("Rekor transparency log inconsistency detected", "rekorURL", t.rekorURL, "error", err)
+	if t.onInconsistency != nil {
+		t.onInconsistency(err)
+	}
+	return err
+}
+
+func decodeHashes(encoded []string) ([][]byte, error) {
+	hashes := make([][]byte, 0, len(encoded))
+	for _, h := range encoded {
+		hash, err := hex.DecodeString(h)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid proof hash %q: %w", h, err)
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes, nil
+}
@@ -0,0 +1,337 @@
+package sigstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/hashicorp/go-hclog"
+	"github.com/sigstore/cosign/pkg/cosign"
+	"github.com/sigstore/cosign/pkg/oci"
+	"github.com/spiffe/spire/test/fakes/fakerekor"
+)
+
+func newRekorSignature(rekor *fakerekor.Rekor, body string) signature {
+	return signature{
+		payload: []byte(`{"critical": {"identity": {"docker-reference": "docker-registry.com/some/image"},"image": {"docker-manifest-digest": "some digest"},"type": "some type"},"optional": {"subject": "spirex@example.com"}}`),
+		bundle: &oci.Bundle{
+			Payload: rekor.AddEntry([]byte(body)),
+		},
+	}
+}
+
+func newTestTransparencyLog(t *testing.T, rekor *fakerekor.Rekor, checkpointPath string, inconsistencies *[]error) *transparencyLog {
+	tl, err := newTransparencyLog(rekor.URL(), &TransparencyLogConfig{
+		CheckpointPath: checkpointPath,
+		OnInconsistency: func(err error) {
+			*inconsistencies = append(*inconsistencies, err)
+		},
+	}, hclog.NewNullLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tl
+}
+
+func readCheckpoint(t *testing.T, path string) checkpoint {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stored checkpoint
+	if err := json.Unmarshal(data, &stored); err != nil {
+		t.Fatal(err)
+	}
+	return stored
+}
+
+func TestTransparencyLog_VerifySignatures(t *testing.T) {
+	rekor := fakerekor.New(t)
+	checkpointPath := filepath.Join(t.TempDir(), "rekor_checkpoint.json")
+	var inconsistencies []error
+	tl := newTestTransparencyLog(t, rekor, checkpointPath, &inconsistencies)
+
+	first := newRekorSignature(rekor, "entry 1")
+	second := newRekorSignature(rekor, "entry 2")
+	noBundle := noBundleSignature{payload: first.payload}
+	notIncluded := signature{
+		payload: first.payload,
+		bundle: &oci.Bundle{
+			Payload: oci.BundlePayload{Body: "bm90IGluIHRoZSBsb2c="},
+		},
+	}
+
+	got, err := tl.VerifySignatures(context.Background(), []oci.Signature{first, noBundle, notIncluded, second})
+	if err != nil {
+		t.Fatalf("VerifySignatures() unexpected error = %v", err)
+	}
+	if want := []oci.Signature{first, second}; !reflect.DeepEqual(got, want) {
+		t.Errorf("VerifySignatures() = %v, want %v", got, want)
+	}
+	if stored := readCheckpoint(t, checkpointPath); stored.TreeSize != 2 || stored.RekorURL != rekor.URL() {
+		t.Errorf("persisted checkpoint = %+v, want tree size 2 of %s", stored, rekor.URL())
+	}
+
+	// The log grows, the new tree head must be consistent with the checkpoint
+	for i := 3; i < 10; i++ {
+		newRekorSignature(rekor, fmt.Sprintf("entry %d", i))
+	}
+	third := newRekorSignature(rekor, "entry 10")
+	if _, err := tl.VerifySignatures(context.Background(), []oci.Signature{first, third}); err != nil {
+		t.Fatalf("VerifySignatures() unexpected error = %v", err)
+	}
+	if stored := readCheckpoint(t, checkpointPath); stored.TreeSize != 10 {
+		t.Errorf("persisted checkpoint tree size = %d, want 10", stored.TreeSize)
+	}
+
+	if _, err := tl.VerifySignatures(context.Background(), []oci.Signature{noBundle, notIncluded}); err == nil || !strings.Contains(err.Error(), "No signature is included") {
+		t.Errorf("VerifySignatures() error = %v, want no included signature", err)
+	}
+	if len(inconsistencies) != 0 {
+		t.Errorf("unexpected inconsistencies: %v", inconsistencies)
+	}
+}
+
+func TestTransparencyLog_SplitView(t *testing.T) {
+	rekor := fakerekor.New(t)
+	checkpointPath := filepath.Join(t.TempDir(), "rekor_checkpoint.json")
+	var inconsistencies []error
+	tl := newTestTransparencyLog(t, rekor, checkpointPath, &inconsistencies)
+
+	for i := 0; i < 5; i++ {
+		sig := newRekorSignature(rekor, fmt.Sprintf("entry %d", i))
+		if _, err := tl.VerifySignatures(context.Background(), []oci.Signature{sig}); err != nil {
+			t.Fatalf("VerifySignatures() unexpected error = %v", err)
+		}
+	}
+
+	// The log rewrites its history and keeps growing from there
+	rekor.Rewrite()
+	forked := newRekorSignature(rekor, "forked entry")
+
+	// A restarted agent detects the inconsistency from the persisted checkpoint
+	restarted := newTestTransparencyLog(t, rekor, checkpointPath, &inconsistencies)
+	for _, tl := range []*transparencyLog{tl, restarted} {
+		_, err := tl.VerifySignatures(context.Background(), []oci.Signature{forked})
+		if !errors.Is(err, ErrInconsistentLog) {
+			t.Errorf("VerifySignatures() error = %v, want %v", err, ErrInconsistentLog)
+		}
+	}
+	if len(inconsistencies) != 2 {
+		t.Errorf("got %d inconsistencies, want 2", len(inconsistencies))
+	}
+	if stored := readCheckpoint(t, checkpointPath); stored.TreeSize != 5 {
+		t.Errorf("persisted checkpoint tree size = %d, want 5", stored.TreeSize)
+	}
+}
+
+func TestTransparencyLog_OlderTreeHead(t *testing.T) {
+	rekor := fakerekor.New(t)
+	checkpointPath := filepath.Join(t.TempDir(), "rekor_checkpoint.json")
+	var inconsistencies []error
+	tl := newTestTransparencyLog(t, rekor, checkpointPath, &inconsistencies)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		rekor.AddEntry([]byte(fmt.Sprintf("entry %d", i)))
+	}
+	olderHead, err := tl.signedTreeHead(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 2; i < 5; i++ {
+		sig := newRekorSignature(rekor, fmt.Sprintf("entry %d", i))
+		if _, err := tl.VerifySignatures(ctx, []oci.Signature{sig}); err != nil {
+			t.Fatalf("VerifySignatures() unexpected error = %v", err)
+		}
+	}
+
+	// A tree head fetched before the checkpoint moved is not an inconsistency
+	if err := tl.updateCheckpoint(ctx, olderHead); err != nil {
+		t.Errorf("updateCheckpoint() unexpected error = %v", err)
+	}
+	if len(inconsistencies) != 0 {
+		t.Errorf("got inconsistencies %v, want none", inconsistencies)
+	}
+	if stored := readCheckpoint(t, checkpointPath); stored.TreeSize != 5 {
+		t.Errorf("persisted checkpoint tree size = %d, want 5", stored.TreeSize)
+	}
+
+	// An older tree head that is not a prefix of the checkpoint is
+	forgedHead := *olderHead
+	forgedHead.rootHash = make([]byte, len(olderHead.rootHash))
+	err = tl.updateCheckpoint(ctx, &forgedHead)
+	if !errors.Is(err, ErrInconsistentLog) {
+		t.Errorf("updateCheckpoint() error = %v, want %v", err, ErrInconsistentLog)
+	}
+	if len(inconsistencies) != 1 {
+		t.Errorf("got %d inconsistencies, want 1", len(inconsistencies))
+	}
+	if stored := readCheckpoint(t, checkpointPath); stored.TreeSize != 5 {
+		t.Errorf("persisted checkpoint tree size = %d, want 5", stored.TreeSize)
+	}
+}
+
+func TestTransparencyLog_SignedTreeHead(t *testing.T) {
+	rekor := fakerekor.New(t)
+	otherRekor := fakerekor.New(t)
+	dir := t.TempDir()
+
+	otherKeyPath := filepath.Join(dir, "rekor.pub")
+	if err := os.WriteFile(otherKeyPath, otherRekor.PublicKeyPEM(), 0600); err != nil {
+		t.Fatal(err)
+	}
+	keyPath := filepath.Join(dir, "other.pub")
+	if err := os.WriteFile(keyPath, rekor.PublicKeyPEM(), 0600); err != nil {
+		t.Fatal(err)
+	}
+	sig := newRekorSignature(rekor, "entry")
+
+	tests := []struct {
+		name          string
+		publicKeyPath string
+		wantErr       string
+	}{
+		{
+			name:          "configured public key",
+			publicKeyPath: keyPath,
+		},
+		{
+			name:          "tree head signed by another key",
+			publicKeyPath: otherKeyPath,
+			wantErr:       "Signed tree head signature verification failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tl, err := newTransparencyLog(rekor.URL(), &TransparencyLogConfig{
+				CheckpointPath: filepath.Join(t.TempDir(), "rekor_checkpoint.json"),
+				PublicKeyPath:  tt.publicKeyPath,
+			}, hclog.NewNullLogger())
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = tl.VerifySignatures(context.Background(), []oci.Signature{sig})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("VerifySignatures() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("VerifySignatures() unexpected error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_newTransparencyLog(t *testing.T) {
+	dir := t.TempDir()
+	otherLogPath := filepath.Join(dir, "other_log.json")
+	if err := os.WriteFile(otherLogPath, []byte(`{"rekor_url": "https://rekor.example.com", "tree_size": 10, "root_hash": "00"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	invalidPath := filepath.Join(dir, "invalid.json")
+	if err := os.WriteFile(invalidPath, []byte(`{`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		config  *TransparencyLogConfig
+		wantErr string
+	}{
+		{
+			name:   "no checkpoint yet",
+			config: &TransparencyLogConfig{CheckpointPath: filepath.Join(dir, "missing.json")},
+		},
+		{
+			name:   "checkpoint of another log is discarded",
+			config: &TransparencyLogConfig{CheckpointPath: otherLogPath},
+		},
+		{
+			name:    "no checkpoint path",
+			config:  &TransparencyLogConfig{},
+			wantErr: "requires a checkpoint path",
+		},
+		{
+			name:    "invalid checkpoint",
+			config:  &TransparencyLogConfig{CheckpointPath: invalidPath},
+			wantErr: "Unable to parse rekor checkpoint",
+		},
+		{
+			name:    "missing public key",
+			config:  &TransparencyLogConfig{CheckpointPath: otherLogPath, PublicKeyPath: filepath.Join(dir, "missing.pub")},
+			wantErr: "Unable to load rekor public key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tl, err := newTransparencyLog("https://rekor.sigstore.dev", tt.config, hclog.NewNullLogger())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("newTransparencyLog() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("newTransparencyLog() unexpected error = %v", err)
+				return
+			}
+			if tl.checkpoint != nil {
+				t.Errorf("newTransparencyLog() checkpoint = %+v, want none", tl.checkpoint)
+			}
+		})
+	}
+}
+
+func TestSigstoreimpl_FetchImageSignaturesWithTransparencyLog(t *testing.T) {
+	rekor := fakerekor.New(t)
+	included := newRekorSignature(rekor, "entry")
+	notIncluded := noBundleSignature{payload: included.payload}
+
+	sigstore := New(nil, hclog.NewNullLogger()).(*Sigstoreimpl)
+	sigstore.verifyFunction = func(context.Context, name.Reference, *cosign.CheckOpts) ([]oci.Signature, bool, error) {
+		return []oci.Signature{included, notIncluded}, true, nil
+	}
+	sigstore.fetchImageManifestFunction = func(ref name.Reference, options ...remote.Option) (*remote.Descriptor, error) {
+		return &remote.Descriptor{
+			Manifest: []byte("sometext"),
+		}, nil
+	}
+	// The fake server is plain HTTP, which SetRekorURL rejects
+	rekorURL, err := url.Parse(rekor.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigstore.rekorURL = *rekorURL
+	if err := sigstore.SetTransparencyLog(&TransparencyLogConfig{
+		CheckpointPath: filepath.Join(t.TempDir(), "rekor_checkpoint.json"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := sigstore.FetchImageSignatures("docker-registry.com/some/image@sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505")
+	if err != nil {
+		t.Fatalf("FetchImageSignatures() unexpected error = %v", err)
+	}
+	if want := []oci.Signature{included}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FetchImageSignatures() = %v, want %v", got, want)
+	}
+
+	if err := sigstore.SetTransparencyLog(nil); err != nil {
+		t.Fatal(err)
+	}
+	if sigstore.transparencyLog != nil {
+		t.Errorf("SetTransparencyLog(nil) did not disable the transparency log")
+	}
+}
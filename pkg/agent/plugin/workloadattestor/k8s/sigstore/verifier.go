@@ -0,0 +1,328 @@
+package sigstore
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/hashicorp/go-hclog"
+	"github.com/sigstore/cosign/pkg/cosign"
+	"github.com/sigstore/cosign/pkg/oci"
+	rekor "github.com/sigstore/rekor/pkg/generated/client"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	sigstoresignature "github.com/sigstore/sigstore/pkg/signature"
+)
+
+const (
+	// CosignKeylessVerifierType verifies cosign signatures issued by Fulcio
+	CosignKeylessVerifierType = "cosign-keyless"
+	// CosignKeyVerifierType verifies cosign signatures against a public key
+	CosignKeyVerifierType = "cosign-key"
+	// DigestAllowListVerifierType accepts images from a list of digests
+	DigestAllowListVerifierType = "digest-allowlist"
+
+	// Selector prefixes emitted by each verifier type
+	cosignKeylessSelectorPrefix   = "image-signature"
+	cosignKeySelectorPrefix       = "cosign-key-signature"
+	digestAllowListSelectorPrefix = "digest-allowlist"
+)
+
+// ImageVerifier verifies the signatures of a container image
+type ImageVerifier interface {
+	// Verify verifies the image referenced by the digest and returns the
+	// selectors of the verified signatures.
+	Verify(ctx context.Context, ref name.Digest) ([]SelectorsFromSignatures, error)
+}
+
+// VerifierConfig holds the configuration of a signature verification backend
+type VerifierConfig struct {
+	// Type is the verifier type, e.g. "cosign-keyless"
+	Type string `hcl:"type" json:"type"`
+
+	// ImagePatterns are the glob patterns matched against the image
+	// repository (e.g. "index.docker.io/library/*") to select this verifier.
+	ImagePatterns []string `hcl:"image_patterns" json:"image_patterns"`
+
+	// RekorURL is the rekor server used by the cosign verifiers
+	RekorURL string `hcl:"rekor_url" json:"rekor_url"`
+
+	// AllowedSubjects restricts the signature subjects accepted by the
+	// cosign keyless verifier.
+	AllowedSubjects []string `hcl:"allowed_subjects" json:"allowed_subjects"`
+
+	// PublicKeyPath is the path to the PEM encoded public key used by the
+	// cosign key verifier.
+	PublicKeyPath string `hcl:"public_key_path" json:"public_key_path"`
+
+	// Digests is the list of image digests accepted by the digest allow-list
+	// verifier.
+	Digests []string `hcl:"digests" json:"digests"`
+
+	// TrustPolicyPath is the path to the notation trust policy used by the
+	// notation verifier.
+	TrustPolicyPath string `hcl:"trust_policy_path" json:"trust_policy_path"`
+
+	// TrustStoreDir is the notation trust store directory, holding the
+	// certificates of each trust store under "x509/<type>/<name>".
+	TrustStoreDir string `hcl:"trust_store_dir" json:"trust_store_dir"`
+}
+
+// verifierOptions holds the settings the verifiers share with the plugin
+type verifierOptions struct {
+	logger hclog.Logger
+
+	// transparencyLog, if set, verifies the rekor inclusion of the cosign
+	// keyless signatures.
+	transparencyLog *transparencyLog
+}
+
+type verifierBuilder func(name string, config *VerifierConfig, opts verifierOptions) (ImageVerifier, error)
+
+// verifierBuilders holds the registered verification backends by type
+var verifierBuilders = map[string]verifierBuilder{
+	CosignKeylessVerifierType:   newCosignKeylessVerifier,
+	CosignKeyVerifierType:       newCosignKeyVerifier,
+	DigestAllowListVerifierType: newDigestAllowListVerifier,
+	NotationVerifierType:        newNotationVerifier,
+}
+
+// NewImageVerifier creates a verifier of the configured type
+func NewImageVerifier(name string, config *VerifierConfig, logger hclog.Logger) (ImageVerifier, error) {
+	return newImageVerifier(name, config, verifierOptions{logger: logger})
+}
+
+func newImageVerifier(name string, config *VerifierConfig, opts verifierOptions) (ImageVerifier, error) {
+	if config == nil {
+		return nil, fmt.Errorf("Verifier %q has no configuration", name)
+	}
+	builder, ok := verifierBuilders[config.Type]
+	if !ok {
+		return nil, fmt.Errorf("Verifier %q has unknown type %q", name, config.Type)
+	}
+	if len(config.ImagePatterns) == 0 {
+		return nil, fmt.Errorf("Verifier %q has no image patterns", name)
+	}
+	for _, pattern := range config.ImagePatterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("Verifier %q has invalid image pattern %q: %w", name, pattern, err)
+		}
+	}
+	return builder(name, config, opts)
+}
+
+type imagePattern struct {
+	pattern  string
+	name     string
+	verifier ImageVerifier
+}
+
+// newImagePatterns builds the verifiers and orders their image patterns from
+// the most to the least specific, breaking ties by verifier name.
+func newImagePatterns(configs map[string]*VerifierConfig, opts verifierOptions) ([]imagePattern, error) {
+	var patterns []imagePattern
+	for name, config := range configs {
+		verifier, err := newImageVerifier(name, config, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, pattern := range config.ImagePatterns {
+			patterns = append(patterns, imagePattern{
+				pattern:  pattern,
+				name:     name,
+				verifier: verifier,
+			})
+		}
+	}
+	sort.Slice(patterns, func(i, j int) bool {
+		if len(patterns[i].pattern) != len(patterns[j].pattern) {
+			return len(patterns[i].pattern) > len(patterns[j].pattern)
+		}
+		return patterns[i].name < patterns[j].name
+	})
+	return patterns, nil
+}
+
+// matchImagePattern returns the first image pattern matching the repository
+// of the image reference, or nil if none match.
+func matchImagePattern(patterns []imagePattern, ref name.Digest) *imagePattern {
+	repository := ref.Context().Name()
+	for i := range patterns {
+		if ok, _ := path.Match(patterns[i].pattern, repository); ok {
+			return &patterns[i]
+		}
+	}
+	return nil
+}
+
+// cosignKeylessVerifier verifies Fulcio issued cosign signatures
+type cosignKeylessVerifier struct {
+	sigstore *Sigstoreimpl
+}
+
+func newCosignKeylessVerifier(name string, config *VerifierConfig, opts verifierOptions) (ImageVerifier, error) {
+	sigstore := New(nil, opts.logger).(*Sigstoreimpl)
+	if config.RekorURL != "" {
+		if err := sigstore.SetRekorURL(config.RekorURL); err != nil {
+			return nil, err
+		}
+	}
+	// The signatures are proven to be in the same log as the default
+	// verification, which is the only log a checkpoint is kept for.
+	if opts.transparencyLog != nil {
+		if !sameRekorURL(opts.transparencyLog.rekorURL, sigstore.rekorURL.String()) {
+			return nil, fmt.Errorf("Verifier %q uses rekor %s, but the transparency log is verified against %s", name, sigstore.rekorURL.String(), opts.transparencyLog.rekorURL)
+		}
+		sigstore.transparencyLog = opts.transparencyLog
+	}
+	sigstore.EnableAllowSubjectList(len(config.AllowedSubjects) > 0)
+	for _, subject := range config.AllowedSubjects {
+		sigstore.AddAllowedSubject(subject)
+	}
+	return &cosignKeylessVerifier{
+		sigstore: sigstore,
+	}, nil
+}
+
+func (v *cosignKeylessVerifier) Verify(ctx context.Context, ref name.Digest) ([]SelectorsFromSignatures, error) {
+	signatures, err := v.sigstore.FetchImageSignatures(ref.String())
+	if err != nil {
+		return nil, err
+	}
+	selectors := v.sigstore.ExtractSelectorsFromSignatures(signatures, "")
+	if len(selectors) == 0 {
+		return nil, errors.New("No signature subject is allowed")
+	}
+	return selectors, nil
+}
+
+// cosignKeyVerifier verifies cosign signatures against a public key
+type cosignKeyVerifier struct {
+	name                       string
+	verifyFunction             func(context context.Context, ref name.Reference, co *cosign.CheckOpts) ([]oci.Signature, bool, error)
+	fetchImageManifestFunction func(ref name.Reference, options ...remote.Option) (*remote.Descriptor, error)
+	sigVerifier                sigstoresignature.Verifier
+	keyID                      string
+	rekorClient                *rekor.Rekor
+	logger                     hclog.Logger
+}
+
+func newCosignKeyVerifier(name string, config *VerifierConfig, opts verifierOptions) (ImageVerifier, error) {
+	if config.PublicKeyPath == "" {
+		return nil, fmt.Errorf("Verifier %q requires a public key path", name)
+	}
+	keyPEM, err := os.ReadFile(config.PublicKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load public key for verifier %q: %w", name, err)
+	}
+	publicKey, err := cryptoutils.UnmarshalPEMToPublicKey(keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse public key for verifier %q: %w", name, err)
+	}
+	sigVerifier, err := sigstoresignature.LoadVerifier(publicKey, crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load public key for verifier %q: %w", name, err)
+	}
+
+	keyDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to marshal public key for verifier %q: %w", name, err)
+	}
+	keyID := sha256.Sum256(keyDER)
+
+	v := &cosignKeyVerifier{
+		name:                       name,
+		verifyFunction:             cosign.VerifyImageSignatures,
+		fetchImageManifestFunction: remote.Get,
+		sigVerifier:                sigVerifier,
+		keyID:                      hex.EncodeToString(keyID[:]),
+		logger:                     opts.logger,
+	}
+	if config.RekorURL != "" {
+		rekorURL, err := parseRekorURL(config.RekorURL)
+		if err != nil {
+			return nil, err
+		}
+		v.rekorClient = rekor.NewHTTPClientWithConfig(nil, rekor.DefaultTransportConfig().WithBasePath(rekorURL.Path).WithHost(rekorURL.Host))
+	}
+	return v, nil
+}
+
+func (v *cosignKeyVerifier) Verify(ctx context.Context, ref name.Digest) ([]SelectorsFromSignatures, error) {
+	if _, err := resolveDigest(v.fetchImageManifestFunction, ref); err != nil {
+		return nil, fmt.Errorf("Could not validate image reference digest: %w", err)
+	}
+
+	co := &cosign.CheckOpts{
+		SigVerifier:   v.sigVerifier,
+		RekorClient:   v.rekorClient,
+		ClaimVerifier: signedImageClaimVerifier(ref),
+	}
+	signatures, ok, err := v.verifyFunction(ctx, ref, co)
+	if err != nil {
+		return nil, fmt.Errorf("Error verifying signature: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("Bundle not verified for %s", ref.String())
+	}
+
+	var selectors []SelectorsFromSignatures
+	for _, sig := range signatures {
+		sigSelectors := SelectorsFromSignatures{
+			Prefix:   cosignKeySelectorPrefix,
+			Subject:  v.name,
+			KeyID:    v.keyID,
+			Verified: true,
+		}
+		addPayloadSelectors(sig, &sigSelectors, v.logger)
+		addBundleSelectors(sig, &sigSelectors, v.logger)
+		selectors = append(selectors, sigSelectors)
+	}
+	return selectors, nil
+}
+
+// digestAllowListVerifier accepts images whose digest is in a local list
+type digestAllowListVerifier struct {
+	name    string
+	digests map[string]bool
+}
+
+func newDigestAllowListVerifier(name string, config *VerifierConfig, _ verifierOptions) (ImageVerifier, error) {
+	if len(config.Digests) == 0 {
+		return nil, fmt.Errorf("Verifier %q requires at least one digest", name)
+	}
+	digests := make(map[string]bool)
+	for _, digest := range config.Digests {
+		hash, err := v1.NewHash(digest)
+		if err != nil {
+			return nil, fmt.Errorf("Verifier %q has invalid digest %q: %w", name, digest, err)
+		}
+		digests[hash.String()] = true
+	}
+	return &digestAllowListVerifier{
+		name:    name,
+		digests: digests,
+	}, nil
+}
+
+func (v *digestAllowListVerifier) Verify(ctx context.Context, ref name.Digest) ([]SelectorsFromSignatures, error) {
+	if !v.digests[ref.DigestStr()] {
+		return nil, fmt.Errorf("Digest %s is not allowed by verifier %q", ref.DigestStr(), v.name)
+	}
+	return []SelectorsFromSignatures{
+		{
+			Prefix:   digestAllowListSelectorPrefix,
+			Subject:  v.name,
+			Verified: true,
+		},
+	}, nil
+}
@@ -0,0 +1,491 @@
+package sigstore
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/hashicorp/go-hclog"
+	"github.com/sigstore/cosign/pkg/cosign"
+	"github.com/sigstore/cosign/pkg/oci"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	testDigest = "sha256:5fb2054478353fd8d514056d1745b3a9eef066deadda4b90967af7ca65ce6505"
+)
+
+func writePublicKey(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM, err := cryptoutils.MarshalPublicKeyToPEM(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPath := filepath.Join(t.TempDir(), "cosign.pub")
+	if err := os.WriteFile(keyPath, keyPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return keyPath
+}
+
+// publicKeyID returns the hex encoded SHA-256 hash of the DER encoding of the
+// public key at the given path
+func publicKeyID(t *testing.T, keyPath string) string {
+	keyPEM, err := os.ReadFile(keyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		t.Fatal("no PEM block in public key")
+	}
+	sum := sha256.Sum256(block.Bytes)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestNewImageVerifier(t *testing.T) {
+	keyPath := writePublicKey(t)
+
+	tests := []struct {
+		name    string
+		config  *VerifierConfig
+		wantErr string
+	}{
+		{
+			name: "cosign keyless",
+			config: &VerifierConfig{
+				Type:            CosignKeylessVerifierType,
+				ImagePatterns:   []string{"docker-registry.com/*"},
+				RekorURL:        "https://rekor.example.com",
+				AllowedSubjects: []string{"spirex@example.com"},
+			},
+		},
+		{
+			name: "cosign key",
+			config: &VerifierConfig{
+				Type:          CosignKeyVerifierType,
+				ImagePatterns: []string{"docker-registry.com/*"},
+				PublicKeyPath: keyPath,
+			},
+		},
+		{
+			name: "digest allow-list",
+			config: &VerifierConfig{
+				Type:          DigestAllowListVerifierType,
+				ImagePatterns: []string{"docker-registry.com/*"},
+				Digests:       []string{testDigest},
+			},
+		},
+		{
+			name:    "no configuration",
+			wantErr: "has no configuration",
+		},
+		{
+			name: "unknown type",
+			config: &VerifierConfig{
+				Type:          "unknown",
+				ImagePatterns: []string{"docker-registry.com/*"},
+			},
+			wantErr: "has unknown type",
+		},
+		{
+			name: "no image patterns",
+			config: &VerifierConfig{
+				Type:    DigestAllowListVerifierType,
+				Digests: []string{testDigest},
+			},
+			wantErr: "has no image patterns",
+		},
+		{
+			name: "invalid image pattern",
+			config: &VerifierConfig{
+				Type:          DigestAllowListVerifierType,
+				ImagePatterns: []string{"docker-registry.com/["},
+				Digests:       []string{testDigest},
+			},
+			wantErr: "has invalid image pattern",
+		},
+		{
+			name: "cosign keyless with invalid rekor URL",
+			config: &VerifierConfig{
+				Type:          CosignKeylessVerifierType,
+				ImagePatterns: []string{"docker-registry.com/*"},
+				RekorURL:      "abc://invalid.url.com",
+			},
+			wantErr: "Invalid rekor URL Scheme",
+		},
+		{
+			name: "cosign key without public key",
+			config: &VerifierConfig{
+				Type:          CosignKeyVerifierType,
+				ImagePatterns: []string{"docker-registry.com/*"},
+			},
+			wantErr: "requires a public key path",
+		},
+		{
+			name: "cosign key with non-existent public key",
+			config: &VerifierConfig{
+				Type:          CosignKeyVerifierType,
+				ImagePatterns: []string{"docker-registry.com/*"},
+				PublicKeyPath: filepath.Join(t.TempDir(), "no-such-file"),
+			},
+			wantErr: "Unable to load public key",
+		},
+		{
+			name: "digest allow-list without digests",
+			config: &VerifierConfig{
+				Type:          DigestAllowListVerifierType,
+				ImagePatterns: []string{"docker-registry.com/*"},
+			},
+			wantErr: "requires at least one digest",
+		},
+		{
+			name: "digest allow-list with invalid digest",
+			config: &VerifierConfig{
+				Type:          DigestAllowListVerifierType,
+				ImagePatterns: []string{"docker-registry.com/*"},
+				Digests:       []string{"sha256:invalid"},
+			},
+			wantErr: "has invalid digest",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewImageVerifier("test", tt.config, hclog.NewNullLogger())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("NewImageVerifier() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("NewImageVerifier() unexpected error = %v", err)
+				return
+			}
+			if got == nil {
+				t.Errorf("NewImageVerifier() returned nil verifier")
+			}
+		})
+	}
+}
+
+func Test_matchImagePattern(t *testing.T) {
+	patterns, err := newImagePatterns(map[string]*VerifierConfig{
+		"registry": {
+			Type:          DigestAllowListVerifierType,
+			ImagePatterns: []string{"docker-registry.com/*/*"},
+			Digests:       []string{testDigest},
+		},
+		"some-image": {
+			Type:          DigestAllowListVerifierType,
+			ImagePatterns: []string{"docker-registry.com/some/image"},
+			Digests:       []string{testDigest},
+		},
+		"library": {
+			Type:          DigestAllowListVerifierType,
+			ImagePatterns: []string{"index.docker.io/library/*"},
+			Digests:       []string{testDigest},
+		},
+	}, verifierOptions{logger: hclog.NewNullLogger()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		image string
+		want  string
+	}{
+		{
+			name:  "most specific pattern wins",
+			image: "docker-registry.com/some/image@" + testDigest,
+			want:  "some-image",
+		},
+		{
+			name:  "wildcard pattern",
+			image: "docker-registry.com/other/image@" + testDigest,
+			want:  "registry",
+		},
+		{
+			name:  "docker hub image",
+			image: "nginx@" + testDigest,
+			want:  "library",
+		},
+		{
+			name:  "no matching pattern",
+			image: "other-registry.com/some/image@" + testDigest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, err := name.NewDigest(tt.image)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := matchImagePattern(patterns, ref)
+			switch {
+			case tt.want == "" && got != nil:
+				t.Errorf("matchImagePattern() = %v, want no match", got.name)
+			case tt.want != "" && got == nil:
+				t.Errorf("matchImagePattern() = no match, want %v", tt.want)
+			case tt.want != "" && got.name != tt.want:
+				t.Errorf("matchImagePattern() = %v, want %v", got.name, tt.want)
+			}
+		})
+	}
+}
+
+func Test_cosignKeyVerifier_Verify(t *testing.T) {
+	keyPath := writePublicKey(t)
+	keyID := publicKeyID(t, keyPath)
+
+	tests := []struct {
+		name           string
+		manifest       []byte
+		verifyFunction func(context context.Context, ref name.Reference, co *cosign.CheckOpts) ([]oci.Signature, bool, error)
+		want           []SelectorsFromSignatures
+		wantErr        bool
+	}{
+		{
+			name: "verified signature with bundle",
+			verifyFunction: func(context context.Context, ref name.Reference, co *cosign.CheckOpts) ([]oci.Signature, bool, error) {
+				if co.SigVerifier == nil || co.ClaimVerifier == nil {
+					return nil, false, errors.New("missing signature or claim verifier")
+				}
+				return []oci.Signature{
+					signature{
+						bundle: &oci.Bundle{
+							Payload: oci.BundlePayload{
+								LogID:          "samplelogID",
+								IntegratedTime: 12345,
+							},
+						},
+					},
+				}, true, nil
+			},
+			want: []SelectorsFromSignatures{
+				{
+					Prefix:         cosignKeySelectorPrefix,
+					Subject:        "prod-key",
+					LogID:          "samplelogID",
+					IntegratedTime: "12345",
+					KeyID:          keyID,
+					Verified:       true,
+				},
+			},
+		},
+		{
+			name: "bundle not verified",
+			verifyFunction: func(context context.Context, ref name.Reference, co *cosign.CheckOpts) ([]oci.Signature, bool, error) {
+				return []oci.Signature{signature{}}, false, nil
+			},
+			wantErr: true,
+		},
+		{
+			name:     "image manifest does not match the digest",
+			manifest: []byte("othertext"),
+			verifyFunction: func(context context.Context, ref name.Reference, co *cosign.CheckOpts) ([]oci.Signature, bool, error) {
+				return nil, false, errors.New("signatures should not be verified")
+			},
+			wantErr: true,
+		},
+		{
+			name: "no matching signatures",
+			verifyFunction: func(context context.Context, ref name.Reference, co *cosign.CheckOpts) ([]oci.Signature, bool, error) {
+				return nil, false, errors.New("no matching signatures")
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier, err := NewImageVerifier("prod-key", &VerifierConfig{
+				Type:          CosignKeyVerifierType,
+				ImagePatterns: []string{"docker-registry.com/*"},
+				PublicKeyPath: keyPath,
+			}, hclog.NewNullLogger())
+			if err != nil {
+				t.Fatal(err)
+			}
+			verifier.(*cosignKeyVerifier).verifyFunction = tt.verifyFunction
+			verifier.(*cosignKeyVerifier).fetchImageManifestFunction = func(ref name.Reference, options ...remote.Option) (*remote.Descriptor, error) {
+				manifest := tt.manifest
+				if manifest == nil {
+					manifest = []byte("sometext")
+				}
+				return &remote.Descriptor{Manifest: manifest}, nil
+			}
+
+			got, err := verifier.Verify(context.Background(), name.MustParseReference("docker-registry.com/some/image@"+testDigest).(name.Digest))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("cosignKeyVerifier.Verify() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cosignKeyVerifier.Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_cosignKeylessVerifierTransparencyLog(t *testing.T) {
+	tl := &transparencyLog{rekorURL: "https://rekor.example.com/"}
+	opts := verifierOptions{
+		logger:          hclog.NewNullLogger(),
+		transparencyLog: tl,
+	}
+
+	verifier, err := newImageVerifier("keyless", &VerifierConfig{
+		Type:          CosignKeylessVerifierType,
+		ImagePatterns: []string{"docker-registry.com/*"},
+		RekorURL:      "https://rekor.example.com",
+	}, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := verifier.(*cosignKeylessVerifier).sigstore.transparencyLog; got != tl {
+		t.Errorf("cosign keyless verifier transparency log = %v, want %v", got, tl)
+	}
+
+	_, err = newImageVerifier("keyless", &VerifierConfig{
+		Type:          CosignKeylessVerifierType,
+		ImagePatterns: []string{"docker-registry.com/*"},
+		RekorURL:      "https://rekor.other.example.com",
+	}, opts)
+	if err == nil || !strings.Contains(err.Error(), "transparency log is verified against") {
+		t.Errorf("newImageVerifier() error = %v, want transparency log rekor mismatch", err)
+	}
+}
+
+func Test_digestAllowListVerifier_Verify(t *testing.T) {
+	verifier, err := NewImageVerifier("allowed", &VerifierConfig{
+		Type:          DigestAllowListVerifierType,
+		ImagePatterns: []string{"docker-registry.com/*"},
+		Digests:       []string{testDigest},
+	}, hclog.NewNullLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		image   string
+		want    []SelectorsFromSignatures
+		wantErr bool
+	}{
+		{
+			name:  "allowed digest",
+			image: "docker-registry.com/some/image@" + testDigest,
+			want: []SelectorsFromSignatures{
+				{
+					Prefix:   digestAllowListSelectorPrefix,
+					Subject:  "allowed",
+					Verified: true,
+				},
+			},
+		},
+		{
+			name:    "digest not allowed",
+			image:   "docker-registry.com/some/image@sha256:0cfdaced91cb46dd7af48309799a3c351e4ca2d5e1ee9737ca0cbd932cb79898",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, err := name.NewDigest(tt.image)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := verifier.Verify(context.Background(), ref)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("digestAllowListVerifier.Verify() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("digestAllowListVerifier.Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSigstoreimpl_AttestContainerSignaturesWithVerifiers(t *testing.T) {
+	sigstore := New(NewCache(maximumAmountCache), hclog.NewNullLogger()).(*Sigstoreimpl)
+	sigstore.fetchImageManifestFunction = func(ref name.Reference, options ...remote.Option) (*remote.Descriptor, error) {
+		return nil, errors.New("default verifier should not be used")
+	}
+	err := sigstore.SetVerifiers(map[string]*VerifierConfig{
+		"allowed": {
+			Type:          DigestAllowListVerifierType,
+			ImagePatterns: []string{"docker-registry.com/some/*"},
+			Digests:       []string{testDigest},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		status  corev1.ContainerStatus
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "image verified by configured verifier",
+			status: corev1.ContainerStatus{
+				Image:       "docker-registry.com/some/image:v1",
+				ImageID:     "docker-pullable://docker-registry.com/some/image@" + testDigest,
+				ContainerID: "000000",
+			},
+			want: []string{
+				"000000:digest-allowlist-subject:allowed", "sigstore-validation:passed",
+			},
+		},
+		{
+			name: "image rejected by configured verifier",
+			status: corev1.ContainerStatus{
+				Image:       "docker-registry.com/some/image:v2",
+				ImageID:     "docker-registry.com/some/image@sha256:0cfdaced91cb46dd7af48309799a3c351e4ca2d5e1ee9737ca0cbd932cb79898",
+				ContainerID: "111111",
+			},
+			wantErr: true,
+		},
+		{
+			name: "image not matching a verifier uses the default verifier",
+			status: corev1.ContainerStatus{
+				Image:       "other-registry.com/some/image:v1",
+				ImageID:     "other-registry.com/some/image@" + testDigest,
+				ContainerID: "222222",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sigstore.AttestContainerSignatures(&tt.status)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Sigstoreimpl.AttestContainerSignatures() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Sigstoreimpl.AttestContainerSignatures() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
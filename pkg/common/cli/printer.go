@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	OutputFormatText = "text"
+	OutputFormatJSON = "json"
+	OutputFormatYAML = "yaml"
+)
+
+// OutputFormatFlag facilitates parsing the output format of a command
+type OutputFormatFlag string
+
+func (f *OutputFormatFlag) String() string {
+	if *f == "" {
+		return OutputFormatText
+	}
+	return string(*f)
+}
+
+func (f *OutputFormatFlag) Set(v string) error {
+	switch v {
+	case OutputFormatText, OutputFormatJSON, OutputFormatYAML:
+		*f = OutputFormatFlag(v)
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format %q; expected %q, %q or %q", v, OutputFormatText, OutputFormatJSON, OutputFormatYAML)
+	}
+}
+
+// Printer prints the API response messages of a command in the format
+// selected with the -output flag. The zero value prints text.
+type Printer struct {
+	format OutputFormatFlag
+}
+
+// AppendFlag adds the -output flag to the flag set
+func (p *Printer) AppendFlag(f *flag.FlagSet) {
+	f.Var(&p.format, "output", "Desired output `format` of the results: text, json or yaml (default text)")
+}
+
+// IsText returns true if the results are printed in the human oriented
+// text format
+func (p *Printer) IsText() bool {
+	return p.format.String() == OutputFormatText
+}
+
+// PrintProto prints msg in JSON or YAML, or calls printText when the text
+// output format is selected. Fields are named as in the proto definitions
+// so the output is stable across releases.
+func (p *Printer) PrintProto(env *Env, msg proto.Message, printText func() error) error {
+	return p.PrintProtos(env, []proto.Message{msg}, printText)
+}
+
+// PrintProtos is like PrintProto, for commands whose results span more than
+// one response message. The messages are printed as consecutive JSON values
+// or as YAML documents.
+func (p *Printer) PrintProtos(env *Env, msgs []proto.Message, printText func() error) error {
+	if p.IsText() {
+		return printText()
+	}
+
+	for i, msg := range msgs {
+		if i > 0 && p.format.String() == OutputFormatYAML {
+			if _, err := io.WriteString(env.Stdout, "---\n"); err != nil {
+				return err
+			}
+		}
+		out, err := marshalProto(msg, p.format.String())
+		if err != nil {
+			return err
+		}
+		if _, err := env.Stdout.Write(out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func marshalProto(msg proto.Message, format string) ([]byte, error) {
+	out, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", msg.ProtoReflect().Descriptor().Name(), err)
+	}
+
+	if format == OutputFormatYAML {
+		return yaml.JSONToYAML(out)
+	}
+
+	// protojson does not guarantee stable whitespace, so the output is
+	// indented again with encoding/json
+	buf := new(bytes.Buffer)
+	if err := json.Indent(buf, out, "", "  "); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
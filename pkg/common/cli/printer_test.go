@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"io"
+	"testing"
+
+	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPrinter(t *testing.T) {
+	msg := &types.Selector{Type: "unix", Value: "uid:1000"}
+
+	for _, tt := range []struct {
+		name   string
+		args   []string
+		expOut string
+		expErr string
+	}{
+		{
+			name:   "default",
+			expOut: "text\n",
+		},
+		{
+			name:   "text",
+			args:   []string{"-output", "text"},
+			expOut: "text\n",
+		},
+		{
+			name:   "json",
+			args:   []string{"-output", "json"},
+			expOut: "{\n  \"type\": \"unix\",\n  \"value\": \"uid:1000\"\n}\n",
+		},
+		{
+			name:   "yaml",
+			args:   []string{"-output", "yaml"},
+			expOut: "type: unix\nvalue: uid:1000\n",
+		},
+		{
+			name:   "unsupported",
+			args:   []string{"-output", "xml"},
+			expErr: `invalid value "xml" for flag -output: unsupported output format "xml"; expected "text", "json" or "yaml"`,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var printer Printer
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			printer.AppendFlag(fs)
+
+			err := fs.Parse(tt.args)
+			if tt.expErr != "" {
+				require.EqualError(t, err, tt.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			stdout := new(bytes.Buffer)
+			env := &Env{Stdout: stdout}
+			err = printer.PrintProto(env, msg, func() error {
+				return env.Println("text")
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expOut, stdout.String())
+		})
+	}
+}
+
+func TestPrinterTextError(t *testing.T) {
+	var printer Printer
+	err := printer.PrintProto(&Env{}, &types.Selector{}, func() error {
+		return errors.New("oh no")
+	})
+	require.EqualError(t, err, "oh no")
+}
+
+func TestPrinterPrintProtos(t *testing.T) {
+	msgs := []proto.Message{
+		&types.Selector{Type: "unix", Value: "uid:1000"},
+		&types.Selector{Type: "unix", Value: "gid:1000"},
+	}
+
+	for _, tt := range []struct {
+		format string
+		expOut string
+	}{
+		{
+			format: OutputFormatJSON,
+			expOut: "{\n  \"type\": \"unix\",\n  \"value\": \"uid:1000\"\n}\n{\n  \"type\": \"unix\",\n  \"value\": \"gid:1000\"\n}\n",
+		},
+		{
+			format: OutputFormatYAML,
+			expOut: "type: unix\nvalue: uid:1000\n---\ntype: unix\nvalue: gid:1000\n",
+		},
+	} {
+		tt := tt
+		t.Run(tt.format, func(t *testing.T) {
+			printer := Printer{format: OutputFormatFlag(tt.format)}
+			stdout := new(bytes.Buffer)
+			err := printer.PrintProtos(&Env{Stdout: stdout}, msgs, func() error {
+				return errors.New("unexpected text output")
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expOut, stdout.String())
+		})
+	}
+}
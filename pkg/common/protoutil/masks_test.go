@@ -37,6 +37,8 @@ func TestAllTrueMasks(t *testing.T) {
 		DnsNames:       true,
 		RevisionNumber: true,
 		StoreSvid:      true,
+		JwtSvidTtl:     true,
+		Labels:         true,
 	}, protoutil.AllTrueEntryMask)
 
 	assert.Equal(t, &common.BundleMask{
@@ -148,6 +148,9 @@ const (
 	// ByBanned tags filtering by banned agents
 	ByBanned = "by_banned"
 
+	// ByLabels tags labels used when filtering
+	ByLabels = "by_labels"
+
 	// BySelectorMatch tags Match used when filtering by Selectors
 	BySelectorMatch = "by_selector_match"
 
@@ -211,6 +214,9 @@ const (
 	// Downstream tags if entry is a downstream
 	Downstream = "downstream"
 
+	// DryRun tags if a request was a dry run that made no changes
+	DryRun = "dry_run"
+
 	// ElapsedTime tags some duration of time.
 	ElapsedTime = "elapsed_time"
 
@@ -234,12 +240,18 @@ const (
 	// ExpectUID is like Expect, specific to uid.
 	ExpectUID = "expect_uid"
 
+	// ExpectedRevisionNumber tags the revision number an entry update expects
+	ExpectedRevisionNumber = "expected_revision_number"
+
 	// Expiration tags an expiration time for some entity
 	Expiration = "expiration"
 
 	// ExpiresAt tags registration entry expiration
 	ExpiresAt = "expires_at"
 
+	// ExpiringEntries tags a count of registration entries expiring within some window
+	ExpiringEntries = "expiring_entries"
+
 	// ExpiryCheckDuration tags duration for an expiry check; should be used with other tags
 	// to add clarity
 	ExpiryCheckDuration = "expiry_check_duration"
@@ -287,9 +299,15 @@ const (
 	// Key IDs instead.
 	JWTKeys = "jwt_keys"
 
+	// JWTSVIDTTL tags the time-to-live of the JWT-SVIDs of an entry
+	JWTSVIDTTL = "jwt_svid_ttl"
+
 	// Kid tags some key ID
 	Kid = "kid"
 
+	// Labels tags the key/value labels of an entry
+	Labels = "labels"
+
 	// Mode tags a bundle deletion mode
 	Mode = "mode"
 
@@ -364,6 +382,9 @@ const (
 	// RegistrationEntry tags a registration entry
 	RegistrationEntry = "registration_entry"
 
+	// RegistrationEntryEvent tags a registration entry event
+	RegistrationEntryEvent = "registration_entry_event"
+
 	// RequestID tags a request identifier
 	RequestID = "request_id"
 
@@ -470,6 +491,9 @@ const (
 	// VersionInfo tags some version information
 	VersionInfo = "version_info"
 
+	// Window tags a time window
+	Window = "window"
+
 	// WorkloadAttestation tags call of overall workload attestation
 	WorkloadAttestation = "workload_attestation"
 
@@ -579,6 +603,10 @@ const (
 	// to add clarity
 	Node = "node"
 
+	// NodeEvent functionality related to a node entity event; should be used
+	// with other tags to add clarity
+	NodeEvent = "node_event"
+
 	// Notifier functionality related to some notifying entity; should be used with other tags
 	// to add clarity
 	Notifier = "notifier"
@@ -61,4 +61,16 @@ func StartUpdateNodeCall(m telemetry.Metrics) *telemetry.CallCounter {
 	return telemetry.StartCall(m, telemetry.Datastore, telemetry.Node, telemetry.Update)
 }
 
+// StartListNodeEventsCall return metric
+// for server's datastore, on listing node events.
+func StartListNodeEventsCall(m telemetry.Metrics) *telemetry.CallCounter {
+	return telemetry.StartCall(m, telemetry.Datastore, telemetry.NodeEvent, telemetry.List)
+}
+
+// StartPruneNodeEventsCall return metric
+// for server's datastore, on pruning node events.
+func StartPruneNodeEventsCall(m telemetry.Metrics) *telemetry.CallCounter {
+	return telemetry.StartCall(m, telemetry.Datastore, telemetry.NodeEvent, telemetry.Prune)
+}
+
 // End Call Counters
@@ -49,4 +49,16 @@ func StartUpdateRegistrationCall(m telemetry.Metrics) *telemetry.CallCounter {
 	return telemetry.StartCall(m, telemetry.Datastore, telemetry.RegistrationEntry, telemetry.Update)
 }
 
+// StartListRegistrationEntryEventsCall return metric
+// for server's datastore, on listing registration entry events.
+func StartListRegistrationEntryEventsCall(m telemetry.Metrics) *telemetry.CallCounter {
+	return telemetry.StartCall(m, telemetry.Datastore, telemetry.RegistrationEntryEvent, telemetry.List)
+}
+
+// StartPruneRegistrationEntryEventsCall return metric
+// for server's datastore, on pruning registration entry events.
+func StartPruneRegistrationEntryEventsCall(m telemetry.Metrics) *telemetry.CallCounter {
+	return telemetry.StartCall(m, telemetry.Datastore, telemetry.RegistrationEntryEvent, telemetry.Prune)
+}
+
 // End Call Counters
@@ -149,12 +149,24 @@ func (w metricsWrapper) ListBundles(ctx context.Context, req *datastore.ListBund
 	return w.ds.ListBundles(ctx, req)
 }
 
+func (w metricsWrapper) ListAttestedNodeEvents(ctx context.Context, req *datastore.ListAttestedNodeEventsRequest) (_ *datastore.ListAttestedNodeEventsResponse, err error) {
+	callCounter := StartListNodeEventsCall(w.m)
+	defer callCounter.Done(&err)
+	return w.ds.ListAttestedNodeEvents(ctx, req)
+}
+
 func (w metricsWrapper) ListNodeSelectors(ctx context.Context, req *datastore.ListNodeSelectorsRequest) (_ *datastore.ListNodeSelectorsResponse, err error) {
 	callCounter := StartListNodeSelectorsCall(w.m)
 	defer callCounter.Done(&err)
 	return w.ds.ListNodeSelectors(ctx, req)
 }
 
+func (w metricsWrapper) ListRegistrationEntryEvents(ctx context.Context, req *datastore.ListRegistrationEntryEventsRequest) (_ *datastore.ListRegistrationEntryEventsResponse, err error) {
+	callCounter := StartListRegistrationEntryEventsCall(w.m)
+	defer callCounter.Done(&err)
+	return w.ds.ListRegistrationEntryEvents(ctx, req)
+}
+
 func (w metricsWrapper) ListRegistrationEntries(ctx context.Context, req *datastore.ListRegistrationEntriesRequest) (_ *datastore.ListRegistrationEntriesResponse, err error) {
 	callCounter := StartListRegistrationCall(w.m)
 	defer callCounter.Done(&err)
@@ -191,7 +203,19 @@ func (w metricsWrapper) PruneJoinTokens(ctx context.Context, expiresBefore time.
 	return w.ds.PruneJoinTokens(ctx, expiresBefore)
 }
 
-func (w metricsWrapper) PruneRegistrationEntries(ctx context.Context, expiresBefore time.Time) (err error) {
+func (w metricsWrapper) PruneAttestedNodeEvents(ctx context.Context, createdBefore time.Time) (err error) {
+	callCounter := StartPruneNodeEventsCall(w.m)
+	defer callCounter.Done(&err)
+	return w.ds.PruneAttestedNodeEvents(ctx, createdBefore)
+}
+
+func (w metricsWrapper) PruneRegistrationEntryEvents(ctx context.Context, createdBefore time.Time) (err error) {
+	callCounter := StartPruneRegistrationEntryEventsCall(w.m)
+	defer callCounter.Done(&err)
+	return w.ds.PruneRegistrationEntryEvents(ctx, createdBefore)
+}
+
+func (w metricsWrapper) PruneRegistrationEntries(ctx context.Context, expiresBefore time.Time) (_ []*common.RegistrationEntry, err error) {
 	callCounter := StartPruneRegistrationCall(w.m)
 	defer callCounter.Done(&err)
 	return w.ds.PruneRegistrationEntries(ctx, expiresBefore)
@@ -129,6 +129,10 @@ func TestWithMetrics(t *testing.T) {
 			key:        "datastore.bundle.list",
 			methodName: "ListBundles",
 		},
+		{
+			key:        "datastore.node_event.list",
+			methodName: "ListAttestedNodeEvents",
+		},
 		{
 			key:        "datastore.node.selectors.list",
 			methodName: "ListNodeSelectors",
@@ -137,6 +141,10 @@ func TestWithMetrics(t *testing.T) {
 			key:        "datastore.registration_entry.list",
 			methodName: "ListRegistrationEntries",
 		},
+		{
+			key:        "datastore.registration_entry_event.list",
+			methodName: "ListRegistrationEntryEvents",
+		},
 		{
 			key:        "datastore.federation_relationship.list",
 			methodName: "ListFederationRelationships",
@@ -149,10 +157,18 @@ func TestWithMetrics(t *testing.T) {
 			key:        "datastore.join_token.prune",
 			methodName: "PruneJoinTokens",
 		},
+		{
+			key:        "datastore.node_event.prune",
+			methodName: "PruneAttestedNodeEvents",
+		},
 		{
 			key:        "datastore.registration_entry.prune",
 			methodName: "PruneRegistrationEntries",
 		},
+		{
+			key:        "datastore.registration_entry_event.prune",
+			methodName: "PruneRegistrationEntryEvents",
+		},
 		{
 			key:        "datastore.bundle.set",
 			methodName: "SetBundle",
@@ -353,6 +369,10 @@ func (ds *fakeDataStore) ListBundles(context.Context, *datastore.ListBundlesRequ
 	return &datastore.ListBundlesResponse{}, ds.err
 }
 
+func (ds *fakeDataStore) ListAttestedNodeEvents(context.Context, *datastore.ListAttestedNodeEventsRequest) (*datastore.ListAttestedNodeEventsResponse, error) {
+	return &datastore.ListAttestedNodeEventsResponse{}, ds.err
+}
+
 func (ds *fakeDataStore) ListNodeSelectors(context.Context, *datastore.ListNodeSelectorsRequest) (*datastore.ListNodeSelectorsResponse, error) {
 	return &datastore.ListNodeSelectorsResponse{}, ds.err
 }
@@ -361,6 +381,14 @@ func (ds *fakeDataStore) ListRegistrationEntries(context.Context, *datastore.Lis
 	return &datastore.ListRegistrationEntriesResponse{}, ds.err
 }
 
+func (ds *fakeDataStore) ListRegistrationEntryEvents(context.Context, *datastore.ListRegistrationEntryEventsRequest) (*datastore.ListRegistrationEntryEventsResponse, error) {
+	return &datastore.ListRegistrationEntryEventsResponse{}, ds.err
+}
+
+func (ds *fakeDataStore) PruneAttestedNodeEvents(context.Context, time.Time) error {
+	return ds.err
+}
+
 func (ds *fakeDataStore) PruneBundle(context.Context, string, time.Time) (bool, error) {
 	return false, ds.err
 }
@@ -369,7 +397,11 @@ func (ds *fakeDataStore) PruneJoinTokens(context.Context, time.Time) error {
 	return ds.err
 }
 
-func (ds *fakeDataStore) PruneRegistrationEntries(context.Context, time.Time) error {
+func (ds *fakeDataStore) PruneRegistrationEntries(context.Context, time.Time) ([]*common.RegistrationEntry, error) {
+	return []*common.RegistrationEntry{}, ds.err
+}
+
+func (ds *fakeDataStore) PruneRegistrationEntryEvents(context.Context, time.Time) error {
 	return ds.err
 }
 
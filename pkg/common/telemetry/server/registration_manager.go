@@ -11,4 +11,35 @@ func StartRegistrationManagerPruneEntryCall(m telemetry.Metrics) *telemetry.Call
 	return telemetry.StartCall(m, telemetry.RegistrationEntry, telemetry.Manager, telemetry.Prune)
 }
 
+// StartRegistrationManagerPruneEventsCall returns metric for
+// for server registration manager entry and node event pruning
+func StartRegistrationManagerPruneEventsCall(m telemetry.Metrics) *telemetry.CallCounter {
+	return telemetry.StartCall(m, telemetry.RegistrationEntry, telemetry.Manager, telemetry.Event, telemetry.Prune)
+}
+
 // End Call Counters
+
+// Gauge (remember previous value set)
+
+// SetRegistrationManagerExpiringEntriesGauge sets the number of registration
+// entries that expire within the given window
+func SetRegistrationManagerExpiringEntriesGauge(m telemetry.Metrics, window string, val float32) {
+	m.SetGaugeWithLabels(
+		[]string{telemetry.RegistrationEntry, telemetry.Manager, telemetry.ExpiringEntries},
+		val,
+		[]telemetry.Label{
+			{Name: telemetry.Window, Value: window},
+		})
+}
+
+// End Gauge
+
+// Counters (literal increments, not call counters)
+
+// IncrRegistrationManagerPrunedEntryCounter indicates the registration
+// manager having pruned expired entries
+func IncrRegistrationManagerPrunedEntryCounter(m telemetry.Metrics, count int) {
+	m.IncrCounter([]string{telemetry.RegistrationEntry, telemetry.Manager, telemetry.Pruned}, float32(count))
+}
+
+// End Counters
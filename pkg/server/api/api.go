@@ -28,6 +28,22 @@ func (fn AuthorizedEntryFetcherFunc) FetchAuthorizedEntries(ctx context.Context,
 	return fn(ctx, id)
 }
 
+// MatchingEntryFetcher is the interface to fetch the entries that a workload
+// with a given set of selectors would receive
+type MatchingEntryFetcher interface {
+	// FetchMatchingEntries fetches the entries that the specified agent
+	// SPIFFE ID is authorized for and that match the given selectors
+	FetchMatchingEntries(ctx context.Context, agentID spiffeid.ID, selectors []*types.Selector) ([]*types.Entry, error)
+}
+
+// AuthorizedAgentFetcher is the interface to fetch the agents an entry is
+// authorized for
+type AuthorizedAgentFetcher interface {
+	// FetchAuthorizedAgents fetches the SPIFFE IDs of the agents that the
+	// specified entry is, or would be once stored, authorized for
+	FetchAuthorizedAgents(ctx context.Context, entry *types.Entry) ([]spiffeid.ID, error)
+}
+
 // AttestedNodeToProto converts an agent from the given *common.AttestedNode with
 // the provided selectors to *types.Agent
 func AttestedNodeToProto(node *common.AttestedNode, selectors []*types.Selector) (*types.Agent, error) {
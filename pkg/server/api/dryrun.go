@@ -0,0 +1,28 @@
+package api
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// DryRunMetadataKey is the gRPC metadata key used to request that an RPC
+// performs all of its validation and reports what it would do, without
+// making any change.
+const DryRunMetadataKey = "spire.dry-run"
+
+// WithDryRun returns a context that requests a dry run from the RPCs
+// invoked with it.
+func WithDryRun(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, DryRunMetadataKey, "true")
+}
+
+// IsDryRun returns true if the caller requested a dry run of the RPC.
+func IsDryRun(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(DryRunMetadataKey)
+	return len(values) > 0 && values[0] == "true"
+}
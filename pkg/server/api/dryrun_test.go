@@ -0,0 +1,25 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spiffe/spire/pkg/server/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestDryRun(t *testing.T) {
+	ctx := context.Background()
+	assert.False(t, api.IsDryRun(ctx))
+
+	// The outgoing metadata set by the client is received as incoming
+	// metadata by the server
+	md, ok := metadata.FromOutgoingContext(api.WithDryRun(ctx))
+	require.True(t, ok)
+	assert.True(t, api.IsDryRun(metadata.NewIncomingContext(ctx, md)))
+
+	md = metadata.Pairs(api.DryRunMetadataKey, "false")
+	assert.False(t, api.IsDryRun(metadata.NewIncomingContext(ctx, md)))
+}
@@ -3,6 +3,8 @@ package api
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
@@ -66,6 +68,8 @@ func RegistrationEntryToProto(e *common.RegistrationEntry) (*types.Entry, error)
 		DnsNames:       append([]string(nil), e.DnsNames...),
 		RevisionNumber: e.RevisionNumber,
 		StoreSvid:      e.StoreSvid,
+		JwtSvidTtl:     e.JwtSvidTtl,
+		Labels:         e.Labels,
 	}, nil
 }
 
@@ -177,6 +181,21 @@ func ProtoToRegistrationEntryWithMask(td spiffeid.TrustDomain, e *types.Entry, m
 		storeSVID = e.StoreSvid
 	}
 
+	var jwtSVIDTTL int32
+	if mask.JwtSvidTtl {
+		jwtSVIDTTL = e.JwtSvidTtl
+	}
+
+	var labels map[string]string
+	if mask.Labels {
+		for name := range e.Labels {
+			if name == "" {
+				return nil, errors.New("label name cannot be empty")
+			}
+		}
+		labels = e.Labels
+	}
+
 	return &common.RegistrationEntry{
 		EntryId:        e.Id,
 		ParentId:       parentIDString,
@@ -190,5 +209,19 @@ func ProtoToRegistrationEntryWithMask(td spiffeid.TrustDomain, e *types.Entry, m
 		Ttl:            ttl,
 		RevisionNumber: revisionNumber,
 		StoreSvid:      storeSVID,
+		JwtSvidTtl:     jwtSVIDTTL,
+		Labels:         labels,
 	}, nil
 }
+
+// LabelFieldFromProto formats labels as a sorted list of name=value pairs
+// to be used as a log field.
+func LabelFieldFromProto(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for name, value := range labels {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", name, value))
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
@@ -3,6 +3,7 @@ package entry
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -10,6 +11,7 @@ import (
 	entryv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/entry/v1"
 	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
 	"github.com/spiffe/spire/pkg/common/idutil"
+	"github.com/spiffe/spire/pkg/common/protoutil"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/pkg/server/api"
 	"github.com/spiffe/spire/pkg/server/api/rpccontext"
@@ -18,13 +20,24 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
+// maxDryRunAgents is the maximum number of agents listed as receiving or
+// losing an entry in the status of a dry run.
+const maxDryRunAgents = 10
+
 // Config defines the service configuration.
 type Config struct {
 	TrustDomain  spiffeid.TrustDomain
 	EntryFetcher api.AuthorizedEntryFetcher
 	DataStore    datastore.DataStore
+	// AgentFetcher is optional. When set, dry runs report the agents that
+	// would receive or lose the entries.
+	AgentFetcher api.AuthorizedAgentFetcher
+	// MatchingEntryFetcher is optional. When unset, GetMatchingEntries
+	// is not implemented.
+	MatchingEntryFetcher api.MatchingEntryFetcher
 }
 
 // Service defines the v1 entry service.
@@ -34,6 +47,8 @@ type Service struct {
 	td spiffeid.TrustDomain
 	ds datastore.DataStore
 	ef api.AuthorizedEntryFetcher
+	af api.AuthorizedAgentFetcher
+	mf api.MatchingEntryFetcher
 }
 
 // New creates a new v1 entry service.
@@ -42,6 +57,8 @@ func New(config Config) *Service {
 		td: config.TrustDomain,
 		ds: config.DataStore,
 		ef: config.EntryFetcher,
+		af: config.AgentFetcher,
+		mf: config.MatchingEntryFetcher,
 	}
 }
 
@@ -125,6 +142,15 @@ func (s *Service) ListEntries(ctx context.Context, req *entryv1.ListEntriesReque
 				TrustDomains: trustDomains,
 			}
 		}
+
+		if len(req.Filter.ByLabels) > 0 {
+			for name := range req.Filter.ByLabels {
+				if name == "" {
+					return nil, api.MakeErr(log, codes.InvalidArgument, "malformed labels filter", errors.New("label name cannot be empty"))
+				}
+			}
+			listReq.ByLabels = req.Filter.ByLabels
+		}
 	}
 
 	dsResp, err := s.ds.ListRegistrationEntries(ctx, listReq)
@@ -186,7 +212,7 @@ func (s *Service) BatchCreateEntry(ctx context.Context, req *entryv1.BatchCreate
 		r := s.createEntry(ctx, eachEntry, req.OutputMask)
 		results = append(results, r)
 		rpccontext.AuditRPCWithTypesStatus(ctx, r.Status, func() logrus.Fields {
-			return fieldsFromEntryProto(eachEntry, nil)
+			return addDryRunField(ctx, fieldsFromEntryProto(eachEntry, nil))
 		})
 	}
 
@@ -207,6 +233,10 @@ func (s *Service) createEntry(ctx context.Context, e *types.Entry, outputMask *t
 
 	log = log.WithField(telemetry.SPIFFEID, cEntry.SpiffeId)
 
+	if api.IsDryRun(ctx) {
+		return s.dryRunCreateEntry(ctx, log, cEntry, outputMask)
+	}
+
 	resultStatus := api.OK()
 	regEntry, existing, err := s.ds.CreateOrReturnRegistrationEntry(ctx, cEntry)
 	switch {
@@ -238,10 +268,15 @@ func (s *Service) BatchUpdateEntry(ctx context.Context, req *entryv1.BatchUpdate
 	var results []*entryv1.BatchUpdateEntryResponse_Result
 
 	for _, eachEntry := range req.Entries {
-		e := s.updateEntry(ctx, eachEntry, req.InputMask, req.OutputMask)
+		expectedRevision, checkRevision := req.ExpectedRevisionNumbers[eachEntry.Id]
+		e := s.updateEntry(ctx, eachEntry, req.InputMask, req.OutputMask, expectedRevision, checkRevision)
 		results = append(results, e)
 		rpccontext.AuditRPCWithTypesStatus(ctx, e.Status, func() logrus.Fields {
-			return fieldsFromEntryProto(eachEntry, req.InputMask)
+			fields := fieldsFromEntryProto(eachEntry, req.InputMask)
+			if checkRevision {
+				fields[telemetry.ExpectedRevisionNumber] = expectedRevision
+			}
+			return addDryRunField(ctx, fields)
 		})
 	}
 
@@ -257,7 +292,7 @@ func (s *Service) BatchDeleteEntry(ctx context.Context, req *entryv1.BatchDelete
 		r := s.deleteEntry(ctx, id)
 		results = append(results, r)
 		rpccontext.AuditRPCWithTypesStatus(ctx, r.Status, func() logrus.Fields {
-			return logrus.Fields{telemetry.RegistrationID: id}
+			return addDryRunField(ctx, logrus.Fields{telemetry.RegistrationID: id})
 		})
 	}
 
@@ -278,6 +313,10 @@ func (s *Service) deleteEntry(ctx context.Context, id string) *entryv1.BatchDele
 
 	log = log.WithField(telemetry.RegistrationID, id)
 
+	if api.IsDryRun(ctx) {
+		return s.dryRunDeleteEntry(ctx, log, id)
+	}
+
 	_, err := s.ds.DeleteRegistrationEntry(ctx, id)
 	switch status.Code(err) {
 	case codes.OK:
@@ -319,6 +358,51 @@ func (s *Service) GetAuthorizedEntries(ctx context.Context, req *entryv1.GetAuth
 	return resp, nil
 }
 
+// GetMatchingEntries returns the list of entries that a workload with the
+// given selectors would receive from the given agent.
+func (s *Service) GetMatchingEntries(ctx context.Context, req *entryv1.GetMatchingEntriesRequest) (*entryv1.GetMatchingEntriesResponse, error) {
+	log := rpccontext.Logger(ctx)
+
+	if s.mf == nil {
+		return nil, api.MakeErr(log, codes.Unimplemented, "matching entries lookup is not supported", nil)
+	}
+
+	agentID, err := api.TrustDomainAgentIDFromProto(s.td, req.AgentId)
+	if err != nil {
+		return nil, api.MakeErr(log, codes.InvalidArgument, "malformed agent ID", err)
+	}
+	rpccontext.AddRPCAuditFields(ctx, logrus.Fields{
+		telemetry.AgentID:   agentID.String(),
+		telemetry.Selectors: api.SelectorFieldFromProto(req.Selectors),
+	})
+	log = log.WithField(telemetry.AgentID, agentID.String())
+
+	if _, err := api.SelectorsFromProto(req.Selectors); err != nil {
+		return nil, api.MakeErr(log, codes.InvalidArgument, "malformed selectors", err)
+	}
+	if len(req.Selectors) == 0 {
+		return nil, api.MakeErr(log, codes.InvalidArgument, "malformed selectors", errors.New("empty selector set"))
+	}
+
+	entries, err := s.mf.FetchMatchingEntries(ctx, agentID, req.Selectors)
+	if err != nil {
+		return nil, api.MakeErr(log, codes.Internal, "failed to fetch entries", err)
+	}
+	resp := &entryv1.GetMatchingEntriesResponse{
+		Entries: make([]*types.Entry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		// The entries are shared with the cache, so the mask is applied
+		// to a copy
+		entry = proto.Clone(entry).(*types.Entry)
+		applyMask(entry, req.OutputMask)
+		resp.Entries = append(resp.Entries, entry)
+	}
+	rpccontext.AuditRPC(ctx)
+
+	return resp, nil
+}
+
 // fetchEntries fetches authorized entries using caller ID from context
 func (s *Service) fetchEntries(ctx context.Context, log logrus.FieldLogger) ([]*types.Entry, error) {
 	callerID, ok := rpccontext.CallerID(ctx)
@@ -382,9 +466,17 @@ func applyMask(e *types.Entry, mask *types.EntryMask) {
 	if !mask.StoreSvid {
 		e.StoreSvid = false
 	}
+
+	if !mask.JwtSvidTtl {
+		e.JwtSvidTtl = 0
+	}
+
+	if !mask.Labels {
+		e.Labels = nil
+	}
 }
 
-func (s *Service) updateEntry(ctx context.Context, e *types.Entry, inputMask *types.EntryMask, outputMask *types.EntryMask) *entryv1.BatchUpdateEntryResponse_Result {
+func (s *Service) updateEntry(ctx context.Context, e *types.Entry, inputMask *types.EntryMask, outputMask *types.EntryMask, expectedRevision int64, checkRevision bool) *entryv1.BatchUpdateEntryResponse_Result {
 	log := rpccontext.Logger(ctx)
 	log = log.WithField(telemetry.RegistrationID, e.Id)
 
@@ -395,6 +487,12 @@ func (s *Service) updateEntry(ctx context.Context, e *types.Entry, inputMask *ty
 		}
 	}
 
+	if inputMask == nil && checkRevision {
+		// A nil input mask updates every field. Spell that out so the
+		// datastore mask can also carry the revision number check.
+		inputMask = protoutil.AllTrueEntryMask
+	}
+
 	var mask *common.RegistrationEntryMask
 	if inputMask != nil {
 		mask = &common.RegistrationEntryMask{
@@ -408,10 +506,28 @@ func (s *Service) updateEntry(ctx context.Context, e *types.Entry, inputMask *ty
 			DnsNames:      inputMask.DnsNames,
 			Selectors:     inputMask.Selectors,
 			StoreSvid:     inputMask.StoreSvid,
+			JwtSvidTtl:    inputMask.JwtSvidTtl,
+			Labels:        inputMask.Labels,
 		}
 	}
+	if checkRevision {
+		// The update only succeeds if the stored entry is still at the
+		// expected revision
+		mask.RevisionNumber = true
+		convEntry.RevisionNumber = expectedRevision
+	}
+	if api.IsDryRun(ctx) {
+		return s.dryRunUpdateEntry(ctx, log, convEntry, mask, outputMask)
+	}
+
 	dsEntry, err := s.ds.UpdateRegistrationEntry(ctx, convEntry, mask)
-	if err != nil {
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.Aborted:
+		return &entryv1.BatchUpdateEntryResponse_Result{
+			Status: api.MakeStatus(log, codes.Aborted, "entry was updated concurrently", err),
+		}
+	default:
 		return &entryv1.BatchUpdateEntryResponse_Result{
 			Status: api.MakeStatus(log, codes.Internal, "failed to update entry", err),
 		}
@@ -432,6 +548,290 @@ func (s *Service) updateEntry(ctx context.Context, e *types.Entry, inputMask *ty
 	}
 }
 
+func (s *Service) dryRunCreateEntry(ctx context.Context, log logrus.FieldLogger, entry *common.RegistrationEntry, outputMask *types.EntryMask) *entryv1.BatchCreateEntryResponse_Result {
+	if err := s.validateEntry(ctx, entry); err != nil {
+		return &entryv1.BatchCreateEntryResponse_Result{
+			Status: api.MakeStatus(log, codes.Internal, "failed to create entry", err),
+		}
+	}
+
+	resultStatus := api.OK()
+	existing, err := s.lookupSimilarEntry(ctx, entry)
+	switch {
+	case err != nil:
+		return &entryv1.BatchCreateEntryResponse_Result{
+			Status: api.MakeStatus(log, codes.Internal, "failed to create entry", err),
+		}
+	case existing != nil:
+		entry = existing
+		resultStatus = api.CreateStatus(codes.AlreadyExists, "similar entry already exists")
+	}
+
+	tEntry, err := api.RegistrationEntryToProto(entry)
+	if err != nil {
+		return &entryv1.BatchCreateEntryResponse_Result{
+			Status: api.MakeStatus(log, codes.Internal, "failed to convert entry", err),
+		}
+	}
+
+	if existing == nil {
+		receiving, err := s.fetchAuthorizedAgents(ctx, tEntry)
+		if err != nil {
+			return &entryv1.BatchCreateEntryResponse_Result{
+				Status: api.MakeStatus(log, codes.Internal, "failed to fetch authorized agents", err),
+			}
+		}
+		resultStatus = dryRunStatus(receiving, nil)
+	}
+
+	applyMask(tEntry, outputMask)
+
+	return &entryv1.BatchCreateEntryResponse_Result{
+		Status: resultStatus,
+		Entry:  tEntry,
+	}
+}
+
+func (s *Service) dryRunUpdateEntry(ctx context.Context, log logrus.FieldLogger, update *common.RegistrationEntry, mask *common.RegistrationEntryMask, outputMask *types.EntryMask) *entryv1.BatchUpdateEntryResponse_Result {
+	existing, err := s.ds.FetchRegistrationEntry(ctx, update.EntryId)
+	switch {
+	case err != nil:
+		return &entryv1.BatchUpdateEntryResponse_Result{
+			Status: api.MakeStatus(log, codes.Internal, "failed to fetch entry", err),
+		}
+	case existing == nil:
+		return &entryv1.BatchUpdateEntryResponse_Result{
+			Status: api.MakeStatus(log, codes.NotFound, "entry not found", nil),
+		}
+	case mask != nil && mask.RevisionNumber && existing.RevisionNumber != update.RevisionNumber:
+		return &entryv1.BatchUpdateEntryResponse_Result{
+			Status: api.MakeStatus(log, codes.Aborted, "entry was updated concurrently", fmt.Errorf("expected %d, found %d", update.RevisionNumber, existing.RevisionNumber)),
+		}
+	}
+
+	updated := applyEntryUpdate(existing, update, mask)
+	if err := s.validateEntry(ctx, updated); err != nil {
+		return &entryv1.BatchUpdateEntryResponse_Result{
+			Status: api.MakeStatus(log, codes.Internal, "failed to update entry", err),
+		}
+	}
+
+	before, err := api.RegistrationEntryToProto(existing)
+	if err != nil {
+		return &entryv1.BatchUpdateEntryResponse_Result{
+			Status: api.MakeStatus(log, codes.Internal, "failed to convert entry in updateEntry", err),
+		}
+	}
+	after, err := api.RegistrationEntryToProto(updated)
+	if err != nil {
+		return &entryv1.BatchUpdateEntryResponse_Result{
+			Status: api.MakeStatus(log, codes.Internal, "failed to convert entry in updateEntry", err),
+		}
+	}
+
+	agentsBefore, err := s.fetchAuthorizedAgents(ctx, before)
+	if err != nil {
+		return &entryv1.BatchUpdateEntryResponse_Result{
+			Status: api.MakeStatus(log, codes.Internal, "failed to fetch authorized agents", err),
+		}
+	}
+	agentsAfter, err := s.fetchAuthorizedAgents(ctx, after)
+	if err != nil {
+		return &entryv1.BatchUpdateEntryResponse_Result{
+			Status: api.MakeStatus(log, codes.Internal, "failed to fetch authorized agents", err),
+		}
+	}
+
+	applyMask(after, outputMask)
+
+	return &entryv1.BatchUpdateEntryResponse_Result{
+		Status: dryRunStatus(subtractIDs(agentsAfter, agentsBefore), subtractIDs(agentsBefore, agentsAfter)),
+		Entry:  after,
+	}
+}
+
+func (s *Service) dryRunDeleteEntry(ctx context.Context, log logrus.FieldLogger, id string) *entryv1.BatchDeleteEntryResponse_Result {
+	existing, err := s.ds.FetchRegistrationEntry(ctx, id)
+	switch {
+	case err != nil:
+		return &entryv1.BatchDeleteEntryResponse_Result{
+			Id:     id,
+			Status: api.MakeStatus(log, codes.Internal, "failed to fetch entry", err),
+		}
+	case existing == nil:
+		return &entryv1.BatchDeleteEntryResponse_Result{
+			Id:     id,
+			Status: api.MakeStatus(log, codes.NotFound, "entry not found", nil),
+		}
+	}
+
+	tEntry, err := api.RegistrationEntryToProto(existing)
+	if err != nil {
+		return &entryv1.BatchDeleteEntryResponse_Result{
+			Id:     id,
+			Status: api.MakeStatus(log, codes.Internal, "failed to convert entry", err),
+		}
+	}
+
+	losing, err := s.fetchAuthorizedAgents(ctx, tEntry)
+	if err != nil {
+		return &entryv1.BatchDeleteEntryResponse_Result{
+			Id:     id,
+			Status: api.MakeStatus(log, codes.Internal, "failed to fetch authorized agents", err),
+		}
+	}
+
+	return &entryv1.BatchDeleteEntryResponse_Result{
+		Id:     id,
+		Status: dryRunStatus(nil, losing),
+	}
+}
+
+// validateEntry runs the validation that the datastore runs when storing an
+// entry, and checks that the bundles it federates with exist.
+func (s *Service) validateEntry(ctx context.Context, entry *common.RegistrationEntry) error {
+	if err := datastore.ValidateRegistrationEntry(entry); err != nil {
+		return err
+	}
+
+	for _, trustDomain := range entry.FederatesWith {
+		bundle, err := s.ds.FetchBundle(ctx, trustDomain)
+		if err != nil {
+			return err
+		}
+		if bundle == nil {
+			return fmt.Errorf("unable to find federated bundle %q", trustDomain)
+		}
+	}
+	return nil
+}
+
+// lookupSimilarEntry returns the entry, if any, with the same parent ID,
+// SPIFFE ID and selectors as the given one.
+func (s *Service) lookupSimilarEntry(ctx context.Context, entry *common.RegistrationEntry) (*common.RegistrationEntry, error) {
+	resp, err := s.ds.ListRegistrationEntries(ctx, &datastore.ListRegistrationEntriesRequest{
+		BySpiffeID: entry.SpiffeId,
+		ByParentID: entry.ParentId,
+		BySelectors: &datastore.BySelectors{
+			Match:     datastore.Exact,
+			Selectors: entry.Selectors,
+		},
+	})
+	switch {
+	case err != nil:
+		return nil, err
+	case len(resp.Entries) > 0:
+		return resp.Entries[0], nil
+	default:
+		return nil, nil
+	}
+}
+
+// fetchAuthorizedAgents fetches the agents the given entry is authorized
+// for, or returns nil if the service has no agent fetcher.
+func (s *Service) fetchAuthorizedAgents(ctx context.Context, entry *types.Entry) ([]spiffeid.ID, error) {
+	if s.af == nil {
+		return nil, nil
+	}
+	return s.af.FetchAuthorizedAgents(ctx, entry)
+}
+
+// applyEntryUpdate returns a copy of the existing entry with the fields set
+// in the mask taken from the update. A nil mask updates all the fields.
+func applyEntryUpdate(existing, update *common.RegistrationEntry, mask *common.RegistrationEntryMask) *common.RegistrationEntry {
+	updated := proto.Clone(existing).(*common.RegistrationEntry)
+	if mask == nil || mask.SpiffeId {
+		updated.SpiffeId = update.SpiffeId
+	}
+	if mask == nil || mask.ParentId {
+		updated.ParentId = update.ParentId
+	}
+	if mask == nil || mask.Ttl {
+		updated.Ttl = update.Ttl
+	}
+	if mask == nil || mask.FederatesWith {
+		updated.FederatesWith = update.FederatesWith
+	}
+	if mask == nil || mask.Admin {
+		updated.Admin = update.Admin
+	}
+	if mask == nil || mask.Downstream {
+		updated.Downstream = update.Downstream
+	}
+	if mask == nil || mask.EntryExpiry {
+		updated.EntryExpiry = update.EntryExpiry
+	}
+	if mask == nil || mask.DnsNames {
+		updated.DnsNames = update.DnsNames
+	}
+	if mask == nil || mask.Selectors {
+		updated.Selectors = update.Selectors
+	}
+	if mask == nil || mask.StoreSvid {
+		updated.StoreSvid = update.StoreSvid
+	}
+	if mask == nil || mask.JwtSvidTtl {
+		updated.JwtSvidTtl = update.JwtSvidTtl
+	}
+	if mask == nil || mask.Labels {
+		updated.Labels = update.Labels
+	}
+	return updated
+}
+
+// dryRunStatus creates the status of a successful dry run, listing the
+// agents that would receive or lose the entry.
+func dryRunStatus(receiving, losing []spiffeid.ID) *types.Status {
+	msg := "dry run"
+	if len(receiving) > 0 {
+		msg += "; receiving agents: " + joinIDs(receiving)
+	}
+	if len(losing) > 0 {
+		msg += "; losing agents: " + joinIDs(losing)
+	}
+	return api.CreateStatus(codes.OK, "%s", msg)
+}
+
+// addDryRunField tags the given audit log fields when the request is a dry
+// run.
+func addDryRunField(ctx context.Context, fields logrus.Fields) logrus.Fields {
+	if api.IsDryRun(ctx) {
+		fields[telemetry.DryRun] = true
+	}
+	return fields
+}
+
+func subtractIDs(ids, other []spiffeid.ID) []spiffeid.ID {
+	var out []spiffeid.ID
+	for _, id := range ids {
+		found := false
+		for _, o := range other {
+			if id == o {
+				found = true
+				break
+			}
+		}
+		if !found {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// joinIDs joins the given IDs for the status of a dry run. Only the first
+// maxDryRunAgents IDs are listed, followed by the count of the others.
+func joinIDs(ids []spiffeid.ID) string {
+	strs := make([]string, 0, maxDryRunAgents+1)
+	for i, id := range ids {
+		if i == maxDryRunAgents {
+			strs = append(strs, fmt.Sprintf("and %d more", len(ids)-maxDryRunAgents))
+			break
+		}
+		strs = append(strs, id.String())
+	}
+	return strings.Join(strs, ", ")
+}
+
 func fieldsFromEntryProto(proto *types.Entry, inputMask *types.EntryMask) logrus.Fields {
 	fields := logrus.Fields{}
 
@@ -499,6 +899,16 @@ func fieldsFromEntryProto(proto *types.Entry, inputMask *types.EntryMask) logrus
 		fields[telemetry.StoreSvid] = proto.StoreSvid
 	}
 
+	if inputMask == nil || inputMask.JwtSvidTtl {
+		fields[telemetry.JWTSVIDTTL] = proto.JwtSvidTtl
+	}
+
+	if inputMask == nil || inputMask.Labels {
+		if labels := api.LabelFieldFromProto(proto.Labels); labels != "" {
+			fields[telemetry.Labels] = labels
+		}
+	}
+
 	return fields
 }
 
@@ -527,5 +937,9 @@ func fieldsFromListEntryFilter(td spiffeid.TrustDomain, filter *entryv1.ListEntr
 		fields[telemetry.FederatesWith] = strings.Join(filter.ByFederatesWith.TrustDomains, ",")
 	}
 
+	if len(filter.ByLabels) > 0 {
+		fields[telemetry.ByLabels] = api.LabelFieldFromProto(filter.ByLabels)
+	}
+
 	return fields
 }
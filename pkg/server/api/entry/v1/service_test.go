@@ -193,6 +193,7 @@ func TestListEntries(t *testing.T) {
 		FederatesWith: []string{
 			federatedTd.IDString(),
 		},
+		Labels: map[string]string{"team": "payments"},
 	}
 	secondChildRegEntry := &common.RegistrationEntry{
 		ParentId: parentID.String(),
@@ -245,6 +246,7 @@ func TestListEntries(t *testing.T) {
 		FederatesWith: []string{
 			federatedTd.String(),
 		},
+		Labels: map[string]string{"team": "payments"},
 	}
 
 	expectedSecondChild := &types.Entry{
@@ -361,6 +363,26 @@ func TestListEntries(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:            "filter by labels",
+			expectedEntries: []*types.Entry{expectedChild},
+			request: &entryv1.ListEntriesRequest{
+				Filter: &entryv1.ListEntriesRequest_Filter{
+					ByLabels: map[string]string{"team": "payments"},
+				},
+			},
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.InfoLevel,
+					Message: "API accessed",
+					Data: logrus.Fields{
+						telemetry.Status:   "success",
+						telemetry.Type:     "audit",
+						telemetry.ByLabels: "team=payments",
+					},
+				},
+			},
+		},
 		{
 			name:            "filter by SPIFFE ID",
 			expectedEntries: []*types.Entry{expectedChild},
@@ -1161,6 +1183,36 @@ func TestListEntries(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "bad labels filter (empty label name)",
+			err:  "malformed labels filter: label name cannot be empty",
+			code: codes.InvalidArgument,
+			request: &entryv1.ListEntriesRequest{
+				Filter: &entryv1.ListEntriesRequest_Filter{
+					ByLabels: map[string]string{"": "payments"},
+				},
+			},
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Invalid argument: malformed labels filter",
+					Data: logrus.Fields{
+						logrus.ErrorKey: "label name cannot be empty",
+					},
+				},
+				{
+					Level:   logrus.InfoLevel,
+					Message: "API accessed",
+					Data: logrus.Fields{
+						telemetry.Status:        "error",
+						telemetry.Type:          "audit",
+						telemetry.StatusCode:    "InvalidArgument",
+						telemetry.StatusMessage: "malformed labels filter: label name cannot be empty",
+						telemetry.ByLabels:      "=payments",
+					},
+				},
+			},
+		},
 	} {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
@@ -1523,6 +1575,7 @@ func TestBatchCreateEntry(t *testing.T) {
 						telemetry.SPIFFEID:       "spiffe://example.org/workload",
 						telemetry.TTL:            "60",
 						telemetry.StoreSvid:      "false",
+						telemetry.JWTSVIDTTL:     "0",
 					},
 				},
 				{
@@ -1549,6 +1602,7 @@ func TestBatchCreateEntry(t *testing.T) {
 						telemetry.SPIFFEID:       "spiffe://example.org/malformed",
 						telemetry.TTL:            "0",
 						telemetry.StoreSvid:      "false",
+						telemetry.JWTSVIDTTL:     "0",
 					},
 				},
 				{
@@ -1567,6 +1621,7 @@ func TestBatchCreateEntry(t *testing.T) {
 						telemetry.SPIFFEID:       "spiffe://example.org/workload2",
 						telemetry.TTL:            "0",
 						telemetry.StoreSvid:      "false",
+						telemetry.JWTSVIDTTL:     "0",
 					},
 				},
 			},
@@ -1659,6 +1714,7 @@ func TestBatchCreateEntry(t *testing.T) {
 						telemetry.SPIFFEID:       "spiffe://example.org/svidstore",
 						telemetry.TTL:            "0",
 						telemetry.StoreSvid:      "true",
+						telemetry.JWTSVIDTTL:     "0",
 					},
 				},
 			},
@@ -1741,6 +1797,7 @@ func TestBatchCreateEntry(t *testing.T) {
 						telemetry.SPIFFEID:       "spiffe://example.org/workload",
 						telemetry.TTL:            "60",
 						telemetry.StoreSvid:      "false",
+						telemetry.JWTSVIDTTL:     "0",
 					},
 				},
 			},
@@ -1777,6 +1834,7 @@ func TestBatchCreateEntry(t *testing.T) {
 						telemetry.SPIFFEID:       "spiffe://example.org/workload",
 						telemetry.TTL:            "60",
 						telemetry.StoreSvid:      "false",
+						telemetry.JWTSVIDTTL:     "0",
 					},
 				},
 			},
@@ -1841,6 +1899,7 @@ func TestBatchCreateEntry(t *testing.T) {
 						telemetry.SPIFFEID:       "spiffe://example.org/bar",
 						telemetry.TTL:            "60",
 						telemetry.StoreSvid:      "false",
+						telemetry.JWTSVIDTTL:     "0",
 					},
 				},
 			},
@@ -1894,6 +1953,7 @@ func TestBatchCreateEntry(t *testing.T) {
 						telemetry.StatusCode:     "AlreadyExists",
 						telemetry.StatusMessage:  "similar entry already exists",
 						telemetry.StoreSvid:      "false",
+						telemetry.JWTSVIDTTL:     "0",
 					},
 				},
 			},
@@ -1929,6 +1989,7 @@ func TestBatchCreateEntry(t *testing.T) {
 						telemetry.RevisionNumber: "0",
 						telemetry.TTL:            "0",
 						telemetry.StoreSvid:      "false",
+						telemetry.JWTSVIDTTL:     "0",
 						telemetry.StatusCode:     "InvalidArgument",
 						telemetry.StatusMessage:  "failed to convert entry: invalid parent ID: trust domain is empty",
 					},
@@ -1969,6 +2030,7 @@ func TestBatchCreateEntry(t *testing.T) {
 						telemetry.SPIFFEID:       "spiffe://example.org/workload",
 						telemetry.TTL:            "60",
 						telemetry.StoreSvid:      "false",
+						telemetry.JWTSVIDTTL:     "0",
 						telemetry.StatusCode:     "Internal",
 						telemetry.StatusMessage:  "failed to create entry: creating error",
 					},
@@ -2018,6 +2080,7 @@ func TestBatchCreateEntry(t *testing.T) {
 						telemetry.SPIFFEID:       "spiffe://example.org/workload",
 						telemetry.TTL:            "60",
 						telemetry.StoreSvid:      "false",
+						telemetry.JWTSVIDTTL:     "0",
 						telemetry.StatusCode:     "Internal",
 						telemetry.StatusMessage:  "failed to convert entry: invalid SPIFFE ID: spiffeid: invalid scheme",
 					},
@@ -2545,6 +2608,238 @@ func TestGetAuthorizedEntries(t *testing.T) {
 	}
 }
 
+func TestGetMatchingEntries(t *testing.T) {
+	matchingAgentID := &types.SPIFFEID{TrustDomain: "example.org", Path: "/spire/agent/foo"}
+	entry1 := &types.Entry{
+		Id:       "entry-1",
+		ParentId: matchingAgentID,
+		SpiffeId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/bar"},
+		Ttl:      60,
+		Selectors: []*types.Selector{
+			{Type: "unix", Value: "uid:1000"},
+		},
+		DnsNames: []string{"dns1"},
+	}
+	entry2 := &types.Entry{
+		Id:       "entry-2",
+		ParentId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/bar"},
+		SpiffeId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/baz"},
+		Ttl:      3600,
+		Selectors: []*types.Selector{
+			{Type: "unix", Value: "uid:1000"},
+			{Type: "unix", Value: "gid:1000"},
+		},
+	}
+	selectors := []*types.Selector{
+		{Type: "unix", Value: "uid:1000"},
+		{Type: "unix", Value: "gid:1000"},
+	}
+
+	for _, tt := range []struct {
+		name           string
+		code           codes.Code
+		err            string
+		fetcherErr     string
+		agentID        *types.SPIFFEID
+		selectors      []*types.Selector
+		outputMask     *types.EntryMask
+		fetcherEntries []*types.Entry
+		expectEntries  []*types.Entry
+		expectLogs     []spiretest.LogEntry
+	}{
+		{
+			name:           "success",
+			agentID:        matchingAgentID,
+			selectors:      selectors,
+			fetcherEntries: []*types.Entry{entry1, entry2},
+			expectEntries:  []*types.Entry{entry1, entry2},
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.InfoLevel,
+					Message: "API accessed",
+					Data: logrus.Fields{
+						telemetry.Status:    "success",
+						telemetry.Type:      "audit",
+						telemetry.AgentID:   "spiffe://example.org/spire/agent/foo",
+						telemetry.Selectors: "unix:uid:1000,unix:gid:1000",
+					},
+				},
+			},
+		},
+		{
+			name:           "success with output mask",
+			agentID:        matchingAgentID,
+			selectors:      selectors,
+			outputMask:     &types.EntryMask{SpiffeId: true},
+			fetcherEntries: []*types.Entry{entry1, entry2},
+			expectEntries: []*types.Entry{
+				{Id: entry1.Id, SpiffeId: entry1.SpiffeId},
+				{Id: entry2.Id, SpiffeId: entry2.SpiffeId},
+			},
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.InfoLevel,
+					Message: "API accessed",
+					Data: logrus.Fields{
+						telemetry.Status:    "success",
+						telemetry.Type:      "audit",
+						telemetry.AgentID:   "spiffe://example.org/spire/agent/foo",
+						telemetry.Selectors: "unix:uid:1000,unix:gid:1000",
+					},
+				},
+			},
+		},
+		{
+			name:      "malformed agent ID",
+			agentID:   &types.SPIFFEID{TrustDomain: "example.org", Path: "/workload"},
+			selectors: selectors,
+			code:      codes.InvalidArgument,
+			err:       "malformed agent ID: \"spiffe://example.org/workload\" is not an agent in trust domain \"example.org\"; path is not in the agent namespace",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Invalid argument: malformed agent ID",
+					Data: logrus.Fields{
+						logrus.ErrorKey: "\"spiffe://example.org/workload\" is not an agent in trust domain \"example.org\"; path is not in the agent namespace",
+					},
+				},
+				{
+					Level:   logrus.InfoLevel,
+					Message: "API accessed",
+					Data: logrus.Fields{
+						telemetry.Status:        "error",
+						telemetry.Type:          "audit",
+						telemetry.StatusCode:    "InvalidArgument",
+						telemetry.StatusMessage: "malformed agent ID: \"spiffe://example.org/workload\" is not an agent in trust domain \"example.org\"; path is not in the agent namespace",
+					},
+				},
+			},
+		},
+		{
+			name:    "no selectors",
+			agentID: matchingAgentID,
+			code:    codes.InvalidArgument,
+			err:     "malformed selectors: empty selector set",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Invalid argument: malformed selectors",
+					Data: logrus.Fields{
+						telemetry.AgentID: "spiffe://example.org/spire/agent/foo",
+						logrus.ErrorKey:   "empty selector set",
+					},
+				},
+				{
+					Level:   logrus.InfoLevel,
+					Message: "API accessed",
+					Data: logrus.Fields{
+						telemetry.Status:        "error",
+						telemetry.Type:          "audit",
+						telemetry.StatusCode:    "InvalidArgument",
+						telemetry.StatusMessage: "malformed selectors: empty selector set",
+						telemetry.AgentID:       "spiffe://example.org/spire/agent/foo",
+						telemetry.Selectors:     "",
+					},
+				},
+			},
+		},
+		{
+			name:      "malformed selector",
+			agentID:   matchingAgentID,
+			selectors: []*types.Selector{{Value: "uid:1000"}},
+			code:      codes.InvalidArgument,
+			err:       "malformed selectors: missing selector type",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Invalid argument: malformed selectors",
+					Data: logrus.Fields{
+						telemetry.AgentID: "spiffe://example.org/spire/agent/foo",
+						logrus.ErrorKey:   "missing selector type",
+					},
+				},
+				{
+					Level:   logrus.InfoLevel,
+					Message: "API accessed",
+					Data: logrus.Fields{
+						telemetry.Status:        "error",
+						telemetry.Type:          "audit",
+						telemetry.StatusCode:    "InvalidArgument",
+						telemetry.StatusMessage: "malformed selectors: missing selector type",
+						telemetry.AgentID:       "spiffe://example.org/spire/agent/foo",
+						telemetry.Selectors:     ":uid:1000",
+					},
+				},
+			},
+		},
+		{
+			name:       "fetcher fails",
+			agentID:    matchingAgentID,
+			selectors:  selectors,
+			fetcherErr: "fetcher fails",
+			code:       codes.Internal,
+			err:        "failed to fetch entries: fetcher fails",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Failed to fetch entries",
+					Data: logrus.Fields{
+						telemetry.AgentID: "spiffe://example.org/spire/agent/foo",
+						logrus.ErrorKey:   "rpc error: code = Internal desc = fetcher fails",
+					},
+				},
+				{
+					Level:   logrus.InfoLevel,
+					Message: "API accessed",
+					Data: logrus.Fields{
+						telemetry.Status:        "error",
+						telemetry.Type:          "audit",
+						telemetry.StatusCode:    "Internal",
+						telemetry.StatusMessage: "failed to fetch entries: fetcher fails",
+						telemetry.AgentID:       "spiffe://example.org/spire/agent/foo",
+						telemetry.Selectors:     "unix:uid:1000,unix:gid:1000",
+					},
+				},
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupServiceTest(t, fakedatastore.New(t))
+			defer test.Cleanup()
+
+			fetcherEntries := make([]*types.Entry, 0, len(tt.fetcherEntries))
+			for _, e := range tt.fetcherEntries {
+				fetcherEntries = append(fetcherEntries, proto.Clone(e).(*types.Entry))
+			}
+			test.ef.entries = fetcherEntries
+			test.ef.err = tt.fetcherErr
+			resp, err := test.client.GetMatchingEntries(ctx, &entryv1.GetMatchingEntriesRequest{
+				AgentId:    tt.agentID,
+				Selectors:  tt.selectors,
+				OutputMask: tt.outputMask,
+			})
+
+			spiretest.AssertLogs(t, test.logHook.AllEntries(), tt.expectLogs)
+			if tt.err != "" {
+				spiretest.RequireGRPCStatus(t, err, tt.code, tt.err)
+				require.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			spiretest.AssertProtoEqual(t, &entryv1.GetMatchingEntriesResponse{
+				Entries: tt.expectEntries,
+			}, resp)
+			require.Equal(t, "spiffe://example.org/spire/agent/foo", test.ef.matchedAgentID.String())
+			spiretest.AssertProtoListEqual(t, tt.selectors, test.ef.matchedSelectors)
+			// The output mask must not be applied to the fetched entries,
+			// since they are shared with the entry cache
+			spiretest.AssertProtoListEqual(t, tt.fetcherEntries, fetcherEntries)
+		})
+	}
+}
+
 func createFederatedBundles(t *testing.T, ds datastore.DataStore) {
 	_, err := ds.CreateBundle(ctx, &common.Bundle{
 		TrustDomainId: federatedTd.IDString(),
@@ -2595,9 +2890,11 @@ func (s *serviceTest) Cleanup() {
 func setupServiceTest(t *testing.T, ds datastore.DataStore) *serviceTest {
 	ef := &entryFetcher{}
 	service := entry.New(entry.Config{
-		TrustDomain:  td,
-		DataStore:    ds,
-		EntryFetcher: ef,
+		TrustDomain:          td,
+		DataStore:            ds,
+		EntryFetcher:         ef,
+		AgentFetcher:         ef,
+		MatchingEntryFetcher: ef,
 	})
 
 	log, logHook := test.NewNullLogger()
@@ -2636,6 +2933,258 @@ func setupServiceTest(t *testing.T, ds datastore.DataStore) *serviceTest {
 	return test
 }
 
+func TestDryRun(t *testing.T) {
+	ds := fakedatastore.New(t)
+	test := setupServiceTest(t, ds)
+	defer test.Cleanup()
+
+	agent1 := td.NewID("agent1")
+	agent2 := td.NewID("agent2")
+	test.ef.agents = map[string][]spiffeid.ID{
+		agent1.String(): {agent1},
+		agent2.String(): {agent2},
+	}
+
+	existing := createTestEntries(t, ds, &common.RegistrationEntry{
+		ParentId:  agent1.String(),
+		SpiffeId:  td.NewID("existing").String(),
+		Selectors: []*common.Selector{{Type: "unix", Value: "uid:1000"}},
+	})[td.NewID("existing").String()]
+
+	dryRunCtx := api.WithDryRun(ctx)
+
+	assertNoChange := func() {
+		resp, err := ds.ListRegistrationEntries(ctx, &datastore.ListRegistrationEntriesRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Entries, 1)
+		spiretest.AssertProtoEqual(t, existing, resp.Entries[0])
+	}
+
+	assertDryRunAudited := func() {
+		entries := test.logHook.AllEntries()
+		require.NotEmpty(t, entries)
+		assert.Equal(t, true, entries[len(entries)-1].Data[telemetry.DryRun])
+		test.logHook.Reset()
+	}
+
+	t.Run("create", func(t *testing.T) {
+		resp, err := test.client.BatchCreateEntry(dryRunCtx, &entryv1.BatchCreateEntryRequest{
+			Entries: []*types.Entry{
+				{
+					ParentId:  api.ProtoFromID(agent2),
+					SpiffeId:  &types.SPIFFEID{TrustDomain: td.String(), Path: "/new"},
+					Selectors: []*types.Selector{{Type: "unix", Value: "uid:1000"}},
+				},
+				{
+					ParentId:  api.ProtoFromID(agent1),
+					SpiffeId:  &types.SPIFFEID{TrustDomain: td.String(), Path: "/existing"},
+					Selectors: []*types.Selector{{Type: "unix", Value: "uid:1000"}},
+				},
+				{
+					ParentId:      api.ProtoFromID(agent1),
+					SpiffeId:      &types.SPIFFEID{TrustDomain: td.String(), Path: "/federated"},
+					Selectors:     []*types.Selector{{Type: "unix", Value: "uid:1000"}},
+					FederatesWith: []string{notFederatedTd.String()},
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 3)
+
+		spiretest.AssertProtoEqual(t, &types.Status{
+			Code:    int32(codes.OK),
+			Message: "dry run; receiving agents: spiffe://example.org/agent2",
+		}, resp.Results[0].Status)
+		assert.Empty(t, resp.Results[0].Entry.Id)
+		assert.Equal(t, "/new", resp.Results[0].Entry.SpiffeId.Path)
+
+		spiretest.AssertProtoEqual(t, &types.Status{
+			Code:    int32(codes.AlreadyExists),
+			Message: "similar entry already exists",
+		}, resp.Results[1].Status)
+		assert.Equal(t, existing.EntryId, resp.Results[1].Entry.Id)
+
+		spiretest.AssertProtoEqual(t, &types.Status{
+			Code:    int32(codes.Internal),
+			Message: `failed to create entry: unable to find federated bundle "spiffe://domain3.org"`,
+		}, resp.Results[2].Status)
+
+		assertNoChange()
+		assertDryRunAudited()
+	})
+
+	t.Run("create with invalid entry", func(t *testing.T) {
+		resp, err := test.client.BatchCreateEntry(dryRunCtx, &entryv1.BatchCreateEntryRequest{
+			Entries: []*types.Entry{
+				{
+					ParentId: api.ProtoFromID(agent2),
+					SpiffeId: &types.SPIFFEID{TrustDomain: td.String(), Path: "/new"},
+					Selectors: []*types.Selector{
+						{Type: "unix", Value: "uid:1000"},
+						{Type: "k8s", Value: "ns:default"},
+					},
+					StoreSvid: true,
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 1)
+
+		spiretest.AssertProtoEqual(t, &types.Status{
+			Code:    int32(codes.Internal),
+			Message: "failed to create entry: invalid registration entry: selector types must be the same when store SVID is enabled",
+		}, resp.Results[0].Status)
+
+		assertNoChange()
+		assertDryRunAudited()
+	})
+
+	t.Run("create for many agents", func(t *testing.T) {
+		group := td.NewID("group")
+		var agents []spiffeid.ID
+		for i := 0; i < 12; i++ {
+			agents = append(agents, td.NewID(fmt.Sprintf("agent%02d", i)))
+		}
+		test.ef.agents[group.String()] = agents
+		defer delete(test.ef.agents, group.String())
+
+		resp, err := test.client.BatchCreateEntry(dryRunCtx, &entryv1.BatchCreateEntryRequest{
+			Entries: []*types.Entry{
+				{
+					ParentId:  api.ProtoFromID(group),
+					SpiffeId:  &types.SPIFFEID{TrustDomain: td.String(), Path: "/new"},
+					Selectors: []*types.Selector{{Type: "unix", Value: "uid:1000"}},
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 1)
+
+		spiretest.AssertProtoEqual(t, &types.Status{
+			Code: int32(codes.OK),
+			Message: "dry run; receiving agents: " +
+				"spiffe://example.org/agent00, spiffe://example.org/agent01, spiffe://example.org/agent02, " +
+				"spiffe://example.org/agent03, spiffe://example.org/agent04, spiffe://example.org/agent05, " +
+				"spiffe://example.org/agent06, spiffe://example.org/agent07, spiffe://example.org/agent08, " +
+				"spiffe://example.org/agent09, and 2 more",
+		}, resp.Results[0].Status)
+
+		assertNoChange()
+		assertDryRunAudited()
+	})
+
+	t.Run("update without input mask", func(t *testing.T) {
+		resp, err := test.client.BatchUpdateEntry(dryRunCtx, &entryv1.BatchUpdateEntryRequest{
+			Entries: []*types.Entry{
+				{
+					Id:        existing.EntryId,
+					ParentId:  api.ProtoFromID(agent2),
+					SpiffeId:  &types.SPIFFEID{TrustDomain: td.String(), Path: "/existing"},
+					Selectors: []*types.Selector{{Type: "unix", Value: "uid:1001"}},
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 1)
+
+		spiretest.AssertProtoEqual(t, &types.Status{
+			Code:    int32(codes.OK),
+			Message: "dry run; receiving agents: spiffe://example.org/agent2; losing agents: spiffe://example.org/agent1",
+		}, resp.Results[0].Status)
+		assert.Equal(t, "/agent2", resp.Results[0].Entry.ParentId.Path)
+		spiretest.AssertProtoListEqual(t, []*types.Selector{{Type: "unix", Value: "uid:1001"}}, resp.Results[0].Entry.Selectors)
+
+		assertNoChange()
+		assertDryRunAudited()
+	})
+
+	t.Run("update", func(t *testing.T) {
+		resp, err := test.client.BatchUpdateEntry(dryRunCtx, &entryv1.BatchUpdateEntryRequest{
+			Entries: []*types.Entry{
+				{
+					Id:       existing.EntryId,
+					ParentId: api.ProtoFromID(agent2),
+				},
+				{
+					Id:       "missing",
+					ParentId: api.ProtoFromID(agent2),
+				},
+			},
+			InputMask: &types.EntryMask{ParentId: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 2)
+
+		spiretest.AssertProtoEqual(t, &types.Status{
+			Code:    int32(codes.OK),
+			Message: "dry run; receiving agents: spiffe://example.org/agent2; losing agents: spiffe://example.org/agent1",
+		}, resp.Results[0].Status)
+		assert.Equal(t, existing.EntryId, resp.Results[0].Entry.Id)
+		assert.Equal(t, "/agent2", resp.Results[0].Entry.ParentId.Path)
+		assert.Equal(t, "/existing", resp.Results[0].Entry.SpiffeId.Path)
+
+		spiretest.AssertProtoEqual(t, &types.Status{
+			Code:    int32(codes.NotFound),
+			Message: "entry not found",
+		}, resp.Results[1].Status)
+
+		assertNoChange()
+		assertDryRunAudited()
+	})
+
+	t.Run("update with stale revision number", func(t *testing.T) {
+		resp, err := test.client.BatchUpdateEntry(dryRunCtx, &entryv1.BatchUpdateEntryRequest{
+			Entries: []*types.Entry{
+				{
+					Id:       existing.EntryId,
+					ParentId: api.ProtoFromID(agent2),
+				},
+			},
+			InputMask:               &types.EntryMask{ParentId: true},
+			ExpectedRevisionNumbers: map[string]int64{existing.EntryId: existing.RevisionNumber + 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 1)
+
+		spiretest.AssertProtoEqual(t, &types.Status{
+			Code:    int32(codes.Aborted),
+			Message: fmt.Sprintf("entry was updated concurrently: expected %d, found %d", existing.RevisionNumber+1, existing.RevisionNumber),
+		}, resp.Results[0].Status)
+
+		assertNoChange()
+		assertDryRunAudited()
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		resp, err := test.client.BatchDeleteEntry(dryRunCtx, &entryv1.BatchDeleteEntryRequest{
+			Ids: []string{existing.EntryId, "missing"},
+		})
+		require.NoError(t, err)
+
+		spiretest.AssertProtoEqual(t, &entryv1.BatchDeleteEntryResponse{
+			Results: []*entryv1.BatchDeleteEntryResponse_Result{
+				{
+					Id: existing.EntryId,
+					Status: &types.Status{
+						Code:    int32(codes.OK),
+						Message: "dry run; losing agents: spiffe://example.org/agent1",
+					},
+				},
+				{
+					Id: "missing",
+					Status: &types.Status{
+						Code:    int32(codes.NotFound),
+						Message: "entry not found",
+					},
+				},
+			},
+		}, resp)
+
+		assertNoChange()
+		assertDryRunAudited()
+	})
+}
+
 func TestBatchUpdateEntry(t *testing.T) {
 	parent := &types.SPIFFEID{TrustDomain: "example.org", Path: "/parent"}
 	entry1SpiffeID := &types.SPIFFEID{TrustDomain: "example.org", Path: "/workload"}
@@ -2684,18 +3233,19 @@ func TestBatchUpdateEntry(t *testing.T) {
 		Downstream:    false,
 	}
 	for _, tt := range []struct {
-		name            string
-		code            codes.Code
-		dsError         error
-		err             string
-		expectDsEntries func(m string) []*types.Entry
-		expectLogs      func(map[string]string) []spiretest.LogEntry
-		expectStatus    *types.Status
-		inputMask       *types.EntryMask
-		outputMask      *types.EntryMask
-		initialEntries  []*types.Entry
-		updateEntries   []*types.Entry
-		expectResults   []*entryv1.BatchUpdateEntryResponse_Result
+		name                    string
+		code                    codes.Code
+		dsError                 error
+		err                     string
+		expectDsEntries         func(m string) []*types.Entry
+		expectLogs              func(map[string]string) []spiretest.LogEntry
+		expectStatus            *types.Status
+		inputMask               *types.EntryMask
+		outputMask              *types.EntryMask
+		initialEntries          []*types.Entry
+		updateEntries           []*types.Entry
+		expectedRevisionNumbers map[string]int64 // keyed by SPIFFE ID path
+		expectResults           []*entryv1.BatchUpdateEntryResponse_Result
 	}{
 		{
 			name:           "Success Update Parent Id",
@@ -3021,6 +3571,254 @@ func TestBatchUpdateEntry(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:           "Success Update JWT-SVID TTL",
+			initialEntries: []*types.Entry{initialEntry},
+			inputMask: &types.EntryMask{
+				JwtSvidTtl: true,
+			},
+			outputMask: &types.EntryMask{
+				JwtSvidTtl: true,
+			},
+			updateEntries: []*types.Entry{
+				{
+					JwtSvidTtl: 300,
+				},
+			},
+			expectDsEntries: func(id string) []*types.Entry {
+				modifiedEntry := proto.Clone(initialEntry).(*types.Entry)
+				modifiedEntry.Id = id
+				modifiedEntry.JwtSvidTtl = 300
+				modifiedEntry.RevisionNumber = 1
+				return []*types.Entry{modifiedEntry}
+			},
+			expectResults: []*entryv1.BatchUpdateEntryResponse_Result{
+				{
+					Status: &types.Status{Code: int32(codes.OK), Message: "OK"},
+					Entry: &types.Entry{
+						JwtSvidTtl: 300,
+					},
+				},
+			},
+			expectLogs: func(m map[string]string) []spiretest.LogEntry {
+				return []spiretest.LogEntry{
+					{
+						Level:   logrus.InfoLevel,
+						Message: "API accessed",
+						Data: logrus.Fields{
+							telemetry.Status:         "success",
+							telemetry.Type:           "audit",
+							telemetry.RegistrationID: m[entry1SpiffeID.Path],
+							telemetry.JWTSVIDTTL:     "300",
+						},
+					},
+				}
+			},
+		},
+		{
+			name:           "Success Update Labels",
+			initialEntries: []*types.Entry{initialEntry},
+			inputMask: &types.EntryMask{
+				Labels: true,
+			},
+			outputMask: &types.EntryMask{
+				Labels: true,
+			},
+			updateEntries: []*types.Entry{
+				{
+					Labels: map[string]string{"team": "payments", "pipeline": "deploy"},
+				},
+			},
+			expectDsEntries: func(id string) []*types.Entry {
+				modifiedEntry := proto.Clone(initialEntry).(*types.Entry)
+				modifiedEntry.Id = id
+				modifiedEntry.Labels = map[string]string{"team": "payments", "pipeline": "deploy"}
+				modifiedEntry.RevisionNumber = 1
+				return []*types.Entry{modifiedEntry}
+			},
+			expectResults: []*entryv1.BatchUpdateEntryResponse_Result{
+				{
+					Status: &types.Status{Code: int32(codes.OK), Message: "OK"},
+					Entry: &types.Entry{
+						Labels: map[string]string{"team": "payments", "pipeline": "deploy"},
+					},
+				},
+			},
+			expectLogs: func(m map[string]string) []spiretest.LogEntry {
+				return []spiretest.LogEntry{
+					{
+						Level:   logrus.InfoLevel,
+						Message: "API accessed",
+						Data: logrus.Fields{
+							telemetry.Status:         "success",
+							telemetry.Type:           "audit",
+							telemetry.RegistrationID: m[entry1SpiffeID.Path],
+							telemetry.Labels:         "pipeline=deploy,team=payments",
+						},
+					},
+				}
+			},
+		},
+		{
+			name:           "Success Update TTL With Expected Revision Number",
+			initialEntries: []*types.Entry{initialEntry},
+			inputMask: &types.EntryMask{
+				Ttl: true,
+			},
+			outputMask: &types.EntryMask{
+				Ttl:            true,
+				RevisionNumber: true,
+			},
+			updateEntries: []*types.Entry{
+				{
+					Ttl: 1000,
+				},
+			},
+			expectedRevisionNumbers: map[string]int64{entry1SpiffeID.Path: 0},
+			expectDsEntries: func(id string) []*types.Entry {
+				modifiedEntry := proto.Clone(initialEntry).(*types.Entry)
+				modifiedEntry.Id = id
+				modifiedEntry.Ttl = 1000
+				modifiedEntry.RevisionNumber = 1
+				return []*types.Entry{modifiedEntry}
+			},
+			expectResults: []*entryv1.BatchUpdateEntryResponse_Result{
+				{
+					Status: &types.Status{Code: int32(codes.OK), Message: "OK"},
+					Entry: &types.Entry{
+						Ttl:            1000,
+						RevisionNumber: 1,
+					},
+				},
+			},
+			expectLogs: func(m map[string]string) []spiretest.LogEntry {
+				return []spiretest.LogEntry{
+					{
+						Level:   logrus.InfoLevel,
+						Message: "API accessed",
+						Data: logrus.Fields{
+							telemetry.Status:                 "success",
+							telemetry.Type:                   "audit",
+							telemetry.RegistrationID:         m[entry1SpiffeID.Path],
+							telemetry.TTL:                    "1000",
+							telemetry.ExpectedRevisionNumber: "0",
+						},
+					},
+				}
+			},
+		},
+		{
+			name:           "Fail Update TTL With Stale Revision Number",
+			initialEntries: []*types.Entry{initialEntry},
+			inputMask: &types.EntryMask{
+				Ttl: true,
+			},
+			updateEntries: []*types.Entry{
+				{
+					Ttl: 1000,
+				},
+			},
+			expectedRevisionNumbers: map[string]int64{entry1SpiffeID.Path: 1},
+			expectDsEntries: func(id string) []*types.Entry {
+				modifiedEntry := proto.Clone(initialEntry).(*types.Entry)
+				modifiedEntry.Id = id
+				return []*types.Entry{modifiedEntry}
+			},
+			expectResults: []*entryv1.BatchUpdateEntryResponse_Result{
+				{
+					Status: &types.Status{
+						Code:    int32(codes.Aborted),
+						Message: "entry was updated concurrently: datastore-sql: registration entry revision number mismatch: expected 1, found 0",
+					},
+				},
+			},
+			expectLogs: func(m map[string]string) []spiretest.LogEntry {
+				return []spiretest.LogEntry{
+					{
+						Level:   logrus.ErrorLevel,
+						Message: "Entry was updated concurrently",
+						Data: logrus.Fields{
+							telemetry.RegistrationID: m[entry1SpiffeID.Path],
+							logrus.ErrorKey:          "rpc error: code = Aborted desc = datastore-sql: registration entry revision number mismatch: expected 1, found 0",
+						},
+					},
+					{
+						Level:   logrus.InfoLevel,
+						Message: "API accessed",
+						Data: logrus.Fields{
+							telemetry.Status:                 "error",
+							telemetry.Type:                   "audit",
+							telemetry.RegistrationID:         m[entry1SpiffeID.Path],
+							telemetry.StatusCode:             "Aborted",
+							telemetry.StatusMessage:          "entry was updated concurrently: datastore-sql: registration entry revision number mismatch: expected 1, found 0",
+							telemetry.TTL:                    "1000",
+							telemetry.ExpectedRevisionNumber: "1",
+						},
+					},
+				}
+			},
+		},
+		{
+			name:           "Fail Update Without Input Mask With Stale Revision Number",
+			initialEntries: []*types.Entry{initialEntry},
+			updateEntries: []*types.Entry{
+				func() *types.Entry {
+					e := proto.Clone(initialEntry).(*types.Entry)
+					e.Ttl = 1000
+					return e
+				}(),
+			},
+			expectedRevisionNumbers: map[string]int64{entry1SpiffeID.Path: 1},
+			expectDsEntries: func(id string) []*types.Entry {
+				modifiedEntry := proto.Clone(initialEntry).(*types.Entry)
+				modifiedEntry.Id = id
+				return []*types.Entry{modifiedEntry}
+			},
+			expectResults: []*entryv1.BatchUpdateEntryResponse_Result{
+				{
+					Status: &types.Status{
+						Code:    int32(codes.Aborted),
+						Message: "entry was updated concurrently: datastore-sql: registration entry revision number mismatch: expected 1, found 0",
+					},
+				},
+			},
+			expectLogs: func(m map[string]string) []spiretest.LogEntry {
+				return []spiretest.LogEntry{
+					{
+						Level:   logrus.ErrorLevel,
+						Message: "Entry was updated concurrently",
+						Data: logrus.Fields{
+							telemetry.RegistrationID: m[entry1SpiffeID.Path],
+							logrus.ErrorKey:          "rpc error: code = Aborted desc = datastore-sql: registration entry revision number mismatch: expected 1, found 0",
+						},
+					},
+					{
+						Level:   logrus.InfoLevel,
+						Message: "API accessed",
+						Data: logrus.Fields{
+							telemetry.Status:                 "error",
+							telemetry.Type:                   "audit",
+							telemetry.RegistrationID:         m[entry1SpiffeID.Path],
+							telemetry.StatusCode:             "Aborted",
+							telemetry.StatusMessage:          "entry was updated concurrently: datastore-sql: registration entry revision number mismatch: expected 1, found 0",
+							telemetry.Admin:                  "true",
+							telemetry.DNSName:                "dns1,dns2",
+							telemetry.Downstream:             "true",
+							telemetry.ExpiresAt:              strconv.FormatInt(expiresAt, 10),
+							telemetry.FederatesWith:          federatedTd.String(),
+							telemetry.ParentID:               "spiffe://example.org/parent",
+							telemetry.RevisionNumber:         "0",
+							telemetry.Selectors:              "unix:uid:1000,unix:uid:2000",
+							telemetry.SPIFFEID:               "spiffe://example.org/workload",
+							telemetry.StoreSvid:              "false",
+							telemetry.TTL:                    "1000",
+							telemetry.JWTSVIDTTL:             "0",
+							telemetry.ExpectedRevisionNumber: "1",
+						},
+					},
+				}
+			},
+		},
 		{
 			name:           "Success Update FederatesWith",
 			initialEntries: []*types.Entry{initialEntry},
@@ -3627,6 +4425,7 @@ func TestBatchUpdateEntry(t *testing.T) {
 							telemetry.SPIFFEID:       "spiffe://example.org/validUpdated",
 							telemetry.TTL:            "500000",
 							telemetry.StoreSvid:      "false",
+							telemetry.JWTSVIDTTL:     "0",
 						},
 					},
 				}
@@ -3789,11 +4588,20 @@ func TestBatchUpdateEntry(t *testing.T) {
 			// Clean creation logs
 			test.logHook.Reset()
 
+			var expectedRevisionNumbers map[string]int64
+			for path, revision := range tt.expectedRevisionNumbers {
+				if expectedRevisionNumbers == nil {
+					expectedRevisionNumbers = make(map[string]int64)
+				}
+				expectedRevisionNumbers[spiffeToIDMap[path]] = revision
+			}
+
 			// Actually do the update, with the proper IDs
 			resp, err := test.client.BatchUpdateEntry(ctx, &entryv1.BatchUpdateEntryRequest{
-				Entries:    updateEntries,
-				InputMask:  tt.inputMask,
-				OutputMask: tt.outputMask,
+				Entries:                 updateEntries,
+				InputMask:               tt.inputMask,
+				OutputMask:              tt.outputMask,
+				ExpectedRevisionNumbers: expectedRevisionNumbers,
 			})
 			require.NoError(t, err)
 
@@ -3881,6 +4689,12 @@ func (f *fakeDS) CreateOrReturnRegistrationEntry(ctx context.Context, entry *com
 type entryFetcher struct {
 	err     string
 	entries []*types.Entry
+	// agents holds the agents authorized for the entries with a parent ID
+	agents map[string][]spiffeid.ID
+	// matchedAgentID and matchedSelectors hold the arguments of the last
+	// matching entries lookup
+	matchedAgentID   spiffeid.ID
+	matchedSelectors []*types.Selector
 }
 
 func (f *entryFetcher) FetchAuthorizedEntries(ctx context.Context, agentID spiffeid.ID) ([]*types.Entry, error) {
@@ -3899,3 +4713,21 @@ func (f *entryFetcher) FetchAuthorizedEntries(ctx context.Context, agentID spiff
 
 	return f.entries, nil
 }
+
+func (f *entryFetcher) FetchMatchingEntries(ctx context.Context, agentID spiffeid.ID, selectors []*types.Selector) ([]*types.Entry, error) {
+	if f.err != "" {
+		return nil, status.Error(codes.Internal, f.err)
+	}
+
+	f.matchedAgentID = agentID
+	f.matchedSelectors = selectors
+	return f.entries, nil
+}
+
+func (f *entryFetcher) FetchAuthorizedAgents(ctx context.Context, entry *types.Entry) ([]spiffeid.ID, error) {
+	parentID, err := spiffeid.New(entry.ParentId.TrustDomain, entry.ParentId.Path)
+	if err != nil {
+		return nil, err
+	}
+	return f.agents[parentID.String()], nil
+}
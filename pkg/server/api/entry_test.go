@@ -45,6 +45,7 @@ func TestRegistrationEntryToProto(t *testing.T) {
 				DnsNames:       []string{"dns1", "dns2"},
 				Downstream:     true,
 				RevisionNumber: 99,
+				Labels:         map[string]string{"team": "payments"},
 			},
 			expectEntry: &types.Entry{
 				Id:       "entry1",
@@ -64,6 +65,7 @@ func TestRegistrationEntryToProto(t *testing.T) {
 				DnsNames:       []string{"dns1", "dns2"},
 				Downstream:     true,
 				RevisionNumber: 99,
+				Labels:         map[string]string{"team": "payments"},
 			},
 		},
 		{
@@ -137,6 +139,7 @@ func TestProtoToRegistrationEntryWithMask(t *testing.T) {
 				DnsNames:       []string{"dns1", "dns2"},
 				Downstream:     true,
 				RevisionNumber: 99,
+				Labels:         map[string]string{"team": "payments"},
 			},
 			expectEntry: &common.RegistrationEntry{
 				EntryId:  "entry1",
@@ -156,6 +159,7 @@ func TestProtoToRegistrationEntryWithMask(t *testing.T) {
 				DnsNames:       []string{"dns1", "dns2"},
 				Downstream:     true,
 				RevisionNumber: 99,
+				Labels:         map[string]string{"team": "payments"},
 			},
 			mask: protoutil.AllTrueEntryMask,
 		},
@@ -172,6 +176,7 @@ func TestProtoToRegistrationEntryWithMask(t *testing.T) {
 				Downstream:     true,
 				ExpiresAt:      4,
 				RevisionNumber: 99,
+				Labels:         map[string]string{"team": "payments"},
 			},
 			expectEntry: &common.RegistrationEntry{
 				EntryId: "entry1",
@@ -228,6 +233,7 @@ func TestProtoToRegistrationEntry(t *testing.T) {
 				DnsNames:       []string{"dns1", "dns2"},
 				Downstream:     true,
 				RevisionNumber: 99,
+				Labels:         map[string]string{"team": "payments"},
 			},
 			expectEntry: &common.RegistrationEntry{
 				EntryId:  "entry1",
@@ -247,6 +253,7 @@ func TestProtoToRegistrationEntry(t *testing.T) {
 				DnsNames:       []string{"dns1", "dns2"},
 				Downstream:     true,
 				RevisionNumber: 99,
+				Labels:         map[string]string{"team": "payments"},
 			},
 		},
 		{
@@ -293,6 +300,16 @@ func TestProtoToRegistrationEntry(t *testing.T) {
 				DnsNames:  []string{"abc-"},
 			},
 		},
+		{
+			name: "empty label name",
+			err:  "label name cannot be empty",
+			entry: &types.Entry{
+				SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/foo"},
+				ParentId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/bar"},
+				Selectors: []*types.Selector{{Type: "unix", Value: "uid:1000"}},
+				Labels:    map[string]string{"": "payments"},
+			},
+		},
 		{
 			name: "malformed federated trust domain",
 			err:  `invalid federated trust domain: spiffeid: unable to parse: parse "spiffe://malformed td":`,
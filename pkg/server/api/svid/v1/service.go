@@ -330,12 +330,17 @@ func (s *Service) NewJWTSVID(ctx context.Context, req *svidv1.NewJWTSVIDRequest)
 		return nil, api.MakeErr(log, codes.NotFound, "entry not found or not authorized", nil)
 	}
 
-	jwtsvid, err := s.mintJWTSVID(ctx, entry.SpiffeId, req.Audience, entry.Ttl)
+	ttl := entry.Ttl
+	if entry.JwtSvidTtl > 0 {
+		ttl = entry.JwtSvidTtl
+	}
+
+	jwtsvid, err := s.mintJWTSVID(ctx, entry.SpiffeId, req.Audience, ttl)
 	if err != nil {
 		return nil, err
 	}
 	rpccontext.AuditRPCWithFields(ctx, logrus.Fields{
-		telemetry.TTL: entry.Ttl,
+		telemetry.TTL: ttl,
 	})
 
 	return &svidv1.NewJWTSVIDResponse{
@@ -838,13 +838,20 @@ func TestServiceNewJWTSVID(t *testing.T) {
 		SpiffeId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/agent-ttl"},
 		Ttl:      10,
 	}
+	entryWithJWTSVIDTTL := &types.Entry{
+		Id:         "agent-entry-jwt-svid-ttl-id",
+		ParentId:   api.ProtoFromID(agentID),
+		SpiffeId:   &types.SPIFFEID{TrustDomain: "example.org", Path: "/agent-jwt-svid-ttl"},
+		Ttl:        10,
+		JwtSvidTtl: 20,
+	}
 	invalidEntry := &types.Entry{
 		Id:       "invalid-entry",
 		ParentId: api.ProtoFromID(agentID),
 		SpiffeId: &types.SPIFFEID{},
 	}
 
-	test.ef.entries = []*types.Entry{entry, entryWithTTL, invalidEntry}
+	test.ef.entries = []*types.Entry{entry, entryWithTTL, entryWithJWTSVIDTTL, invalidEntry}
 	jwtKey := test.ca.JWTKey()
 	now := test.ca.Clock().Now().UTC()
 
@@ -902,6 +909,25 @@ func TestServiceNewJWTSVID(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:      "success custom JWT-SVID TTL",
+			audience:  []string{"AUDIENCE"},
+			entry:     entryWithJWTSVIDTTL,
+			expiresAt: now.Add(20 * time.Second),
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.InfoLevel,
+					Message: "API accessed",
+					Data: logrus.Fields{
+						telemetry.Status:         "success",
+						telemetry.Type:           "audit",
+						telemetry.Audience:       "AUDIENCE",
+						telemetry.RegistrationID: "agent-entry-jwt-svid-ttl-id",
+						telemetry.TTL:            "20",
+					},
+				},
+			},
+		},
 		{
 			name:     "no SPIFFE ID",
 			code:     codes.InvalidArgument,
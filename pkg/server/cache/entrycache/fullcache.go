@@ -2,6 +2,7 @@ package entrycache
 
 import (
 	"context"
+	"sort"
 	"sync"
 
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
@@ -28,6 +29,8 @@ var _ Cache = (*FullEntryCache)(nil)
 // at a particular moment in time.
 type Cache interface {
 	GetAuthorizedEntries(agentID spiffeid.ID) []*types.Entry
+	GetMatchingEntries(agentID spiffeid.ID, selectors []*types.Selector) []*types.Entry
+	GetAuthorizedAgents(entry *types.Entry) []spiffeid.ID
 }
 
 // Selector is a key-value attribute of a node or workload.
@@ -85,8 +88,20 @@ type Agent struct {
 }
 
 type FullEntryCache struct {
+	mu      sync.RWMutex
 	aliases map[spiffeID][]aliasEntry
 	entries map[spiffeID][]*types.Entry
+
+	// workloadsBySel indexes the entries that are not node aliases by each
+	// of their selectors, keyed by entry ID. workloadsByID indexes them by
+	// SPIFFE ID so that their parents can be walked up to an agent.
+	workloadsBySel map[Selector]map[string]*types.Entry
+	workloadsByID  map[spiffeID][]*types.Entry
+
+	// The following are only needed to apply incremental updates
+	entriesByID map[string]*types.Entry
+	agents      map[spiffeID]selectorSet
+	bysel       map[Selector][]aliasInfo
 }
 
 type selectorSet map[Selector]struct{}
@@ -105,33 +120,26 @@ type aliasEntry struct {
 	entry *types.Entry
 }
 
+type aliasInfo struct {
+	aliasEntry
+	selectors selectorSet
+}
+
 // Build queries the data source for all registration entries and Agent selectors and builds an in-memory
 // representation of the data that can be used for efficient lookups.
 func Build(ctx context.Context, entryIter EntryIterator, agentIter AgentIterator) (*FullEntryCache, error) {
-	type aliasInfo struct {
-		aliasEntry
-		selectors selectorSet
+	c := &FullEntryCache{
+		aliases:        make(map[spiffeID][]aliasEntry),
+		entries:        make(map[spiffeID][]*types.Entry),
+		workloadsBySel: make(map[Selector]map[string]*types.Entry),
+		workloadsByID:  make(map[spiffeID][]*types.Entry),
+		entriesByID:    make(map[string]*types.Entry),
+		agents:         make(map[spiffeID]selectorSet),
+		bysel:          make(map[Selector][]aliasInfo),
 	}
-	bysel := make(map[Selector][]aliasInfo)
 
-	entries := make(map[spiffeID][]*types.Entry)
 	for entryIter.Next(ctx) {
-		entry := entryIter.Entry()
-		parentID := spiffeIDFromProto(entry.ParentId)
-		if parentID.Path == "/spire/server" {
-			alias := aliasInfo{
-				aliasEntry: aliasEntry{
-					id:    spiffeIDFromProto(entry.SpiffeId),
-					entry: entry,
-				},
-				selectors: selectorSetFromProto(entry.Selectors),
-			}
-			for selector := range alias.selectors {
-				bysel[selector] = append(bysel[selector], alias)
-			}
-			continue
-		}
-		entries[parentID] = append(entries[parentID], entry)
+		c.addEntry(entryIter.Entry())
 	}
 	if err := entryIter.Err(); err != nil {
 		return nil, err
@@ -140,44 +148,197 @@ func Build(ctx context.Context, entryIter EntryIterator, agentIter AgentIterator
 	aliasSeen := allocStringSet()
 	defer freeStringSet(aliasSeen)
 
-	aliases := make(map[spiffeID][]aliasEntry)
 	for agentIter.Next(ctx) {
 		agent := agentIter.Agent()
 		agentID := spiffeIDFromID(agent.ID)
 		agentSelectors := selectorSetFromProto(agent.Selectors)
-		// track which aliases we've evaluated so far to make sure we don't
-		// add one twice.
-		clearStringSet(aliasSeen)
-		for s := range agentSelectors {
-			for _, alias := range bysel[s] {
-				if _, ok := aliasSeen[alias.entry.Id]; ok {
-					continue
-				}
-				aliasSeen[alias.entry.Id] = struct{}{}
-				if isSubset(alias.selectors, agentSelectors) {
-					aliases[agentID] = append(aliases[agentID], alias.aliasEntry)
-				}
-			}
-		}
+		c.agents[agentID] = agentSelectors
+		c.addAgentAliases(agentID, agentSelectors, aliasSeen)
 	}
 	if err := agentIter.Err(); err != nil {
 		return nil, err
 	}
 
-	return &FullEntryCache{
-		aliases: aliases,
-		entries: entries,
-	}, nil
+	return c, nil
+}
+
+// Updates holds the registration entries and agents that changed in the
+// data source, keyed by entry ID and agent SPIFFE ID respectively. A nil
+// value means that the entry or agent no longer exists.
+type Updates struct {
+	Entries map[string]*types.Entry
+	Agents  map[spiffeid.ID]*Agent
+}
+
+// ApplyUpdates applies the given changes to the cache. It is safe to call
+// concurrently with GetAuthorizedEntries.
+func (c *FullEntryCache) ApplyUpdates(updates *Updates) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for entryID, entry := range updates.Entries {
+		c.removeEntry(entryID)
+		if entry != nil {
+			c.addEntry(entry)
+		}
+	}
+
+	aliasSeen := allocStringSet()
+	defer freeStringSet(aliasSeen)
+
+	for id, agent := range updates.Agents {
+		agentID := spiffeIDFromID(id)
+		delete(c.agents, agentID)
+		delete(c.aliases, agentID)
+		if agent == nil {
+			continue
+		}
+		agentSelectors := selectorSetFromProto(agent.Selectors)
+		c.agents[agentID] = agentSelectors
+		c.addAgentAliases(agentID, agentSelectors, aliasSeen)
+	}
 }
 
 // GetAuthorizedEntries gets all authorized registration entries for a given Agent SPIFFE ID.
 func (c *FullEntryCache) GetAuthorizedEntries(agentID spiffeid.ID) []*types.Entry {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	seen := allocSeenSet()
 	defer freeSeenSet(seen)
 
 	return c.getAuthorizedEntries(spiffeIDFromID(agentID), seen)
 }
 
+// GetMatchingEntries gets the registration entries authorized for a given
+// Agent SPIFFE ID that a workload with the given selectors would receive,
+// i.e. the entries whose selectors are all contained in the given selectors.
+// Node alias entries are not included. Only the aliases of the agent itself
+// are followed, not those of other agents whose SPIFFE ID is the SPIFFE ID
+// of an entry authorized for the agent.
+func (c *FullEntryCache) GetMatchingEntries(agentID spiffeid.ID, selectors []*types.Selector) []*types.Entry {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	// Count how many of the given selectors each candidate entry has. An
+	// entry matches when all of its selectors were counted.
+	hits := make(map[string]int)
+	for selector := range selectorSetFromProto(selectors) {
+		for entryID := range c.workloadsBySel[selector] {
+			hits[entryID]++
+		}
+	}
+
+	id := spiffeIDFromID(agentID)
+	aliasIDs := make(map[spiffeID]struct{}, len(c.aliases[id]))
+	for _, alias := range c.aliases[id] {
+		aliasIDs[alias.id] = struct{}{}
+	}
+	reachable := make(map[spiffeID]bool)
+
+	var entries []*types.Entry
+	for entryID, count := range hits {
+		entry := c.entriesByID[entryID]
+		if count != len(selectorSetFromProto(entry.Selectors)) {
+			continue
+		}
+		if c.isReachable(id, aliasIDs, spiffeIDFromProto(entry.ParentId), reachable) {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Id < entries[j].Id
+	})
+	return entries
+}
+
+// GetAuthorizedAgents gets the SPIFFE IDs of the Agents that the given
+// registration entry is, or would be once stored, authorized for. The entry
+// itself does not need to be in the cache.
+func (c *FullEntryCache) GetAuthorizedAgents(entry *types.Entry) []spiffeid.ID {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	agents := make(map[spiffeID]struct{})
+	parentID := spiffeIDFromProto(entry.ParentId)
+	if isNodeAliasParent(parentID) {
+		// Aliases without selectors are never matched
+		aliasSelectors := selectorSetFromProto(entry.Selectors)
+		if len(aliasSelectors) > 0 {
+			for agentID, agentSelectors := range c.agents {
+				if isSubset(aliasSelectors, agentSelectors) {
+					agents[agentID] = struct{}{}
+				}
+			}
+		}
+	} else {
+		seen := allocSeenSet()
+		defer freeSeenSet(seen)
+		c.collectAgents(parentID, agents, seen)
+	}
+
+	agentIDs := make([]spiffeid.ID, 0, len(agents))
+	for agentID := range agents {
+		id, err := spiffeid.New(agentID.TrustDomain, agentID.Path)
+		if err != nil {
+			continue
+		}
+		agentIDs = append(agentIDs, id)
+	}
+	sort.Slice(agentIDs, func(i, j int) bool {
+		return agentIDs[i].String() < agentIDs[j].String()
+	})
+	return agentIDs
+}
+
+// collectAgents collects the Agents that entries parented to the given ID
+// are authorized for, walking up the entries and aliases with that SPIFFE ID.
+func (c *FullEntryCache) collectAgents(id spiffeID, agents map[spiffeID]struct{}, seen seenSet) {
+	if _, ok := seen[id]; ok {
+		return
+	}
+	seen[id] = struct{}{}
+
+	if _, ok := c.agents[id]; ok {
+		agents[id] = struct{}{}
+	}
+	for agentID, aliases := range c.aliases {
+		for _, alias := range aliases {
+			if alias.id == id {
+				c.collectAgents(agentID, agents, seen)
+				break
+			}
+		}
+	}
+	for _, entry := range c.workloadsByID[id] {
+		c.collectAgents(spiffeIDFromProto(entry.ParentId), agents, seen)
+	}
+}
+
+// isReachable returns whether entries parented to the given ID are
+// authorized for the agent, by walking up the entries with that SPIFFE ID
+// until the agent or one of its aliases is found. The results are memoized
+// in the reachable map, which also stops the walk on cycles.
+func (c *FullEntryCache) isReachable(agentID spiffeID, aliasIDs map[spiffeID]struct{}, parentID spiffeID, reachable map[spiffeID]bool) bool {
+	if parentID == agentID {
+		return true
+	}
+	if _, ok := aliasIDs[parentID]; ok {
+		return true
+	}
+	if ok, visited := reachable[parentID]; visited {
+		return ok
+	}
+	reachable[parentID] = false
+	for _, entry := range c.workloadsByID[parentID] {
+		if c.isReachable(agentID, aliasIDs, spiffeIDFromProto(entry.ParentId), reachable) {
+			reachable[parentID] = true
+			return true
+		}
+	}
+	return false
+}
+
 func (c *FullEntryCache) getAuthorizedEntries(id spiffeID, seen map[spiffeID]struct{}) []*types.Entry {
 	entries := c.crawl(id, seen)
 	for _, descendant := range entries {
@@ -205,6 +366,135 @@ func (c *FullEntryCache) crawl(parentID spiffeID, seen map[spiffeID]struct{}) []
 	return entries
 }
 
+func (c *FullEntryCache) addEntry(entry *types.Entry) {
+	c.entriesByID[entry.Id] = entry
+
+	parentID := spiffeIDFromProto(entry.ParentId)
+	if !isNodeAliasParent(parentID) {
+		c.entries[parentID] = append(c.entries[parentID], entry)
+		id := spiffeIDFromProto(entry.SpiffeId)
+		c.workloadsByID[id] = append(c.workloadsByID[id], entry)
+		for selector := range selectorSetFromProto(entry.Selectors) {
+			workloads, ok := c.workloadsBySel[selector]
+			if !ok {
+				workloads = make(map[string]*types.Entry)
+				c.workloadsBySel[selector] = workloads
+			}
+			workloads[entry.Id] = entry
+		}
+		return
+	}
+
+	alias := aliasInfo{
+		aliasEntry: aliasEntry{
+			id:    spiffeIDFromProto(entry.SpiffeId),
+			entry: entry,
+		},
+		selectors: selectorSetFromProto(entry.Selectors),
+	}
+	for selector := range alias.selectors {
+		c.bysel[selector] = append(c.bysel[selector], alias)
+	}
+
+	// Agents are only known at this point when applying updates. Aliases
+	// without selectors are never matched, same as when building.
+	if len(alias.selectors) == 0 {
+		return
+	}
+	for agentID, agentSelectors := range c.agents {
+		if isSubset(alias.selectors, agentSelectors) {
+			c.aliases[agentID] = append(c.aliases[agentID], alias.aliasEntry)
+		}
+	}
+}
+
+func (c *FullEntryCache) removeEntry(entryID string) {
+	entry, ok := c.entriesByID[entryID]
+	if !ok {
+		return
+	}
+	delete(c.entriesByID, entryID)
+
+	parentID := spiffeIDFromProto(entry.ParentId)
+	if !isNodeAliasParent(parentID) {
+		c.entries[parentID] = removeEntryByID(c.entries[parentID], entryID)
+		if len(c.entries[parentID]) == 0 {
+			delete(c.entries, parentID)
+		}
+		id := spiffeIDFromProto(entry.SpiffeId)
+		c.workloadsByID[id] = removeEntryByID(c.workloadsByID[id], entryID)
+		if len(c.workloadsByID[id]) == 0 {
+			delete(c.workloadsByID, id)
+		}
+		for selector := range selectorSetFromProto(entry.Selectors) {
+			delete(c.workloadsBySel[selector], entryID)
+			if len(c.workloadsBySel[selector]) == 0 {
+				delete(c.workloadsBySel, selector)
+			}
+		}
+		return
+	}
+
+	for selector := range selectorSetFromProto(entry.Selectors) {
+		aliases := c.bysel[selector][:0]
+		for _, alias := range c.bysel[selector] {
+			if alias.entry.Id != entryID {
+				aliases = append(aliases, alias)
+			}
+		}
+		if len(aliases) == 0 {
+			delete(c.bysel, selector)
+			continue
+		}
+		c.bysel[selector] = aliases
+	}
+
+	for agentID := range c.aliases {
+		aliases := c.aliases[agentID][:0]
+		for _, alias := range c.aliases[agentID] {
+			if alias.entry.Id != entryID {
+				aliases = append(aliases, alias)
+			}
+		}
+		if len(aliases) == 0 {
+			delete(c.aliases, agentID)
+			continue
+		}
+		c.aliases[agentID] = aliases
+	}
+}
+
+func (c *FullEntryCache) addAgentAliases(agentID spiffeID, agentSelectors selectorSet, aliasSeen stringSet) {
+	// track which aliases we've evaluated so far to make sure we don't
+	// add one twice.
+	clearStringSet(aliasSeen)
+	for s := range agentSelectors {
+		for _, alias := range c.bysel[s] {
+			if _, ok := aliasSeen[alias.entry.Id]; ok {
+				continue
+			}
+			aliasSeen[alias.entry.Id] = struct{}{}
+			if isSubset(alias.selectors, agentSelectors) {
+				c.aliases[agentID] = append(c.aliases[agentID], alias.aliasEntry)
+			}
+		}
+	}
+}
+
+func isNodeAliasParent(id spiffeID) bool {
+	return id.Path == "/spire/server"
+}
+
+func removeEntryByID(entries []*types.Entry, entryID string) []*types.Entry {
+	out := entries[:0]
+	for _, entry := range entries {
+		if entry.Id != entryID {
+			out = append(out, entry)
+		}
+	}
+	return out
+}
+
 func spiffeIDFromID(id spiffeid.ID) spiffeID {
 	return spiffeID{
 		TrustDomain: id.TrustDomain().String(),
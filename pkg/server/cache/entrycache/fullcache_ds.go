@@ -19,19 +19,33 @@ var (
 
 // BuildFromDataStore builds a Cache using the provided datastore as the data source
 func BuildFromDataStore(ctx context.Context, ds datastore.DataStore) (*FullEntryCache, error) {
-	return Build(ctx, makeEntryIteratorDS(ds), makeAgentIteratorDS(ds))
+	return buildFromDataStore(ctx, ds, datastore.TolerateStale)
+}
+
+func buildFromDataStore(ctx context.Context, ds datastore.DataStore, dataConsistency datastore.DataConsistency) (*FullEntryCache, error) {
+	entryIter := &entryIteratorDS{
+		ds:              ds,
+		dataConsistency: dataConsistency,
+	}
+	agentIter := &agentIteratorDS{
+		ds:              ds,
+		dataConsistency: dataConsistency,
+	}
+	return Build(ctx, entryIter, agentIter)
 }
 
 type entryIteratorDS struct {
-	ds      datastore.DataStore
-	entries []*types.Entry
-	next    int
-	err     error
+	ds              datastore.DataStore
+	dataConsistency datastore.DataConsistency
+	entries         []*types.Entry
+	next            int
+	err             error
 }
 
 func makeEntryIteratorDS(ds datastore.DataStore) EntryIterator {
 	return &entryIteratorDS{
-		ds: ds,
+		ds:              ds,
+		dataConsistency: datastore.TolerateStale,
 	}
 }
 
@@ -41,7 +55,7 @@ func (it *entryIteratorDS) Next(ctx context.Context) bool {
 	}
 	if it.entries == nil {
 		req := &datastore.ListRegistrationEntriesRequest{
-			DataConsistency: datastore.TolerateStale,
+			DataConsistency: it.dataConsistency,
 		}
 
 		resp, err := it.ds.ListRegistrationEntries(ctx, req)
@@ -69,20 +83,26 @@ func (it *entryIteratorDS) Next(ctx context.Context) bool {
 func (it *entryIteratorDS) filterEntries(in []*common.RegistrationEntry) []*common.RegistrationEntry {
 	out := make([]*common.RegistrationEntry, 0, len(in))
 	for _, entry := range in {
-		// Filter out entries with invalid SPIFFE IDs. Operators are notified
-		// that they are ignored on server startup (see
-		// pkg/server/scanentries.go)
-		if err := idutil.CheckIDStringNormalization(entry.SpiffeId); err != nil {
-			continue
-		}
-		if err := idutil.CheckIDStringNormalization(entry.ParentId); err != nil {
-			continue
+		if isCacheableEntry(entry) {
+			out = append(out, entry)
 		}
-		out = append(out, entry)
 	}
 	return out
 }
 
+// isCacheableEntry filters out entries with invalid SPIFFE IDs. Operators are
+// notified that they are ignored on server startup (see
+// pkg/server/scanentries.go)
+func isCacheableEntry(entry *common.RegistrationEntry) bool {
+	if err := idutil.CheckIDStringNormalization(entry.SpiffeId); err != nil {
+		return false
+	}
+	if err := idutil.CheckIDStringNormalization(entry.ParentId); err != nil {
+		return false
+	}
+	return true
+}
+
 func (it *entryIteratorDS) Entry() *types.Entry {
 	return it.entries[it.next-1]
 }
@@ -92,15 +112,17 @@ func (it *entryIteratorDS) Err() error {
 }
 
 type agentIteratorDS struct {
-	ds     datastore.DataStore
-	agents []Agent
-	next   int
-	err    error
+	ds              datastore.DataStore
+	dataConsistency datastore.DataConsistency
+	agents          []Agent
+	next            int
+	err             error
 }
 
 func makeAgentIteratorDS(ds datastore.DataStore) AgentIterator {
 	return &agentIteratorDS{
-		ds: ds,
+		ds:              ds,
+		dataConsistency: datastore.TolerateStale,
 	}
 }
 
@@ -135,7 +157,7 @@ func (it *agentIteratorDS) Err() error {
 func (it *agentIteratorDS) fetchAgents(ctx context.Context) ([]Agent, error) {
 	now := time.Now()
 	resp, err := it.ds.ListNodeSelectors(ctx, &datastore.ListNodeSelectorsRequest{
-		DataConsistency: datastore.TolerateStale,
+		DataConsistency: it.dataConsistency,
 		ValidAt:         now,
 	})
 	if err != nil {
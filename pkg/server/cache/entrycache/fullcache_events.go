@@ -0,0 +1,296 @@
+package entrycache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/andres-erbsen/clock"
+	"github.com/sirupsen/logrus"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
+	"github.com/spiffe/spire/pkg/server/api"
+	"github.com/spiffe/spire/pkg/server/datastore"
+)
+
+const (
+	// eventGapTimeout is how long a skipped event ID is waited for before
+	// the cache is rebuilt. Event IDs can be observed out of order when the
+	// transactions recording them commit out of order.
+	eventGapTimeout = time.Minute
+
+	// maxSkippedEvents is the number of skipped event IDs over which the
+	// cache is rebuilt right away.
+	maxSkippedEvents = 1000
+)
+
+var errEventGap = errors.New("gap detected in datastore events")
+
+// EventHydrator keeps a FullEntryCache up to date with a datastore. The
+// cache is built from scratch the first time it is hydrated. After that, only
+// the registration entries and agents named by the events recorded in the
+// datastore are fetched and applied to the cache. The cache is rebuilt from
+// scratch when a gap is detected in the events.
+//
+// Agents that expire without any further change stay in the cache until it
+// is rebuilt. Expired agents cannot authenticate to fetch their entries.
+type EventHydrator struct {
+	ds  datastore.DataStore
+	log logrus.FieldLogger
+	clk clock.Clock
+
+	cache       *FullEntryCache
+	entryCursor eventCursor
+	nodeCursor  eventCursor
+}
+
+// NewEventHydrator creates a new EventHydrator for the given datastore
+func NewEventHydrator(ds datastore.DataStore, log logrus.FieldLogger, clk clock.Clock) *EventHydrator {
+	return &EventHydrator{
+		ds:  ds,
+		log: log,
+		clk: clk,
+	}
+}
+
+// Hydrate brings the cache up to date with the datastore and returns it.
+// The same cache is returned on every call unless it has to be rebuilt.
+// Hydrate is not safe for concurrent use.
+func (h *EventHydrator) Hydrate(ctx context.Context) (*FullEntryCache, error) {
+	if h.cache != nil {
+		err := h.update(ctx)
+		switch {
+		case err == nil:
+			return h.cache, nil
+		case errors.Is(err, errEventGap):
+			h.log.WithError(err).Info("Rebuilding in-memory entry cache")
+		default:
+			return nil, err
+		}
+	}
+	return h.rebuild(ctx)
+}
+
+func (h *EventHydrator) rebuild(ctx context.Context) (*FullEntryCache, error) {
+	// The events are listed before building so that the changes made while
+	// building are applied again on the next update.
+	entryEvents, err := h.ds.ListRegistrationEntryEvents(ctx, &datastore.ListRegistrationEntryEventsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	nodeEvents, err := h.ds.ListAttestedNodeEvents(ctx, &datastore.ListAttestedNodeEventsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Read from the primary so the cache is not behind the events
+	cache, err := buildFromDataStore(ctx, h.ds, datastore.RequireCurrent)
+	if err != nil {
+		return nil, err
+	}
+
+	now := h.clk.Now()
+	h.entryCursor = newEventCursor()
+	for _, event := range entryEvents.Events {
+		h.entryCursor.reset(event.EventID, event.CreatedAt, now)
+	}
+	h.nodeCursor = newEventCursor()
+	for _, event := range nodeEvents.Events {
+		h.nodeCursor.reset(event.EventID, event.CreatedAt, now)
+	}
+	h.cache = cache
+	return cache, nil
+}
+
+func (h *EventHydrator) update(ctx context.Context) error {
+	now := h.clk.Now()
+
+	// Work on copies so that the cursors are left untouched on failure
+	entryCursor := h.entryCursor.clone()
+	nodeCursor := h.nodeCursor.clone()
+
+	entryEvents, err := h.ds.ListRegistrationEntryEvents(ctx, &datastore.ListRegistrationEntryEventsRequest{
+		GreaterThanEventID: entryCursor.listAfter(),
+	})
+	if err != nil {
+		return err
+	}
+	entryIDs := make(map[string]struct{})
+	for _, event := range entryEvents.Events {
+		if entryCursor.next(event.EventID, now) {
+			entryIDs[event.EntryID] = struct{}{}
+		}
+	}
+	if err := entryCursor.checkGap(now); err != nil {
+		return err
+	}
+
+	nodeEvents, err := h.ds.ListAttestedNodeEvents(ctx, &datastore.ListAttestedNodeEventsRequest{
+		GreaterThanEventID: nodeCursor.listAfter(),
+	})
+	if err != nil {
+		return err
+	}
+	agentIDs := make(map[string]struct{})
+	for _, event := range nodeEvents.Events {
+		if nodeCursor.next(event.EventID, now) {
+			agentIDs[event.SpiffeID] = struct{}{}
+		}
+	}
+	if err := nodeCursor.checkGap(now); err != nil {
+		return err
+	}
+
+	updates := &Updates{
+		Entries: make(map[string]*types.Entry, len(entryIDs)),
+		Agents:  make(map[spiffeid.ID]*Agent, len(agentIDs)),
+	}
+	for entryID := range entryIDs {
+		entry, err := h.fetchEntry(ctx, entryID)
+		if err != nil {
+			return err
+		}
+		updates.Entries[entryID] = entry
+	}
+	for spiffeID := range agentIDs {
+		agentID, err := spiffeid.FromString(spiffeID)
+		if err != nil {
+			return err
+		}
+		agent, err := h.fetchAgent(ctx, agentID, now)
+		if err != nil {
+			return err
+		}
+		updates.Agents[agentID] = agent
+	}
+
+	h.cache.ApplyUpdates(updates)
+	h.entryCursor = entryCursor
+	h.nodeCursor = nodeCursor
+	return nil
+}
+
+// fetchEntry fetches the given entry. It returns nil if the entry no longer
+// exists or would not be included when building the cache.
+func (h *EventHydrator) fetchEntry(ctx context.Context, entryID string) (*types.Entry, error) {
+	entry, err := h.ds.FetchRegistrationEntry(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil || !isCacheableEntry(entry) {
+		return nil, nil
+	}
+	return api.RegistrationEntryToProto(entry)
+}
+
+// fetchAgent fetches the given agent and its selectors. It returns nil if
+// the agent no longer exists or has expired.
+func (h *EventHydrator) fetchAgent(ctx context.Context, agentID spiffeid.ID, now time.Time) (*Agent, error) {
+	node, err := h.ds.FetchAttestedNode(ctx, agentID.String())
+	if err != nil {
+		return nil, err
+	}
+	if node == nil || node.CertNotAfter <= now.Unix() {
+		return nil, nil
+	}
+
+	selectors, err := h.ds.GetNodeSelectors(ctx, agentID.String(), datastore.RequireCurrent)
+	if err != nil {
+		return nil, err
+	}
+	return &Agent{
+		ID:        agentID,
+		Selectors: api.ProtoFromSelectors(selectors),
+	}, nil
+}
+
+// eventCursor tracks the events that have been applied to the cache.
+type eventCursor struct {
+	lastEventID uint
+	// skipped holds the IDs lower than lastEventID that have not been seen
+	// yet, along with the time they were noticed missing.
+	skipped map[uint]time.Time
+	// overflow is set when too many IDs were skipped to track them.
+	overflow bool
+}
+
+func newEventCursor() eventCursor {
+	return eventCursor{
+		skipped: make(map[uint]time.Time),
+	}
+}
+
+func (c eventCursor) clone() eventCursor {
+	clone := c
+	clone.skipped = make(map[uint]time.Time, len(c.skipped))
+	for id, noticedAt := range c.skipped {
+		clone.skipped[id] = noticedAt
+	}
+	return clone
+}
+
+// reset moves the cursor to an event listed before rebuilding the cache.
+// Only the IDs skipped by recent events are tracked, since they could belong
+// to transactions that had not committed yet. Older gaps are permanent.
+func (c *eventCursor) reset(eventID uint, createdAt, now time.Time) {
+	if c.lastEventID != 0 && now.Sub(createdAt) < eventGapTimeout {
+		// The cache was just rebuilt, so there is nothing better to do
+		// than ignoring the IDs that cannot be tracked.
+		_ = c.skip(eventID, now)
+	}
+	c.lastEventID = eventID
+}
+
+// next moves the cursor to the given event and returns whether it has not
+// been applied yet.
+func (c *eventCursor) next(eventID uint, now time.Time) bool {
+	if eventID > c.lastEventID {
+		if !c.skip(eventID, now) {
+			c.overflow = true
+		}
+		c.lastEventID = eventID
+		return true
+	}
+	if _, ok := c.skipped[eventID]; ok {
+		delete(c.skipped, eventID)
+		return true
+	}
+	return false
+}
+
+// skip records the IDs between the last event and the given one as skipped.
+// It returns false if there are too many skipped IDs to track them.
+func (c *eventCursor) skip(eventID uint, now time.Time) bool {
+	if eventID-c.lastEventID-1 > uint(maxSkippedEvents-len(c.skipped)) {
+		return false
+	}
+	for id := c.lastEventID + 1; id < eventID; id++ {
+		c.skipped[id] = now
+	}
+	return true
+}
+
+// listAfter returns the event ID after which events have to be listed so
+// that the skipped events are seen if they show up.
+func (c eventCursor) listAfter() uint {
+	after := c.lastEventID
+	for id := range c.skipped {
+		if id <= after {
+			after = id - 1
+		}
+	}
+	return after
+}
+
+// checkGap returns errEventGap if skipped events have not shown up in time.
+func (c eventCursor) checkGap(now time.Time) error {
+	if c.overflow {
+		return errEventGap
+	}
+	for _, noticedAt := range c.skipped {
+		if now.Sub(noticedAt) >= eventGapTimeout {
+			return errEventGap
+		}
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+package entrycache
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
+	"github.com/spiffe/spire/pkg/server/datastore"
+	"github.com/spiffe/spire/proto/spire/common"
+	"github.com/spiffe/spire/test/clock"
+	"github.com/spiffe/spire/test/fakes/fakedatastore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventHydrator(t *testing.T) {
+	ctx := context.Background()
+	ds := fakedatastore.New(t)
+	log, _ := test.NewNullLogger()
+	clk := clock.NewMock(t)
+
+	const serverID = "spiffe://example.org/spire/server"
+	agentID1 := spiffeid.RequireFromString("spiffe://example.org/spire/agent/agent1")
+	agentID2 := spiffeid.RequireFromString("spiffe://example.org/spire/agent/agent2")
+	s1 := &common.Selector{Type: "s", Value: "1"}
+	s2 := &common.Selector{Type: "s", Value: "2"}
+
+	for i, agentID := range []spiffeid.ID{agentID1, agentID2} {
+		createAttestedNode(t, ds, &common.AttestedNode{
+			SpiffeId:            agentID.String(),
+			AttestationDataType: testNodeAttestor,
+			CertSerialNumber:    strconv.Itoa(i),
+			CertNotAfter:        clk.Now().Add(24 * time.Hour).Unix(),
+		})
+	}
+	setNodeSelectors(ctx, t, ds, agentID1.String(), s1)
+	setNodeSelectors(ctx, t, ds, agentID2.String(), s2)
+
+	hydrator := NewEventHydrator(ds, log, clk)
+	cache, err := hydrator.Hydrate(ctx)
+	require.NoError(t, err)
+
+	// assertHydrated asserts that the cache is updated in place and that it
+	// authorizes the same entries as a cache built from scratch.
+	assertHydrated := func(expectedCounts ...int) {
+		hydrated, err := hydrator.Hydrate(ctx)
+		require.NoError(t, err)
+		require.Same(t, cache, hydrated)
+
+		built, err := BuildFromDataStore(ctx, ds)
+		require.NoError(t, err)
+
+		for i, agentID := range []spiffeid.ID{agentID1, agentID2} {
+			expected := authorizedEntryIDs(built, agentID)
+			assert.Equal(t, expected, authorizedEntryIDs(hydrated, agentID), "agent %q", agentID)
+			assert.Len(t, expected, expectedCounts[i], "agent %q", agentID)
+
+			workloadSelectors := []*types.Selector{{Type: "not", Value: "relevant"}}
+			assert.Equal(t, built.GetMatchingEntries(agentID, workloadSelectors), hydrated.GetMatchingEntries(agentID, workloadSelectors), "agent %q", agentID)
+		}
+	}
+
+	// Entries parented to an agent and to an alias
+	workload1 := createRegistrationEntry(ctx, t, ds, &common.RegistrationEntry{
+		ParentId:  agentID1.String(),
+		SpiffeId:  "spiffe://example.org/workload1",
+		Selectors: []*common.Selector{{Type: "not", Value: "relevant"}},
+	})
+	alias := createRegistrationEntry(ctx, t, ds, &common.RegistrationEntry{
+		ParentId:  serverID,
+		SpiffeId:  "spiffe://example.org/alias",
+		Selectors: []*common.Selector{s1},
+	})
+	createRegistrationEntry(ctx, t, ds, &common.RegistrationEntry{
+		ParentId:  alias.SpiffeId,
+		SpiffeId:  "spiffe://example.org/workload2",
+		Selectors: []*common.Selector{{Type: "not", Value: "relevant"}},
+	})
+	assertHydrated(3, 0)
+
+	// Agent selectors change
+	setNodeSelectors(ctx, t, ds, agentID2.String(), s1, s2)
+	assertHydrated(3, 2)
+
+	// Alias selectors change
+	alias.Selectors = []*common.Selector{s2}
+	_, err = ds.UpdateRegistrationEntry(ctx, alias, &common.RegistrationEntryMask{Selectors: true})
+	require.NoError(t, err)
+	assertHydrated(1, 2)
+
+	// Entry is deleted
+	_, err = ds.DeleteRegistrationEntry(ctx, workload1.EntryId)
+	require.NoError(t, err)
+	assertHydrated(0, 2)
+
+	// Agent expires
+	_, err = ds.UpdateAttestedNode(ctx, &common.AttestedNode{
+		SpiffeId:     agentID2.String(),
+		CertNotAfter: clk.Now().Add(-time.Hour).Unix(),
+	}, &common.AttestedNodeMask{CertNotAfter: true})
+	require.NoError(t, err)
+	assertHydrated(0, 0)
+
+	// Agent is deleted
+	alias.Selectors = []*common.Selector{s1}
+	_, err = ds.UpdateRegistrationEntry(ctx, alias, &common.RegistrationEntryMask{Selectors: true})
+	require.NoError(t, err)
+	assertHydrated(2, 0)
+	_, err = ds.DeleteAttestedNode(ctx, agentID1.String())
+	require.NoError(t, err)
+	assertHydrated(0, 0)
+}
+
+func TestEventHydratorRebuildsOnGap(t *testing.T) {
+	ctx := context.Background()
+	ds := &hidingEventsDataStore{
+		DataStore: fakedatastore.New(t),
+		hidden:    make(map[uint]bool),
+	}
+	log, hook := test.NewNullLogger()
+	clk := clock.NewMock(t)
+	agentID := spiffeid.RequireFromString("spiffe://example.org/spire/agent/agent1")
+
+	createEntry := func(name string) uint {
+		createRegistrationEntry(ctx, t, ds, &common.RegistrationEntry{
+			ParentId:  agentID.String(),
+			SpiffeId:  "spiffe://example.org/" + name,
+			Selectors: []*common.Selector{{Type: "not", Value: "relevant"}},
+		})
+		resp, err := ds.DataStore.ListRegistrationEntryEvents(ctx, &datastore.ListRegistrationEntryEventsRequest{})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Events)
+		return resp.Events[len(resp.Events)-1].EventID
+	}
+
+	hydrator := NewEventHydrator(ds, log, clk)
+	cache, err := hydrator.Hydrate(ctx)
+	require.NoError(t, err)
+
+	// The event of the first entry is not visible yet
+	ds.hidden[createEntry("workload1")] = true
+	createEntry("workload2")
+	hydrated, err := hydrator.Hydrate(ctx)
+	require.NoError(t, err)
+	require.Same(t, cache, hydrated)
+	assert.Len(t, cache.GetAuthorizedEntries(agentID), 1)
+
+	// The skipped event shows up before the timeout
+	ds.hidden = make(map[uint]bool)
+	clk.Add(eventGapTimeout - time.Second)
+	hydrated, err = hydrator.Hydrate(ctx)
+	require.NoError(t, err)
+	require.Same(t, cache, hydrated)
+	assert.Len(t, cache.GetAuthorizedEntries(agentID), 2)
+	assert.Empty(t, hook.AllEntries())
+
+	// The skipped event never shows up
+	ds.hidden[createEntry("workload3")] = true
+	createEntry("workload4")
+	hydrated, err = hydrator.Hydrate(ctx)
+	require.NoError(t, err)
+	require.Same(t, cache, hydrated)
+	assert.Len(t, cache.GetAuthorizedEntries(agentID), 3)
+
+	clk.Add(eventGapTimeout)
+	hydrated, err = hydrator.Hydrate(ctx)
+	require.NoError(t, err)
+	assert.NotSame(t, cache, hydrated)
+	assert.Len(t, hydrated.GetAuthorizedEntries(agentID), 4)
+	require.NotNil(t, hook.LastEntry())
+	assert.Equal(t, "Rebuilding in-memory entry cache", hook.LastEntry().Message)
+}
+
+func TestEventCursor(t *testing.T) {
+	now := time.Now()
+
+	cursor := newEventCursor()
+	assert.True(t, cursor.next(1, now))
+	assert.True(t, cursor.next(4, now))
+	assert.Equal(t, uint(1), cursor.listAfter())
+	assert.False(t, cursor.next(4, now))
+	assert.True(t, cursor.next(3, now))
+	assert.False(t, cursor.next(3, now))
+	assert.NoError(t, cursor.checkGap(now.Add(eventGapTimeout-time.Second)))
+	assert.ErrorIs(t, cursor.checkGap(now.Add(eventGapTimeout)), errEventGap)
+	assert.True(t, cursor.next(2, now))
+	assert.Equal(t, uint(4), cursor.listAfter())
+	assert.NoError(t, cursor.checkGap(now.Add(eventGapTimeout)))
+
+	// Too many skipped events to track
+	assert.True(t, cursor.next(maxSkippedEvents+6, now))
+	assert.ErrorIs(t, cursor.checkGap(now), errEventGap)
+}
+
+func BenchmarkHydrateSQL(b *testing.B) {
+	ctx := context.Background()
+	ds := newSQLPlugin(b)
+	populateBenchmarkData(ctx, b, ds)
+
+	log, _ := test.NewNullLogger()
+	hydrator := NewEventHydrator(ds, log, clock.NewMock(b))
+	if _, err := hydrator.Hydrate(ctx); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		createRegistrationEntry(ctx, b, ds, &common.RegistrationEntry{
+			ParentId:  makeAgentID(0).String(),
+			SpiffeId:  "spiffe://domain.test/benchmark/" + strconv.Itoa(i),
+			Selectors: []*common.Selector{{Type: "not", Value: "relevant"}},
+		})
+		b.StartTimer()
+
+		if _, err := hydrator.Hydrate(ctx); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func authorizedEntryIDs(cache *FullEntryCache, agentID spiffeid.ID) []string {
+	entryIDs := []string{}
+	for _, entry := range cache.GetAuthorizedEntries(agentID) {
+		entryIDs = append(entryIDs, entry.Id)
+	}
+	sort.Strings(entryIDs)
+	return entryIDs
+}
+
+// hidingEventsDataStore hides registration entry events, like events
+// recorded by transactions that have not committed yet.
+type hidingEventsDataStore struct {
+	datastore.DataStore
+	hidden map[uint]bool
+}
+
+func (ds *hidingEventsDataStore) ListRegistrationEntryEvents(ctx context.Context, req *datastore.ListRegistrationEntryEventsRequest) (*datastore.ListRegistrationEntryEventsResponse, error) {
+	resp, err := ds.DataStore.ListRegistrationEntryEvents(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var events []datastore.RegistrationEntryEvent
+	for _, event := range resp.Events {
+		if !ds.hidden[event.EventID] {
+			events = append(events, event)
+		}
+	}
+	resp.Events = events
+	return resp, nil
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/url"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"testing"
 	"time"
@@ -220,6 +221,141 @@ func TestFullCacheNodeAliasing(t *testing.T) {
 	assertAuthorizedEntries(agentIDs[2], workloadEntries[2])
 }
 
+func TestFullCacheGetMatchingEntries(t *testing.T) {
+	ds := fakedatastore.New(t)
+	ctx := context.Background()
+
+	const serverID = "spiffe://example.org/spire/server"
+	agentID := spiffeid.RequireFromString("spiffe://example.org/spire/agent/agent1")
+	otherAgentID := spiffeid.RequireFromString("spiffe://example.org/spire/agent/agent2")
+
+	n1 := &common.Selector{Type: "n", Value: "1"}
+	w1 := &common.Selector{Type: "w", Value: "1"}
+	w2 := &common.Selector{Type: "w", Value: "2"}
+	w3 := &common.Selector{Type: "w", Value: "3"}
+
+	alias := createRegistrationEntry(ctx, t, ds, &common.RegistrationEntry{
+		ParentId:  serverID,
+		SpiffeId:  "spiffe://example.org/alias",
+		Selectors: []*common.Selector{n1},
+	})
+	workload1 := createRegistrationEntry(ctx, t, ds, &common.RegistrationEntry{
+		ParentId:  agentID.String(),
+		SpiffeId:  "spiffe://example.org/workload1",
+		Selectors: []*common.Selector{w1},
+	})
+	workload2 := createRegistrationEntry(ctx, t, ds, &common.RegistrationEntry{
+		ParentId:  alias.SpiffeId,
+		SpiffeId:  "spiffe://example.org/workload2",
+		Selectors: []*common.Selector{w1, w2},
+	})
+	createRegistrationEntry(ctx, t, ds, &common.RegistrationEntry{
+		ParentId:  otherAgentID.String(),
+		SpiffeId:  "spiffe://example.org/workload3",
+		Selectors: []*common.Selector{w1},
+	})
+
+	createAttestedNode(t, ds, &common.AttestedNode{
+		SpiffeId:            agentID.String(),
+		AttestationDataType: testNodeAttestor,
+		CertSerialNumber:    "1",
+		CertNotAfter:        time.Now().Add(24 * time.Hour).Unix(),
+	})
+	setNodeSelectors(ctx, t, ds, agentID.String(), n1)
+
+	cache, err := BuildFromDataStore(ctx, ds)
+	require.NoError(t, err)
+
+	assertMatchingEntries := func(selectors []*common.Selector, expected ...*common.RegistrationEntry) {
+		var expectedIDs []string
+		for _, entry := range expected {
+			expectedIDs = append(expectedIDs, entry.EntryId)
+		}
+		sort.Strings(expectedIDs)
+
+		var actualIDs []string
+		for _, entry := range cache.GetMatchingEntries(agentID, api.ProtoFromSelectors(selectors)) {
+			actualIDs = append(actualIDs, entry.Id)
+		}
+		assert.Equal(t, expectedIDs, actualIDs)
+	}
+
+	assertMatchingEntries(nil)
+	assertMatchingEntries([]*common.Selector{w3})
+	assertMatchingEntries([]*common.Selector{n1})
+	assertMatchingEntries([]*common.Selector{w1}, workload1)
+	assertMatchingEntries([]*common.Selector{w2})
+	assertMatchingEntries([]*common.Selector{w1, w2, w3}, workload1, workload2)
+}
+
+func TestFullCacheGetAuthorizedAgents(t *testing.T) {
+	ds := fakedatastore.New(t)
+	ctx := context.Background()
+
+	const serverID = "spiffe://example.org/spire/server"
+	agentIDs := []spiffeid.ID{
+		spiffeid.RequireFromString("spiffe://example.org/spire/agent/agent1"),
+		spiffeid.RequireFromString("spiffe://example.org/spire/agent/agent2"),
+		spiffeid.RequireFromString("spiffe://example.org/spire/agent/agent3"),
+	}
+
+	s1 := &common.Selector{Type: "s", Value: "1"}
+	s2 := &common.Selector{Type: "s", Value: "2"}
+	irrelevantSelectors := []*common.Selector{{Type: "not", Value: "relevant"}}
+
+	alias := createRegistrationEntry(ctx, t, ds, &common.RegistrationEntry{
+		ParentId:  serverID,
+		SpiffeId:  "spiffe://example.org/alias",
+		Selectors: []*common.Selector{s1},
+	})
+	workload := createRegistrationEntry(ctx, t, ds, &common.RegistrationEntry{
+		ParentId:  agentIDs[2].String(),
+		SpiffeId:  "spiffe://example.org/workload",
+		Selectors: irrelevantSelectors,
+	})
+
+	for i, agentID := range agentIDs {
+		createAttestedNode(t, ds, &common.AttestedNode{
+			SpiffeId:            agentID.String(),
+			AttestationDataType: testNodeAttestor,
+			CertSerialNumber:    strconv.Itoa(i),
+			CertNotAfter:        time.Now().Add(24 * time.Hour).Unix(),
+		})
+	}
+	setNodeSelectors(ctx, t, ds, agentIDs[0].String(), s1, s2)
+	setNodeSelectors(ctx, t, ds, agentIDs[1].String(), s1)
+	setNodeSelectors(ctx, t, ds, agentIDs[2].String(), s2)
+
+	cache, err := BuildFromDataStore(ctx, ds)
+	require.NoError(t, err)
+
+	assertAuthorizedAgents := func(parentID, spiffeID string, selectors []*common.Selector, expected ...spiffeid.ID) {
+		entry, err := api.RegistrationEntryToProto(&common.RegistrationEntry{
+			ParentId:  parentID,
+			SpiffeId:  spiffeID,
+			Selectors: selectors,
+		})
+		require.NoError(t, err)
+		actual := cache.GetAuthorizedAgents(entry)
+		if len(expected) == 0 {
+			assert.Empty(t, actual)
+			return
+		}
+		assert.Equal(t, expected, actual)
+	}
+
+	// Node alias entries
+	assertAuthorizedAgents(serverID, "spiffe://example.org/new-alias", []*common.Selector{s1}, agentIDs[0], agentIDs[1])
+	assertAuthorizedAgents(serverID, "spiffe://example.org/new-alias", []*common.Selector{s1, s2}, agentIDs[0])
+	assertAuthorizedAgents(serverID, "spiffe://example.org/new-alias", []*common.Selector{{Type: "s", Value: "3"}})
+
+	// Entries parented to an alias, an agent, a workload or nothing known
+	assertAuthorizedAgents(alias.SpiffeId, "spiffe://example.org/new", irrelevantSelectors, agentIDs[0], agentIDs[1])
+	assertAuthorizedAgents(agentIDs[2].String(), "spiffe://example.org/new", irrelevantSelectors, agentIDs[2])
+	assertAuthorizedAgents(workload.SpiffeId, "spiffe://example.org/new", irrelevantSelectors, agentIDs[2])
+	assertAuthorizedAgents("spiffe://example.org/unknown", "spiffe://example.org/new", irrelevantSelectors)
+}
+
 func TestFullCacheExcludesNodeSelectorMappedEntriesForExpiredAgents(t *testing.T) {
 	// This test verifies that the cache contains no workloads parented to alias entries
 	// that are only associated with an expired agent.
@@ -471,32 +607,27 @@ func BenchmarkGetAuthorizedEntriesInMemory(b *testing.B) {
 	}
 }
 
+func BenchmarkGetMatchingEntriesInMemory(b *testing.B) {
+	allEntries, agents := buildBenchmarkData()
+	cache, err := Build(context.Background(), makeEntryIterator(allEntries), makeAgentIterator(agents))
+	require.NoError(b, err)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		selectors := []*types.Selector{
+			{Type: "unix", Value: fmt.Sprintf("uid:%d", i%300)},
+			{Type: "unix", Value: "gid:0"},
+		}
+		cache.GetMatchingEntries(agents[i%len(agents)].ID, selectors)
+	}
+}
+
 // To run this benchmark against a real MySQL or Postgres database, set the following flags in your test run,
 // substituting in the required connection string parameters for each of the ldflags:
 // -bench 'BenchmarkBuildSQL' -benchtime <some-reasonable-time-limit> -ldflags "-X github.com/spiffe/spire/pkg/server/cache/entrycache.TestDialect=<mysql|postgres> -X github.com/spiffe/spire/pkg/server/cache/entrycache.TestConnString=<CONNECTION_STRING_HERE> -X github.com/spiffe/spire/pkg/server/cache/entrycache.TestROConnString=<CONNECTION_STRING_HERE>"
 func BenchmarkBuildSQL(b *testing.B) {
-	allEntries, agents := buildBenchmarkData()
 	ctx := context.Background()
 	ds := newSQLPlugin(b)
-
-	for _, entry := range allEntries {
-		e, _ := api.ProtoToRegistrationEntry(td, entry)
-		createRegistrationEntry(ctx, b, ds, e)
-	}
-
-	for i, agent := range agents {
-		agentIDStr := agent.ID.String()
-		node := &common.AttestedNode{
-			SpiffeId:            agent.ID.String(),
-			AttestationDataType: testNodeAttestor,
-			CertSerialNumber:    strconv.Itoa(i),
-			CertNotAfter:        time.Now().Add(24 * time.Hour).Unix(),
-		}
-
-		createAttestedNode(b, ds, node)
-		ss, _ := api.SelectorsFromProto(agent.Selectors)
-		setNodeSelectors(ctx, b, ds, agentIDStr, ss...)
-	}
+	populateBenchmarkData(ctx, b, ds)
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -715,7 +846,7 @@ func buildBenchmarkData() ([]*types.Entry, []Agent) {
 	var workloadEntries2 []*types.Entry
 	for i := 0; i < 300; i++ {
 		workloadEntries2 = append(workloadEntries2, &types.Entry{
-			Id: fmt.Sprintf("workload%d", i),
+			Id: fmt.Sprintf("workload%d", 300+i),
 			SpiffeId: &types.SPIFFEID{
 				TrustDomain: "domain.test",
 				Path:        fmt.Sprintf("workload%d", i),
@@ -732,6 +863,28 @@ func buildBenchmarkData() ([]*types.Entry, []Agent) {
 	return allEntries, agents
 }
 
+func populateBenchmarkData(ctx context.Context, tb testing.TB, ds datastore.DataStore) {
+	allEntries, agents := buildBenchmarkData()
+	for _, entry := range allEntries {
+		e, _ := api.ProtoToRegistrationEntry(td, entry)
+		createRegistrationEntry(ctx, tb, ds, e)
+	}
+
+	for i, agent := range agents {
+		agentIDStr := agent.ID.String()
+		node := &common.AttestedNode{
+			SpiffeId:            agent.ID.String(),
+			AttestationDataType: testNodeAttestor,
+			CertSerialNumber:    strconv.Itoa(i),
+			CertNotAfter:        time.Now().Add(24 * time.Hour).Unix(),
+		}
+
+		createAttestedNode(tb, ds, node)
+		ss, _ := api.SelectorsFromProto(agent.Selectors)
+		setNodeSelectors(ctx, tb, ds, agentIDStr, ss...)
+	}
+}
+
 func newSQLPlugin(tb testing.TB) datastore.DataStore {
 	log, _ := test.NewNullLogger()
 	p := sqlds.New(log)
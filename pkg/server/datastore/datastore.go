@@ -30,9 +30,13 @@ type DataStore interface {
 	DeleteRegistrationEntry(ctx context.Context, entryID string) (*common.RegistrationEntry, error)
 	FetchRegistrationEntry(ctx context.Context, entryID string) (*common.RegistrationEntry, error)
 	ListRegistrationEntries(context.Context, *ListRegistrationEntriesRequest) (*ListRegistrationEntriesResponse, error)
-	PruneRegistrationEntries(ctx context.Context, expiresBefore time.Time) error
+	PruneRegistrationEntries(ctx context.Context, expiresBefore time.Time) ([]*common.RegistrationEntry, error)
 	UpdateRegistrationEntry(context.Context, *common.RegistrationEntry, *common.RegistrationEntryMask) (*common.RegistrationEntry, error)
 
+	// Entry events
+	ListRegistrationEntryEvents(context.Context, *ListRegistrationEntryEventsRequest) (*ListRegistrationEntryEventsResponse, error)
+	PruneRegistrationEntryEvents(ctx context.Context, createdBefore time.Time) error
+
 	// Nodes
 	CountAttestedNodes(context.Context) (int32, error)
 	CreateAttestedNode(context.Context, *common.AttestedNode) (*common.AttestedNode, error)
@@ -41,6 +45,10 @@ type DataStore interface {
 	ListAttestedNodes(context.Context, *ListAttestedNodesRequest) (*ListAttestedNodesResponse, error)
 	UpdateAttestedNode(context.Context, *common.AttestedNode, *common.AttestedNodeMask) (*common.AttestedNode, error)
 
+	// Node events
+	ListAttestedNodeEvents(context.Context, *ListAttestedNodeEventsRequest) (*ListAttestedNodeEventsResponse, error)
+	PruneAttestedNodeEvents(ctx context.Context, createdBefore time.Time) error
+
 	// Node selectors
 	GetNodeSelectors(ctx context.Context, spiffeID string, dataConsistency DataConsistency) ([]*common.Selector, error)
 	ListNodeSelectors(context.Context, *ListNodeSelectorsRequest) (*ListNodeSelectorsResponse, error)
@@ -167,6 +175,11 @@ type ListRegistrationEntriesRequest struct {
 	BySpiffeID      string
 	Pagination      *Pagination
 	ByFederatesWith *ByFederatesWith
+	// ByLabels matches the entries that have all the given labels
+	ByLabels map[string]string
+	// ByExpiresBefore matches the entries that expire before the given
+	// time. Entries that do not expire are not matched.
+	ByExpiresBefore time.Time
 }
 
 type ListRegistrationEntriesResponse struct {
@@ -174,6 +187,42 @@ type ListRegistrationEntriesResponse struct {
 	Pagination *Pagination
 }
 
+// RegistrationEntryEvent records that a registration entry was created,
+// updated or deleted.
+type RegistrationEntryEvent struct {
+	EventID   uint
+	EntryID   string
+	CreatedAt time.Time
+}
+
+type ListRegistrationEntryEventsRequest struct {
+	// GreaterThanEventID lists the events with an ID greater than the given one
+	GreaterThanEventID uint
+}
+
+type ListRegistrationEntryEventsResponse struct {
+	// Events are ordered by event ID
+	Events []RegistrationEntryEvent
+}
+
+// AttestedNodeEvent records that an attested node, or its selectors, was
+// created, updated or deleted.
+type AttestedNodeEvent struct {
+	EventID   uint
+	SpiffeID  string
+	CreatedAt time.Time
+}
+
+type ListAttestedNodeEventsRequest struct {
+	// GreaterThanEventID lists the events with an ID greater than the given one
+	GreaterThanEventID uint
+}
+
+type ListAttestedNodeEventsResponse struct {
+	// Events are ordered by event ID
+	Events []AttestedNodeEvent
+}
+
 type ListFederationRelationshipsRequest struct {
 	Pagination *Pagination
 }
@@ -5,4 +5,7 @@ import "github.com/jinzhu/gorm"
 type dialect interface {
 	connect(cfg *configuration, isReadOnly bool) (db *gorm.DB, version string, supportsCTE bool, err error)
 	isConstraintViolation(err error) bool
+	// isTransactionConflict reports whether the transaction failed because
+	// of a concurrent transaction, i.e. a serialization failure or a deadlock
+	isTransactionConflict(err error) bool
 }
@@ -0,0 +1,44 @@
+package sqlstore
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestIsTransactionConflict(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		dialect  dialect
+		err      error
+		conflict bool
+	}{
+		{name: "postgres serialization failure", dialect: postgresDB{}, err: &pq.Error{Code: "40001"}, conflict: true},
+		{name: "postgres deadlock", dialect: postgresDB{}, err: &pq.Error{Code: "40P01"}, conflict: true},
+		{name: "postgres unique violation", dialect: postgresDB{}, err: &pq.Error{Code: "23505"}},
+		{name: "mysql deadlock", dialect: mysqlDB{}, err: &mysql.MySQLError{Number: 1213}, conflict: true},
+		{name: "mysql duplicate entry", dialect: mysqlDB{}, err: &mysql.MySQLError{Number: 1062}},
+		{name: "sqlite busy", dialect: sqliteDB{}, err: sqlite3.Error{Code: sqlite3.ErrBusy}, conflict: true},
+		{name: "sqlite locked", dialect: sqliteDB{}, err: sqlite3.Error{Code: sqlite3.ErrLocked}, conflict: true},
+		{name: "sqlite constraint", dialect: sqliteDB{}, err: sqlite3.Error{Code: sqlite3.ErrConstraint}},
+		{name: "other error", dialect: postgresDB{}, err: errors.New("oh no")},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.conflict, tt.dialect.isTransactionConflict(tt.err))
+		})
+	}
+}
+
+func TestGormToGRPCStatusTransactionConflict(t *testing.T) {
+	ds := &Plugin{db: &sqlDB{dialect: postgresDB{}}}
+
+	err := ds.gormToGRPCStatus(sqlError.Wrap(&pq.Error{Code: "40001", Message: "could not serialize access due to concurrent update"}))
+	spiretest.AssertGRPCStatus(t, err, codes.Aborted, "datastore-sql: pq: could not serialize access due to concurrent update")
+}
@@ -92,6 +92,12 @@ type RegisteredEntry struct {
 
 	// StoreSvid determines if the issued SVID is exportable to a store
 	StoreSvid bool
+
+	// JWTSvidTTL of JWT identities derived from this entry
+	JWTSvidTTL int32 `gorm:"column:jwt_svid_ttl"`
+
+	// (optional) key/value labels
+	Labels []EntryLabel
 }
 
 // JoinToken holds a join token
@@ -123,6 +129,46 @@ func (DNSName) TableName() string {
 	return "dns_names"
 }
 
+// EntryLabel holds a key/value label of a registration entry
+type EntryLabel struct {
+	Model
+
+	RegisteredEntryID uint   `gorm:"unique_index:idx_entry_label"`
+	Name              string `gorm:"unique_index:idx_entry_label;index:idx_entry_labels_name_value"`
+	Value             string `gorm:"index:idx_entry_labels_name_value"`
+}
+
+// TableName gets table name for entry labels
+func (EntryLabel) TableName() string {
+	return "entry_labels"
+}
+
+// RegisteredEntryEvent holds the entry ID of a registration entry that was
+// created, updated or deleted
+type RegisteredEntryEvent struct {
+	Model
+
+	EntryID string
+}
+
+// TableName gets table name for registered entry events
+func (RegisteredEntryEvent) TableName() string {
+	return "registered_entries_events"
+}
+
+// AttestedNodeEvent holds the SPIFFE ID of an attested node that was
+// created, updated or deleted, or whose selectors were set
+type AttestedNodeEvent struct {
+	Model
+
+	SpiffeID string
+}
+
+// TableName gets table name for attested node events
+func (AttestedNodeEvent) TableName() string {
+	return "attested_node_entries_events"
+}
+
 // FederatedTrustDomain holds federated trust domains.
 // It has the information needed to get updated bundles of the
 // federated trust domain from a SPIFFE bundle endpoint server.
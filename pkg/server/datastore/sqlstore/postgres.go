@@ -34,3 +34,10 @@ func (p postgresDB) isConstraintViolation(err error) bool {
 	// "23xxx" is the constraint violation class for PostgreSQL
 	return ok && e.Code.Class() == "23"
 }
+
+func (p postgresDB) isTransactionConflict(err error) bool {
+	var e *pq.Error
+	ok := errors.As(err, &e)
+	// "40001" is serialization_failure and "40P01" is deadlock_detected
+	return ok && (e.Code == "40001" || e.Code == "40P01")
+}
@@ -20,3 +20,7 @@ func (s sqliteDB) connect(cfg *configuration, isReadOnly bool) (db *gorm.DB, ver
 func (s sqliteDB) isConstraintViolation(err error) bool {
 	return false
 }
+
+func (s sqliteDB) isTransactionConflict(err error) bool {
+	return false
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -271,6 +272,26 @@ func (ds *Plugin) DeleteAttestedNode(ctx context.Context, spiffeID string) (atte
 	return attestedNode, nil
 }
 
+// ListAttestedNodeEvents lists the attested node events, ordered by event ID
+func (ds *Plugin) ListAttestedNodeEvents(ctx context.Context, req *datastore.ListAttestedNodeEventsRequest) (resp *datastore.ListAttestedNodeEventsResponse, err error) {
+	if err = ds.withReadTx(ctx, func(tx *gorm.DB) (err error) {
+		resp, err = listAttestedNodeEvents(tx, req)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PruneAttestedNodeEvents deletes all attested node events created before the
+// given time
+func (ds *Plugin) PruneAttestedNodeEvents(ctx context.Context, createdBefore time.Time) (err error) {
+	return ds.withWriteTx(ctx, func(tx *gorm.DB) (err error) {
+		err = pruneAttestedNodeEvents(tx, createdBefore)
+		return err
+	})
+}
+
 // SetNodeSelectors sets node (agent) selectors by SPIFFE ID, deleting old selectors first
 func (ds *Plugin) SetNodeSelectors(ctx context.Context, spiffeID string, selectors []*common.Selector) (err error) {
 	return ds.withWriteTx(ctx, func(tx *gorm.DB) (err error) {
@@ -315,8 +336,8 @@ func (ds *Plugin) CreateOrReturnRegistrationEntry(ctx context.Context,
 func (ds *Plugin) createOrReturnRegistrationEntry(ctx context.Context,
 	entry *common.RegistrationEntry) (registrationEntry *common.RegistrationEntry, existing bool, err error) {
 	// TODO: Validations should be done in the ProtoBuf level [https://github.com/spiffe/spire/issues/44]
-	if err = validateRegistrationEntry(entry); err != nil {
-		return nil, false, err
+	if err = datastore.ValidateRegistrationEntry(entry); err != nil {
+		return nil, false, sqlError.Wrap(err)
 	}
 
 	if err = ds.withWriteTx(ctx, func(tx *gorm.DB) (err error) {
@@ -388,9 +409,33 @@ func (ds *Plugin) DeleteRegistrationEntry(ctx context.Context,
 
 // PruneRegistrationEntries takes a registration entry message, and deletes all entries which have expired
 // before the date in the message
-func (ds *Plugin) PruneRegistrationEntries(ctx context.Context, expiresBefore time.Time) (err error) {
+func (ds *Plugin) PruneRegistrationEntries(ctx context.Context, expiresBefore time.Time) (pruned []*common.RegistrationEntry, err error) {
+	if err = ds.withWriteTx(ctx, func(tx *gorm.DB) (err error) {
+		pruned, err = pruneRegistrationEntries(tx, expiresBefore)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return pruned, nil
+}
+
+// ListRegistrationEntryEvents lists the registration entry events, ordered
+// by event ID
+func (ds *Plugin) ListRegistrationEntryEvents(ctx context.Context, req *datastore.ListRegistrationEntryEventsRequest) (resp *datastore.ListRegistrationEntryEventsResponse, err error) {
+	if err = ds.withReadTx(ctx, func(tx *gorm.DB) (err error) {
+		resp, err = listRegistrationEntryEvents(tx, req)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PruneRegistrationEntryEvents deletes all registration entry events created
+// before the given time
+func (ds *Plugin) PruneRegistrationEntryEvents(ctx context.Context, createdBefore time.Time) (err error) {
 	return ds.withWriteTx(ctx, func(tx *gorm.DB) (err error) {
-		err = pruneRegistrationEntries(tx, expiresBefore)
+		err = pruneRegistrationEntryEvents(tx, createdBefore)
 		return err
 	})
 }
@@ -595,7 +640,8 @@ func (ds *Plugin) closeDB() {
 // operations that will read one or more rows, change one or more columns in
 // those rows, and then set them back. This requires a stronger level of
 // consistency that prevents two transactions from doing read-modify-write
-// concurrently.
+// concurrently. The transaction that loses fails with an Aborted status, so
+// that callers can tell it from other failures and retry.
 func (ds *Plugin) withReadModifyWriteTx(ctx context.Context, op func(tx *gorm.DB) error) error {
 	isolationLevel := sql.LevelRepeatableRead
 	if ds.db.databaseType == MySQL {
@@ -656,7 +702,10 @@ func (ds *Plugin) withTx(ctx context.Context, op func(tx *gorm.DB) error, readOn
 		// writes won't be committed.
 		return sqlError.Wrap(tx.Rollback().Error)
 	}
-	return sqlError.Wrap(tx.Commit().Error)
+	if err := tx.Commit().Error; err != nil {
+		return ds.gormToGRPCStatus(sqlError.Wrap(err))
+	}
+	return nil
 }
 
 // gormToGRPCStatus takes an error, and converts it to a GRPC error.  If the
@@ -675,6 +724,8 @@ func (ds *Plugin) gormToGRPCStatus(err error) error {
 		code = codes.NotFound
 	case ds.db.dialect.isConstraintViolation(unwrapped):
 		code = codes.AlreadyExists
+	case ds.db.dialect.isTransactionConflict(unwrapped):
+		code = codes.Aborted
 	default:
 	}
 
@@ -887,6 +938,15 @@ func deleteBundle(tx *gorm.DB, trustDomainID string, mode datastore.DeleteMode)
 	}
 
 	if entriesCount > 0 {
+		// Both deleting and dissociating change the federated entries
+		var entryIDs []string
+		if err := tx.Table("registered_entries").
+			Joins("INNER JOIN federated_registration_entries ON federated_registration_entries.registered_entry_id = registered_entries.id").
+			Where("federated_registration_entries.bundle_id = ?", model.ID).
+			Pluck("registered_entries.entry_id", &entryIDs).Error; err != nil {
+			return sqlError.Wrap(err)
+		}
+
 		switch mode {
 		case datastore.Delete:
 			// TODO: figure out how to do this gracefully with GORM.
@@ -906,6 +966,12 @@ func deleteBundle(tx *gorm.DB, trustDomainID string, mode datastore.DeleteMode)
 		default:
 			return status.Newf(codes.FailedPrecondition, "datastore-sql: cannot delete bundle; federated with %d registration entries", entriesCount).Err()
 		}
+
+		for _, entryID := range entryIDs {
+			if err := createRegistrationEntryEvent(tx, entryID); err != nil {
+				return err
+			}
+		}
 	}
 
 	if err := tx.Delete(model).Error; err != nil {
@@ -1039,6 +1105,10 @@ func createAttestedNode(tx *gorm.DB, node *common.AttestedNode) (*common.Atteste
 		return nil, sqlError.Wrap(err)
 	}
 
+	if err := createAttestedNodeEvent(tx, model.SpiffeID); err != nil {
+		return nil, err
+	}
+
 	return modelToAttestedNode(model), nil
 }
 
@@ -1597,6 +1667,10 @@ func updateAttestedNode(tx *gorm.DB, n *common.AttestedNode, mask *common.Attest
 		return nil, sqlError.Wrap(err)
 	}
 
+	if err := createAttestedNodeEvent(tx, model.SpiffeID); err != nil {
+		return nil, err
+	}
+
 	return modelToAttestedNode(model), nil
 }
 
@@ -1610,6 +1684,10 @@ func deleteAttestedNode(tx *gorm.DB, spiffeID string) (*common.AttestedNode, err
 		return nil, sqlError.Wrap(err)
 	}
 
+	if err := createAttestedNodeEvent(tx, model.SpiffeID); err != nil {
+		return nil, err
+	}
+
 	return modelToAttestedNode(model), nil
 }
 
@@ -1646,7 +1724,7 @@ func setNodeSelectors(tx *gorm.DB, spiffeID string, selectors []*common.Selector
 		}
 	}
 
-	return nil
+	return createAttestedNodeEvent(tx, spiffeID)
 }
 
 func getNodeSelectors(ctx context.Context, db *sqlDB, spiffeID string) ([]*common.Selector, error) {
@@ -1759,6 +1837,7 @@ func createRegistrationEntry(tx *gorm.DB, entry *common.RegistrationEntry) (*com
 		Downstream: entry.Downstream,
 		Expiry:     entry.EntryExpiry,
 		StoreSvid:  entry.StoreSvid,
+		JWTSvidTTL: entry.JwtSvidTtl,
 	}
 
 	if err := tx.Create(&newRegisteredEntry).Error; err != nil {
@@ -1796,6 +1875,22 @@ func createRegistrationEntry(tx *gorm.DB, entry *common.RegistrationEntry) (*com
 		}
 	}
 
+	for name, value := range entry.Labels {
+		newLabel := EntryLabel{
+			RegisteredEntryID: newRegisteredEntry.ID,
+			Name:              name,
+			Value:             value,
+		}
+
+		if err := tx.Create(&newLabel).Error; err != nil {
+			return nil, sqlError.Wrap(err)
+		}
+	}
+
+	if err := createRegistrationEntryEvent(tx, entryID); err != nil {
+		return nil, err
+	}
+
 	registrationEntry, err := modelToEntry(tx, newRegisteredEntry)
 	if err != nil {
 		return nil, err
@@ -1879,7 +1974,10 @@ SELECT
 	NULL AS trust_domain,
 	NULL AS dns_name_id,
 	NULL AS dns_name,
-	revision_number
+	revision_number,
+	jwt_svid_ttl,
+	NULL AS label_name,
+	NULL AS label_value
 FROM
 	registered_entries
 WHERE id IN (SELECT id FROM listing)
@@ -1887,7 +1985,7 @@ WHERE id IN (SELECT id FROM listing)
 UNION
 
 SELECT
-	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL
+	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	bundles B
 INNER JOIN
@@ -1900,7 +1998,7 @@ WHERE
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL, NULL, NULL, NULL
 FROM
 	dns_names
 WHERE registered_entry_id IN (SELECT id FROM listing)
@@ -1908,11 +2006,19 @@ WHERE registered_entry_id IN (SELECT id FROM listing)
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	selectors
 WHERE registered_entry_id IN (SELECT id FROM listing)
 
+UNION
+
+SELECT
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, name, value
+FROM
+	entry_labels
+WHERE registered_entry_id IN (SELECT id FROM listing)
+
 ORDER BY selector_id, dns_name_id
 ;`
 	return query, []interface{}{entryID}, nil
@@ -1939,7 +2045,10 @@ SELECT
 	NULL AS trust_domain,
 	NULL ::integer AS dns_name_id,
 	NULL AS dns_name,
-	revision_number
+	revision_number,
+	jwt_svid_ttl,
+	NULL AS label_name,
+	NULL AS label_value
 FROM
 	registered_entries
 WHERE id IN (SELECT id FROM listing)
@@ -1947,7 +2056,7 @@ WHERE id IN (SELECT id FROM listing)
 UNION
 
 SELECT
-	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL
+	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	bundles B
 INNER JOIN
@@ -1960,7 +2069,7 @@ WHERE
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL, NULL, NULL, NULL
 FROM
 	dns_names
 WHERE registered_entry_id IN (SELECT id FROM listing)
@@ -1968,11 +2077,19 @@ WHERE registered_entry_id IN (SELECT id FROM listing)
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	selectors
 WHERE registered_entry_id IN (SELECT id FROM listing)
 
+UNION
+
+SELECT
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, name, value
+FROM
+	entry_labels
+WHERE registered_entry_id IN (SELECT id FROM listing)
+
 ORDER BY selector_id, dns_name_id
 ;`
 	return query, []interface{}{entryID}, nil
@@ -1996,17 +2113,22 @@ SELECT
 	B.trust_domain,
 	D.id AS dns_name_id,
 	D.value AS dns_name,
-	E.revision_number
+	E.revision_number,
+	E.jwt_svid_ttl,
+	L.name AS label_name,
+	L.value AS label_value
 FROM
 	registered_entries E
 LEFT JOIN
-	(SELECT 1 AS joinItem UNION SELECT 2 UNION SELECT 3) AS joinItems ON TRUE
+	(SELECT 1 AS joinItem UNION SELECT 2 UNION SELECT 3 UNION SELECT 4) AS joinItems ON TRUE
 LEFT JOIN
 	selectors S ON joinItem=1 AND E.id=S.registered_entry_id
 LEFT JOIN
 	dns_names D ON joinItem=2 AND E.id=D.registered_entry_id
 LEFT JOIN
 	(federated_registration_entries F INNER JOIN bundles B ON F.bundle_id=B.id) ON joinItem=3 AND E.id=F.registered_entry_id
+LEFT JOIN
+	entry_labels L ON joinItem=4 AND E.id=L.registered_entry_id
 WHERE E.entry_id = ?
 ORDER BY selector_id, dns_name_id
 ;`
@@ -2034,7 +2156,10 @@ SELECT
 	NULL AS trust_domain,
 	NULL AS dns_name_id,
 	NULL AS dns_name,
-	revision_number
+	revision_number,
+	jwt_svid_ttl,
+	NULL AS label_name,
+	NULL AS label_value
 FROM
 	registered_entries
 WHERE id IN (SELECT id FROM listing)
@@ -2042,7 +2167,7 @@ WHERE id IN (SELECT id FROM listing)
 UNION
 
 SELECT
-	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL
+	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	bundles B
 INNER JOIN
@@ -2055,7 +2180,7 @@ WHERE
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL, NULL, NULL, NULL
 FROM
 	dns_names
 WHERE registered_entry_id IN (SELECT id FROM listing)
@@ -2063,11 +2188,19 @@ WHERE registered_entry_id IN (SELECT id FROM listing)
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	selectors
 WHERE registered_entry_id IN (SELECT id FROM listing)
 
+UNION
+
+SELECT
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, name, value
+FROM
+	entry_labels
+WHERE registered_entry_id IN (SELECT id FROM listing)
+
 ORDER BY selector_id, dns_name_id
 ;`
 	return query, []interface{}{entryID}, nil
@@ -2281,7 +2414,10 @@ SELECT
 	NULL AS trust_domain,
 	NULL AS dns_name_id,
 	NULL AS dns_name,
-	revision_number
+	revision_number,
+	jwt_svid_ttl,
+	NULL AS label_name,
+	NULL AS label_value
 FROM
 	registered_entries
 `)
@@ -2292,7 +2428,7 @@ FROM
 UNION
 
 SELECT
-	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL
+	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	bundles B
 INNER JOIN
@@ -2307,7 +2443,7 @@ ON
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL, NULL, NULL, NULL
 FROM
 	dns_names
 `)
@@ -2318,7 +2454,7 @@ FROM
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	selectors
 `)
@@ -2326,6 +2462,17 @@ FROM
 		builder.WriteString("WHERE registered_entry_id IN (SELECT e_id FROM listing)\n")
 	}
 	builder.WriteString(`
+UNION
+
+SELECT
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, name, value
+FROM
+	entry_labels
+`)
+	if filtered {
+		builder.WriteString("WHERE registered_entry_id IN (SELECT e_id FROM listing)\n")
+	}
+	builder.WriteString(`
 ORDER BY e_id, selector_id, dns_name_id
 ;`)
 
@@ -2360,7 +2507,10 @@ SELECT
 	NULL AS trust_domain,
 	NULL ::integer AS dns_name_id,
 	NULL AS dns_name,
-	revision_number
+	revision_number,
+	jwt_svid_ttl,
+	NULL AS label_name,
+	NULL AS label_value
 FROM
 	registered_entries
 `)
@@ -2371,7 +2521,7 @@ FROM
 UNION
 
 SELECT
-	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL
+	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	bundles B
 INNER JOIN
@@ -2386,7 +2536,7 @@ ON
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL, NULL, NULL, NULL
 FROM
 	dns_names
 `)
@@ -2397,7 +2547,7 @@ FROM
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	selectors
 `)
@@ -2405,6 +2555,17 @@ FROM
 		builder.WriteString("WHERE registered_entry_id IN (SELECT e_id FROM listing)\n")
 	}
 	builder.WriteString(`
+UNION
+
+SELECT
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, name, value
+FROM
+	entry_labels
+`)
+	if filtered {
+		builder.WriteString("WHERE registered_entry_id IN (SELECT e_id FROM listing)\n")
+	}
+	builder.WriteString(`
 ORDER BY e_id, selector_id, dns_name_id
 ;`)
 
@@ -2443,17 +2604,22 @@ SELECT
 	B.trust_domain,
 	D.id AS dns_name_id,
 	D.value AS dns_name,
-	E.revision_number
+	E.revision_number,
+	E.jwt_svid_ttl,
+	L.name AS label_name,
+	L.value AS label_value
 FROM
 	registered_entries E
 LEFT JOIN
-	(SELECT 1 AS joinItem UNION SELECT 2 UNION SELECT 3) AS joinItems ON TRUE
+	(SELECT 1 AS joinItem UNION SELECT 2 UNION SELECT 3 UNION SELECT 4) AS joinItems ON TRUE
 LEFT JOIN
 	selectors S ON joinItem=1 AND E.id=S.registered_entry_id
 LEFT JOIN
 	dns_names D ON joinItem=2 AND E.id=D.registered_entry_id
 LEFT JOIN
 	(federated_registration_entries F INNER JOIN bundles B ON F.bundle_id=B.id) ON joinItem=3 AND E.id=F.registered_entry_id
+LEFT JOIN
+	entry_labels L ON joinItem=4 AND E.id=L.registered_entry_id
 `)
 
 	filtered, args, err := appendListRegistrationEntriesFilterQuery("WHERE E.id IN (\n", builder, MySQL, req)
@@ -2498,7 +2664,10 @@ SELECT
 	NULL AS trust_domain,
 	NULL AS dns_name_id,
 	NULL AS dns_name,
-	revision_number
+	revision_number,
+	jwt_svid_ttl,
+	NULL AS label_name,
+	NULL AS label_value
 FROM
 	registered_entries
 `)
@@ -2509,7 +2678,7 @@ FROM
 UNION
 
 SELECT
-	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL
+	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	bundles B
 INNER JOIN
@@ -2524,7 +2693,7 @@ ON
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL, NULL, NULL, NULL
 FROM
 	dns_names
 `)
@@ -2535,7 +2704,7 @@ FROM
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	selectors
 `)
@@ -2543,6 +2712,17 @@ FROM
 		builder.WriteString("WHERE registered_entry_id IN (SELECT e_id FROM listing)\n")
 	}
 	builder.WriteString(`
+UNION
+
+SELECT
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, name, value
+FROM
+	entry_labels
+`)
+	if filtered {
+		builder.WriteString("WHERE registered_entry_id IN (SELECT e_id FROM listing)\n")
+	}
+	builder.WriteString(`
 ORDER BY e_id, selector_id, dns_name_id
 ;`)
 
@@ -2736,6 +2916,31 @@ func appendListRegistrationEntriesFilterQuery(filterExp string, builder *strings
 		}
 	}
 
+	if len(req.ByLabels) > 0 {
+		// Entries must have all the labels in the request, so each label
+		// is added directly to the root idFilterNode, which is an intersection
+		names := make([]string, 0, len(req.ByLabels))
+		for name := range req.ByLabels {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			root.children = append(root.children, idFilterNode{
+				idColumn: "registered_entry_id",
+				query:    []string{"SELECT registered_entry_id AS e_id FROM entry_labels WHERE name = ? AND value = ?"},
+			})
+			args = append(args, name, req.ByLabels[name])
+		}
+	}
+
+	if !req.ByExpiresBefore.IsZero() {
+		root.children = append(root.children, idFilterNode{
+			idColumn: "id",
+			query:    []string{"SELECT id AS e_id FROM registered_entries WHERE expiry != 0 AND expiry < ?"},
+		})
+		args = append(args, req.ByExpiresBefore.Unix())
+	}
+
 	if req.ByFederatesWith != nil && len(req.ByFederatesWith.TrustDomains) > 0 {
 		// Take the trust domains from the request without duplicates
 		tdSet := make(map[string]struct{})
@@ -2984,6 +3189,9 @@ type entryRow struct {
 	DNSNameID      sql.NullInt64
 	DNSName        sql.NullString
 	RevisionNumber sql.NullInt64
+	JWTSvidTTL     sql.NullInt64
+	LabelName      sql.NullString
+	LabelValue     sql.NullString
 }
 
 func scanEntryRow(rs *sql.Rows, r *entryRow) error {
@@ -3004,6 +3212,9 @@ func scanEntryRow(rs *sql.Rows, r *entryRow) error {
 		&r.DNSNameID,
 		&r.DNSName,
 		&r.RevisionNumber,
+		&r.JWTSvidTTL,
+		&r.LabelName,
+		&r.LabelValue,
 	))
 }
 
@@ -3035,6 +3246,9 @@ func fillEntryFromRow(entry *common.RegistrationEntry, r *entryRow) error {
 	if r.RevisionNumber.Valid {
 		entry.RevisionNumber = r.RevisionNumber.Int64
 	}
+	if r.JWTSvidTTL.Valid {
+		entry.JwtSvidTtl = int32(r.JWTSvidTTL.Int64)
+	}
 
 	if r.SelectorType.Valid {
 		if !r.SelectorValue.Valid {
@@ -3050,6 +3264,13 @@ func fillEntryFromRow(entry *common.RegistrationEntry, r *entryRow) error {
 		entry.DnsNames = append(entry.DnsNames, r.DNSName.String)
 	}
 
+	if r.LabelName.Valid {
+		if entry.Labels == nil {
+			entry.Labels = make(map[string]string)
+		}
+		entry.Labels[r.LabelName.String] = r.LabelValue.String
+	}
+
 	if r.TrustDomain.Valid {
 		entry.FederatesWith = append(entry.FederatesWith, r.TrustDomain.String)
 	}
@@ -3074,8 +3295,8 @@ func applyPagination(p *datastore.Pagination, entryTx *gorm.DB) (*gorm.DB, error
 }
 
 func updateRegistrationEntry(tx *gorm.DB, e *common.RegistrationEntry, mask *common.RegistrationEntryMask) (*common.RegistrationEntry, error) {
-	if err := validateRegistrationEntryForUpdate(e, mask); err != nil {
-		return nil, err
+	if err := datastore.ValidateRegistrationEntryForUpdate(e, mask); err != nil {
+		return nil, sqlError.Wrap(err)
 	}
 
 	// Get the existing entry
@@ -3083,6 +3304,14 @@ func updateRegistrationEntry(tx *gorm.DB, e *common.RegistrationEntry, mask *com
 	if err := tx.Find(&entry, "entry_id = ?", e.EntryId).Error; err != nil {
 		return nil, sqlError.Wrap(err)
 	}
+
+	// The revision number is only checked when explicitly requested. The
+	// entry is read and written in a read-modify-write transaction, so a
+	// concurrent update either fails the check or fails the transaction.
+	if mask != nil && mask.RevisionNumber && entry.RevisionNumber != e.RevisionNumber {
+		return nil, status.Newf(codes.Aborted, "datastore-sql: registration entry revision number mismatch: expected %d, found %d", e.RevisionNumber, entry.RevisionNumber).Err()
+	}
+
 	if mask == nil || mask.StoreSvid {
 		entry.StoreSvid = e.StoreSvid
 	}
@@ -3126,6 +3355,22 @@ func updateRegistrationEntry(tx *gorm.DB, e *common.RegistrationEntry, mask *com
 		entry.DNSList = dnsList
 	}
 
+	if mask == nil || mask.Labels {
+		// Delete existing labels - we will write new ones
+		if err := tx.Exec("DELETE FROM entry_labels WHERE registered_entry_id = ?", entry.ID).Error; err != nil {
+			return nil, sqlError.Wrap(err)
+		}
+
+		labels := []EntryLabel{}
+		for name, value := range e.Labels {
+			labels = append(labels, EntryLabel{
+				Name:  name,
+				Value: value,
+			})
+		}
+		entry.Labels = labels
+	}
+
 	if mask == nil || mask.SpiffeId {
 		entry.SpiffeID = e.SpiffeId
 	}
@@ -3135,6 +3380,9 @@ func updateRegistrationEntry(tx *gorm.DB, e *common.RegistrationEntry, mask *com
 	if mask == nil || mask.Ttl {
 		entry.TTL = e.Ttl
 	}
+	if mask == nil || mask.JwtSvidTtl {
+		entry.JWTSvidTTL = e.JwtSvidTtl
+	}
 	if mask == nil || mask.Admin {
 		entry.Admin = e.Admin
 	}
@@ -3164,6 +3412,10 @@ func updateRegistrationEntry(tx *gorm.DB, e *common.RegistrationEntry, mask *com
 		// The FederatesWith field in entry is filled in by the call to modelToEntry below
 	}
 
+	if err := createRegistrationEntryEvent(tx, entry.EntryID); err != nil {
+		return nil, err
+	}
+
 	returnEntry, err := modelToEntry(tx, entry)
 	if err != nil {
 		return nil, err
@@ -3205,19 +3457,102 @@ func deleteRegistrationEntrySupport(tx *gorm.DB, entry RegisteredEntry) error {
 		return sqlError.Wrap(err)
 	}
 
-	return nil
+	// Delete existing labels
+	if err := tx.Exec("DELETE FROM entry_labels WHERE registered_entry_id = ?", entry.ID).Error; err != nil {
+		return sqlError.Wrap(err)
+	}
+
+	return createRegistrationEntryEvent(tx, entry.EntryID)
 }
 
-func pruneRegistrationEntries(tx *gorm.DB, expiresBefore time.Time) error {
+func pruneRegistrationEntries(tx *gorm.DB, expiresBefore time.Time) ([]*common.RegistrationEntry, error) {
 	var registrationEntries []RegisteredEntry
 	if err := tx.Where("expiry != 0").Where("expiry < ?", expiresBefore.Unix()).Find(&registrationEntries).Error; err != nil {
-		return err
+		return nil, err
 	}
 
+	pruned := make([]*common.RegistrationEntry, 0, len(registrationEntries))
 	for _, entry := range registrationEntries {
+		registrationEntry, err := modelToEntry(tx, entry)
+		if err != nil {
+			return nil, err
+		}
 		if err := deleteRegistrationEntrySupport(tx, entry); err != nil {
-			return err
+			return nil, err
 		}
+		pruned = append(pruned, registrationEntry)
+	}
+
+	return pruned, nil
+}
+
+func createRegistrationEntryEvent(tx *gorm.DB, entryID string) error {
+	if err := tx.Create(&RegisteredEntryEvent{EntryID: entryID}).Error; err != nil {
+		return sqlError.Wrap(err)
+	}
+
+	return nil
+}
+
+func listRegistrationEntryEvents(tx *gorm.DB, req *datastore.ListRegistrationEntryEventsRequest) (*datastore.ListRegistrationEntryEventsResponse, error) {
+	var models []RegisteredEntryEvent
+	if err := tx.Where("id > ?", req.GreaterThanEventID).Order("id asc").Find(&models).Error; err != nil {
+		return nil, sqlError.Wrap(err)
+	}
+
+	resp := &datastore.ListRegistrationEntryEventsResponse{
+		Events: make([]datastore.RegistrationEntryEvent, 0, len(models)),
+	}
+	for _, model := range models {
+		resp.Events = append(resp.Events, datastore.RegistrationEntryEvent{
+			EventID:   model.ID,
+			EntryID:   model.EntryID,
+			CreatedAt: model.CreatedAt,
+		})
+	}
+
+	return resp, nil
+}
+
+func pruneRegistrationEntryEvents(tx *gorm.DB, createdBefore time.Time) error {
+	if err := tx.Where("created_at < ?", createdBefore).Delete(&RegisteredEntryEvent{}).Error; err != nil {
+		return sqlError.Wrap(err)
+	}
+
+	return nil
+}
+
+func createAttestedNodeEvent(tx *gorm.DB, spiffeID string) error {
+	if err := tx.Create(&AttestedNodeEvent{SpiffeID: spiffeID}).Error; err != nil {
+		return sqlError.Wrap(err)
+	}
+
+	return nil
+}
+
+func listAttestedNodeEvents(tx *gorm.DB, req *datastore.ListAttestedNodeEventsRequest) (*datastore.ListAttestedNodeEventsResponse, error) {
+	var models []AttestedNodeEvent
+	if err := tx.Where("id > ?", req.GreaterThanEventID).Order("id asc").Find(&models).Error; err != nil {
+		return nil, sqlError.Wrap(err)
+	}
+
+	resp := &datastore.ListAttestedNodeEventsResponse{
+		Events: make([]datastore.AttestedNodeEvent, 0, len(models)),
+	}
+	for _, model := range models {
+		resp.Events = append(resp.Events, datastore.AttestedNodeEvent{
+			EventID:   model.ID,
+			SpiffeID:  model.SpiffeID,
+			CreatedAt: model.CreatedAt,
+		})
+	}
+
+	return resp, nil
+}
+
+func pruneAttestedNodeEvents(tx *gorm.DB, createdBefore time.Time) error {
+	if err := tx.Where("created_at < ?", createdBefore).Delete(&AttestedNodeEvent{}).Error; err != nil {
+		return sqlError.Wrap(err)
 	}
 
 	return nil
@@ -3476,39 +3811,6 @@ func modelToBundle(model *Bundle) (*common.Bundle, error) {
 	return bundle, nil
 }
 
-func validateRegistrationEntry(entry *common.RegistrationEntry) error {
-	if entry == nil {
-		return sqlError.New("invalid request: missing registered entry")
-	}
-
-	if entry.Selectors == nil || len(entry.Selectors) == 0 {
-		return sqlError.New("invalid registration entry: missing selector list")
-	}
-
-	// In case of StoreSvid is set, all entries 'must' be the same type,
-	// it is done to avoid users to mix selectors from different platforms in
-	// entries with storable SVIDs
-	if entry.StoreSvid {
-		// Selectors must never be empty
-		tpe := entry.Selectors[0].Type
-		for _, t := range entry.Selectors {
-			if tpe != t.Type {
-				return sqlError.New("invalid registration entry: selector types must be the same when store SVID is enabled")
-			}
-		}
-	}
-
-	if len(entry.SpiffeId) == 0 {
-		return sqlError.New("invalid registration entry: missing SPIFFE ID")
-	}
-
-	if entry.Ttl < 0 {
-		return sqlError.New("invalid registration entry: TTL is not set")
-	}
-
-	return nil
-}
-
 // equalSelectorTypes validates that all selectors has the same type,
 func equalSelectorTypes(selectors []Selector) bool {
 	typ := ""
@@ -3523,29 +3825,6 @@ func equalSelectorTypes(selectors []Selector) bool {
 	return true
 }
 
-func validateRegistrationEntryForUpdate(entry *common.RegistrationEntry, mask *common.RegistrationEntryMask) error {
-	if entry == nil {
-		return sqlError.New("invalid request: missing registered entry")
-	}
-
-	if (mask == nil || mask.Selectors) &&
-		(entry.Selectors == nil || len(entry.Selectors) == 0) {
-		return sqlError.New("invalid registration entry: missing selector list")
-	}
-
-	if (mask == nil || mask.SpiffeId) &&
-		entry.SpiffeId == "" {
-		return sqlError.New("invalid registration entry: missing SPIFFE ID")
-	}
-
-	if (mask == nil || mask.Ttl) &&
-		(entry.Ttl < 0) {
-		return sqlError.New("invalid registration entry: TTL is not set")
-	}
-
-	return nil
-}
-
 // bundleToModel converts the given Protobuf bundle message to a database model. It
 // performs validation, and fully parses certificates to form CACert embedded models.
 func bundleToModel(pb *common.Bundle) (*Bundle, error) {
@@ -3595,6 +3874,19 @@ func modelToEntry(tx *gorm.DB, model RegisteredEntry) (*common.RegistrationEntry
 		}
 	}
 
+	var fetchedLabels []*EntryLabel
+	if err := tx.Model(&model).Related(&fetchedLabels).Error; err != nil {
+		return nil, sqlError.Wrap(err)
+	}
+
+	var labels map[string]string
+	if len(fetchedLabels) > 0 {
+		labels = make(map[string]string, len(fetchedLabels))
+		for _, fetchedLabel := range fetchedLabels {
+			labels[fetchedLabel.Name] = fetchedLabel.Value
+		}
+	}
+
 	var fetchedBundles []*Bundle
 	if err := tx.Model(&model).Association("FederatesWith").Find(&fetchedBundles).Error; err != nil {
 		return nil, sqlError.Wrap(err)
@@ -3618,6 +3910,8 @@ func modelToEntry(tx *gorm.DB, model RegisteredEntry) (*common.RegistrationEntry
 		DnsNames:       dnsList,
 		RevisionNumber: model.RevisionNumber,
 		StoreSvid:      model.StoreSvid,
+		JwtSvidTtl:     model.JWTSvidTTL,
+		Labels:         labels,
 	}, nil
 }
 
@@ -1094,6 +1094,54 @@ func (s *PluginSuite) TestDeleteAttestedNode() {
 	s.Nil(attestedNode)
 }
 
+func (s *PluginSuite) TestAttestedNodeEvents() {
+	node := &common.AttestedNode{
+		SpiffeId:            "spiffe://example.org/spire/agent/foo",
+		AttestationDataType: "aws-tag",
+		CertSerialNumber:    "badcafe",
+		CertNotAfter:        time.Now().Add(time.Hour).Unix(),
+	}
+
+	_, err := s.ds.CreateAttestedNode(ctx, node)
+	s.Require().NoError(err)
+	_, err = s.ds.UpdateAttestedNode(ctx, node, nil)
+	s.Require().NoError(err)
+	err = s.ds.SetNodeSelectors(ctx, node.SpiffeId, []*common.Selector{{Type: "TYPE", Value: "VALUE"}})
+	s.Require().NoError(err)
+	_, err = s.ds.DeleteAttestedNode(ctx, node.SpiffeId)
+	s.Require().NoError(err)
+
+	resp, err := s.ds.ListAttestedNodeEvents(ctx, &datastore.ListAttestedNodeEventsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Events, 4)
+	for i, event := range resp.Events {
+		s.Equal(node.SpiffeId, event.SpiffeID)
+		if i > 0 {
+			s.Greater(event.EventID, resp.Events[i-1].EventID)
+		}
+	}
+
+	// Only the events after the given one are listed
+	resp, err = s.ds.ListAttestedNodeEvents(ctx, &datastore.ListAttestedNodeEventsRequest{
+		GreaterThanEventID: resp.Events[1].EventID,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Events, 2)
+
+	// Events created after the given time are not pruned
+	err = s.ds.PruneAttestedNodeEvents(ctx, time.Now().Add(-time.Hour))
+	s.Require().NoError(err)
+	resp, err = s.ds.ListAttestedNodeEvents(ctx, &datastore.ListAttestedNodeEventsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Events, 4)
+
+	err = s.ds.PruneAttestedNodeEvents(ctx, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+	resp, err = s.ds.ListAttestedNodeEvents(ctx, &datastore.ListAttestedNodeEventsRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(resp.Events)
+}
+
 func (s *PluginSuite) TestNodeSelectors() {
 	foo1 := []*common.Selector{
 		{Type: "FOO1", Value: "1"},
@@ -1329,6 +1377,21 @@ func (s *PluginSuite) TestFetchRegistrationEntry() {
 				StoreSvid: true,
 			},
 		},
+		{
+			name: "entry with labels",
+			entry: &common.RegistrationEntry{
+				Selectors: []*common.Selector{
+					{Type: "Type1", Value: "Value1"},
+				},
+				SpiffeId: "SpiffeId",
+				ParentId: "ParentId",
+				Ttl:      1,
+				Labels: map[string]string{
+					"team":     "payments",
+					"pipeline": "deploy",
+				},
+			},
+		},
 	} {
 		tt := tt
 		s.T().Run(tt.name, func(t *testing.T) {
@@ -1361,30 +1424,90 @@ func (s *PluginSuite) TestPruneRegistrationEntries() {
 	s.Require().NoError(err)
 
 	// Ensure we don't prune valid entries, wind clock back 10s
-	err = s.ds.PruneRegistrationEntries(ctx, now.Add(-10*time.Second))
+	pruned, err := s.ds.PruneRegistrationEntries(ctx, now.Add(-10*time.Second))
 	s.Require().NoError(err)
+	s.Empty(pruned)
 
 	fetchedRegistrationEntry, err := s.ds.FetchRegistrationEntry(ctx, createdRegistrationEntry.EntryId)
 	s.Require().NoError(err)
 	s.Equal(createdRegistrationEntry, fetchedRegistrationEntry)
 
 	// Ensure we don't prune on the exact ExpiresBefore
-	err = s.ds.PruneRegistrationEntries(ctx, now)
+	pruned, err = s.ds.PruneRegistrationEntries(ctx, now)
 	s.Require().NoError(err)
+	s.Empty(pruned)
 
 	fetchedRegistrationEntry, err = s.ds.FetchRegistrationEntry(ctx, createdRegistrationEntry.EntryId)
 	s.Require().NoError(err)
 	s.Equal(createdRegistrationEntry, fetchedRegistrationEntry)
 
-	// Ensure we prune old entries
-	err = s.ds.PruneRegistrationEntries(ctx, now.Add(10*time.Second))
+	// Ensure we prune old entries, and return them
+	pruned, err = s.ds.PruneRegistrationEntries(ctx, now.Add(10*time.Second))
 	s.Require().NoError(err)
+	s.Equal([]*common.RegistrationEntry{createdRegistrationEntry}, pruned)
 
 	fetchedRegistrationEntry, err = s.ds.FetchRegistrationEntry(ctx, createdRegistrationEntry.EntryId)
 	s.Require().NoError(err)
 	s.Nil(fetchedRegistrationEntry)
 }
 
+func (s *PluginSuite) TestRegistrationEntryEvents() {
+	entry := s.createRegistrationEntry(&common.RegistrationEntry{
+		Selectors: []*common.Selector{{Type: "Type1", Value: "Value1"}},
+		SpiffeId:  "spiffe://example.org/foo",
+		ParentId:  "spiffe://example.org/bar",
+	})
+	expired := s.createRegistrationEntry(&common.RegistrationEntry{
+		Selectors:   []*common.Selector{{Type: "Type1", Value: "Value1"}},
+		SpiffeId:    "spiffe://example.org/baz",
+		ParentId:    "spiffe://example.org/bar",
+		EntryExpiry: time.Now().Add(-time.Hour).Unix(),
+	})
+
+	entry.Ttl = 10
+	_, err := s.ds.UpdateRegistrationEntry(ctx, entry, nil)
+	s.Require().NoError(err)
+	_, err = s.ds.DeleteRegistrationEntry(ctx, entry.EntryId)
+	s.Require().NoError(err)
+	_, err = s.ds.PruneRegistrationEntries(ctx, time.Now())
+	s.Require().NoError(err)
+
+	resp, err := s.ds.ListRegistrationEntryEvents(ctx, &datastore.ListRegistrationEntryEventsRequest{})
+	s.Require().NoError(err)
+	var entryIDs []string
+	for _, event := range resp.Events {
+		entryIDs = append(entryIDs, event.EntryID)
+	}
+	s.Require().Equal([]string{
+		entry.EntryId,   // created
+		expired.EntryId, // created
+		entry.EntryId,   // updated
+		entry.EntryId,   // deleted
+		expired.EntryId, // pruned
+	}, entryIDs)
+
+	// Only the events after the given one are listed
+	resp, err = s.ds.ListRegistrationEntryEvents(ctx, &datastore.ListRegistrationEntryEventsRequest{
+		GreaterThanEventID: resp.Events[3].EventID,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Events, 1)
+	s.Require().Equal(expired.EntryId, resp.Events[0].EntryID)
+
+	// Events created after the given time are not pruned
+	err = s.ds.PruneRegistrationEntryEvents(ctx, time.Now().Add(-time.Hour))
+	s.Require().NoError(err)
+	resp, err = s.ds.ListRegistrationEntryEvents(ctx, &datastore.ListRegistrationEntryEventsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Events, 5)
+
+	err = s.ds.PruneRegistrationEntryEvents(ctx, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+	resp, err = s.ds.ListRegistrationEntryEvents(ctx, &datastore.ListRegistrationEntryEventsRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(resp.Events)
+}
+
 func (s *PluginSuite) TestFetchInexistentRegistrationEntry() {
 	fetchedRegistrationEntry, err := s.ds.FetchRegistrationEntry(ctx, "INEXISTENT")
 	s.Require().NoError(err)
@@ -2164,6 +2287,48 @@ func (s *PluginSuite) TestUpdateRegistrationEntry() {
 	s.RequireGRPCStatus(err, codes.NotFound, _notFoundErrMsg)
 }
 
+func (s *PluginSuite) TestUpdateRegistrationEntryWithRevisionNumber() {
+	entry := s.createRegistrationEntry(&common.RegistrationEntry{
+		Selectors: []*common.Selector{{Type: "Type1", Value: "Value1"}},
+		SpiffeId:  "spiffe://example.org/foo",
+		ParentId:  "spiffe://example.org/bar",
+		Ttl:       1,
+	})
+	mask := &common.RegistrationEntryMask{Ttl: true, RevisionNumber: true}
+
+	// The update succeeds on the expected revision number
+	updated, err := s.ds.UpdateRegistrationEntry(ctx, &common.RegistrationEntry{
+		EntryId:        entry.EntryId,
+		Ttl:            2,
+		RevisionNumber: 0,
+	}, mask)
+	s.Require().NoError(err)
+	s.Require().Equal(int32(2), updated.Ttl)
+	s.Require().Equal(int64(1), updated.RevisionNumber)
+
+	// The update fails on a stale revision number
+	_, err = s.ds.UpdateRegistrationEntry(ctx, &common.RegistrationEntry{
+		EntryId:        entry.EntryId,
+		Ttl:            3,
+		RevisionNumber: 0,
+	}, mask)
+	s.RequireGRPCStatus(err, codes.Aborted, "datastore-sql: registration entry revision number mismatch: expected 0, found 1")
+
+	fetched, err := s.ds.FetchRegistrationEntry(ctx, entry.EntryId)
+	s.Require().NoError(err)
+	s.RequireProtoEqual(updated, fetched)
+
+	// The revision number is not checked unless requested in the mask
+	updated, err = s.ds.UpdateRegistrationEntry(ctx, &common.RegistrationEntry{
+		EntryId:        entry.EntryId,
+		Ttl:            3,
+		RevisionNumber: 0,
+	}, &common.RegistrationEntryMask{Ttl: true})
+	s.Require().NoError(err)
+	s.Require().Equal(int32(3), updated.Ttl)
+	s.Require().Equal(int64(2), updated.RevisionNumber)
+}
+
 func (s *PluginSuite) TestUpdateRegistrationEntryWithStoreSvid() {
 	entry := s.createRegistrationEntry(&common.RegistrationEntry{
 		Selectors: []*common.Selector{
@@ -2210,6 +2375,7 @@ func (s *PluginSuite) TestUpdateRegistrationEntryWithMask() {
 		ParentId:      "spiffe://example.org/oldParentId",
 		SpiffeId:      "spiffe://example.org/oldSpiffeId",
 		Ttl:           1000,
+		JwtSvidTtl:    300,
 		Selectors:     []*common.Selector{{Type: "Type1", Value: "Value1"}},
 		FederatesWith: []string{"spiffe://dom1.org"},
 		Admin:         false,
@@ -2217,11 +2383,13 @@ func (s *PluginSuite) TestUpdateRegistrationEntryWithMask() {
 		DnsNames:      []string{"dns1"},
 		Downstream:    false,
 		StoreSvid:     false,
+		Labels:        map[string]string{"team": "payments"},
 	}
 	newEntry := &common.RegistrationEntry{
 		ParentId:      "spiffe://example.org/oldParentId",
 		SpiffeId:      "spiffe://example.org/newSpiffeId",
 		Ttl:           1000,
+		JwtSvidTtl:    600,
 		Selectors:     []*common.Selector{{Type: "Type2", Value: "Value2"}},
 		FederatesWith: []string{"spiffe://dom2.org"},
 		Admin:         false,
@@ -2229,17 +2397,20 @@ func (s *PluginSuite) TestUpdateRegistrationEntryWithMask() {
 		DnsNames:      []string{"dns2"},
 		Downstream:    false,
 		StoreSvid:     false,
+		Labels:        map[string]string{"team": "billing", "pipeline": "deploy"},
 	}
 	badEntry := &common.RegistrationEntry{
 		ParentId:      "not a good parent id",
 		SpiffeId:      "",
 		Ttl:           -1000,
+		JwtSvidTtl:    -1000,
 		Selectors:     []*common.Selector{},
 		FederatesWith: []string{"invalid federated bundle"},
 		Admin:         false,
 		EntryExpiry:   -2000,
 		DnsNames:      []string{"this is a bad domain name "},
 		Downstream:    false,
+		Labels:        map[string]string{"": "no name"},
 	}
 	// Needed for the FederatesWith field to work
 	s.createBundle("spiffe://dom1.org")
@@ -2287,6 +2458,40 @@ func (s *PluginSuite) TestUpdateRegistrationEntryWithMask() {
 			mask:   &common.RegistrationEntryMask{Ttl: false},
 			update: func(e *common.RegistrationEntry) { e.Ttl = badEntry.Ttl },
 			result: func(e *common.RegistrationEntry) {}},
+		// JWT-SVID TTL FIELD -- This field is validated so we check with good and bad data
+		{name: "Update JWT-SVID TTL, Good Data, Mask True",
+			mask:   &common.RegistrationEntryMask{JwtSvidTtl: true},
+			update: func(e *common.RegistrationEntry) { e.JwtSvidTtl = newEntry.JwtSvidTtl },
+			result: func(e *common.RegistrationEntry) { e.JwtSvidTtl = newEntry.JwtSvidTtl }},
+		{name: "Update JWT-SVID TTL, Good Data, Mask False",
+			mask:   &common.RegistrationEntryMask{JwtSvidTtl: false},
+			update: func(e *common.RegistrationEntry) { e.JwtSvidTtl = newEntry.JwtSvidTtl },
+			result: func(e *common.RegistrationEntry) {}},
+		{name: "Update JWT-SVID TTL, Bad Data, Mask True",
+			mask:   &common.RegistrationEntryMask{JwtSvidTtl: true},
+			update: func(e *common.RegistrationEntry) { e.JwtSvidTtl = badEntry.JwtSvidTtl },
+			err:    errors.New("invalid registration entry: JWT-SVID TTL is negative")},
+		{name: "Update JWT-SVID TTL, Bad Data, Mask False",
+			mask:   &common.RegistrationEntryMask{JwtSvidTtl: false},
+			update: func(e *common.RegistrationEntry) { e.JwtSvidTtl = badEntry.JwtSvidTtl },
+			result: func(e *common.RegistrationEntry) {}},
+		// LABELS FIELD -- This field is validated so we check with good and bad data
+		{name: "Update Labels, Good Data, Mask True",
+			mask:   &common.RegistrationEntryMask{Labels: true},
+			update: func(e *common.RegistrationEntry) { e.Labels = newEntry.Labels },
+			result: func(e *common.RegistrationEntry) { e.Labels = newEntry.Labels }},
+		{name: "Update Labels, Good Data, Mask False",
+			mask:   &common.RegistrationEntryMask{Labels: false},
+			update: func(e *common.RegistrationEntry) { e.Labels = newEntry.Labels },
+			result: func(e *common.RegistrationEntry) {}},
+		{name: "Update Labels, Bad Data, Mask True",
+			mask:   &common.RegistrationEntryMask{Labels: true},
+			update: func(e *common.RegistrationEntry) { e.Labels = badEntry.Labels },
+			err:    errors.New("invalid registration entry: label name cannot be empty")},
+		{name: "Update Labels, Bad Data, Mask False",
+			mask:   &common.RegistrationEntryMask{Labels: false},
+			update: func(e *common.RegistrationEntry) { e.Labels = badEntry.Labels },
+			result: func(e *common.RegistrationEntry) {}},
 		{name: "Update TTL, Bad Data, Mask True",
 			mask:   &common.RegistrationEntryMask{Ttl: true},
 			update: func(e *common.RegistrationEntry) { e.Ttl = badEntry.Ttl },
@@ -2434,6 +2639,7 @@ func (s *PluginSuite) TestDeleteRegistrationEntry() {
 		SpiffeId: "spiffe://example.org/foo",
 		ParentId: "spiffe://example.org/bar",
 		Ttl:      1,
+		Labels:   map[string]string{"team": "payments"},
 	})
 
 	s.createRegistrationEntry(&common.RegistrationEntry{
@@ -2462,6 +2668,11 @@ func (s *PluginSuite) TestDeleteRegistrationEntry() {
 	s.Require().NoError(err)
 	s.Require().Len(entriesResp.Entries, 1)
 
+	// Make sure the labels were deleted along with the entry
+	var labelCount int
+	s.Require().NoError(s.ds.db.Model(&EntryLabel{}).Count(&labelCount).Error)
+	s.Require().Zero(labelCount)
+
 	// Delete again must fails with Not Found
 	deletedEntry, err = s.ds.DeleteRegistrationEntry(ctx, entry1.EntryId)
 	s.Require().EqualError(err, "rpc error: code = NotFound desc = datastore-sql: record not found")
@@ -3017,6 +3228,82 @@ func (s *PluginSuite) TestListEntriesByFederatesWithSuperset() {
 	}
 }
 
+func (s *PluginSuite) TestListEntriesByLabels() {
+	newEntry := func(path string, labels map[string]string) *common.RegistrationEntry {
+		return &common.RegistrationEntry{
+			Selectors: []*common.Selector{{Type: "Type1", Value: "Value1"}},
+			SpiffeId:  "spiffe://example.org" + path,
+			ParentId:  "spiffe://example.org/parent",
+			Labels:    labels,
+		}
+	}
+	payments := newEntry("/payments", map[string]string{"team": "payments", "pipeline": "deploy"})
+	billing := newEntry("/billing", map[string]string{"team": "billing", "pipeline": "deploy"})
+	unlabeled := newEntry("/unlabeled", nil)
+
+	tests := []struct {
+		name         string
+		labels       map[string]string
+		pageSize     int32
+		expectedList []*common.RegistrationEntry
+	}{
+		{
+			name:         "single label",
+			labels:       map[string]string{"pipeline": "deploy"},
+			expectedList: []*common.RegistrationEntry{payments, billing},
+		},
+		{
+			name:         "all labels must match",
+			labels:       map[string]string{"team": "payments", "pipeline": "deploy"},
+			expectedList: []*common.RegistrationEntry{payments},
+		},
+		{
+			name:   "value mismatch",
+			labels: map[string]string{"team": "marketing"},
+		},
+		{
+			name:         "no labels",
+			expectedList: []*common.RegistrationEntry{payments, billing, unlabeled},
+		},
+		{
+			name:         "paginated",
+			labels:       map[string]string{"pipeline": "deploy"},
+			pageSize:     1,
+			expectedList: []*common.RegistrationEntry{payments, billing},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		s.T().Run(test.name, func(t *testing.T) {
+			ds := s.newPlugin()
+			for _, entry := range []*common.RegistrationEntry{payments, billing, unlabeled} {
+				registrationEntry, err := ds.CreateRegistrationEntry(ctx, entry)
+				require.NoError(t, err)
+				entry.EntryId = registrationEntry.EntryId
+			}
+
+			req := &datastore.ListRegistrationEntriesRequest{
+				ByLabels: test.labels,
+			}
+			if test.pageSize > 0 {
+				req.Pagination = &datastore.Pagination{PageSize: test.pageSize}
+			}
+
+			var entries []*common.RegistrationEntry
+			for {
+				resp, err := ds.ListRegistrationEntries(ctx, req)
+				require.NoError(t, err)
+				entries = append(entries, resp.Entries...)
+				if resp.Pagination == nil || resp.Pagination.Token == "" {
+					break
+				}
+				req.Pagination = resp.Pagination
+			}
+			spiretest.RequireProtoListEqual(t, test.expectedList, entries)
+		})
+	}
+}
+
 func (s *PluginSuite) TestRegistrationEntriesFederatesWithAgainstMissingBundle() {
 	// cannot federate with a trust bundle that does not exist
 	_, err := s.ds.CreateRegistrationEntry(ctx, makeFederatedRegistrationEntry())
@@ -3067,6 +3354,12 @@ func (s *PluginSuite) TestDeleteBundleDeleteRegistrationEntries() {
 	s.Require().NoError(err)
 	s.Require().Nil(registrationEntry)
 
+	// verify that the deletion was recorded as an event
+	resp, err := s.ds.ListRegistrationEntryEvents(context.Background(), &datastore.ListRegistrationEntryEventsRequest{})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(resp.Events)
+	s.Require().Equal(entry.EntryId, resp.Events[len(resp.Events)-1].EntryID)
+
 	// make sure the unrelated entry still exists
 	s.fetchRegistrationEntry(unrelated.EntryId)
 }
@@ -3083,6 +3376,12 @@ func (s *PluginSuite) TestDeleteBundleDissociateRegistrationEntries() {
 	// make sure the entry still exists, albeit without an associated bundle
 	entry = s.fetchRegistrationEntry(entry.EntryId)
 	s.Require().Empty(entry.FederatesWith)
+
+	// verify that the dissociation was recorded as an event
+	resp, err := s.ds.ListRegistrationEntryEvents(context.Background(), &datastore.ListRegistrationEntryEventsRequest{})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(resp.Events)
+	s.Require().Equal(entry.EntryId, resp.Events[len(resp.Events)-1].EntryID)
 }
 
 func (s *PluginSuite) TestCreateJoinToken() {
@@ -4023,6 +4322,15 @@ func (s *PluginSuite) TestMigration() {
 			s.Require().True(s.ds.db.Dialect().HasColumn("federated_trust_domains", "endpoint_spiffe_id"))
 			s.Require().True(s.ds.db.Dialect().HasColumn("federated_trust_domains", "implicit"))
 			s.Require().True(s.ds.db.Dialect().HasIndex("federated_trust_domains", "uix_federated_trust_domains_trust_domain"))
+		case 17:
+			s.Require().True(s.ds.db.Dialect().HasTable("registered_entries_events"))
+			s.Require().True(s.ds.db.Dialect().HasTable("attested_node_entries_events"))
+		case 18:
+			s.Require().True(s.ds.db.Dialect().HasColumn("registered_entries", "jwt_svid_ttl"))
+		case 19:
+			s.Require().True(s.ds.db.Dialect().HasTable("entry_labels"))
+			s.Require().True(s.ds.db.Dialect().HasIndex("entry_labels", "idx_entry_label"))
+			s.Require().True(s.ds.db.Dialect().HasIndex("entry_labels", "idx_entry_labels_name_value"))
 		default:
 			s.T().Fatalf("no migration test added for version %d", i)
 		}
@@ -0,0 +1,91 @@
+package datastore
+
+import (
+	"errors"
+
+	"github.com/spiffe/spire/proto/spire/common"
+)
+
+// ValidateRegistrationEntry validates a registration entry before it is
+// created. Datastore implementations run it when storing the entry, and
+// callers can run it beforehand to report the same errors.
+func ValidateRegistrationEntry(entry *common.RegistrationEntry) error {
+	if entry == nil {
+		return errors.New("invalid request: missing registered entry")
+	}
+
+	if len(entry.Selectors) == 0 {
+		return errors.New("invalid registration entry: missing selector list")
+	}
+
+	// In case of StoreSvid is set, all entries 'must' be the same type,
+	// it is done to avoid users to mix selectors from different platforms in
+	// entries with storable SVIDs
+	if entry.StoreSvid {
+		// Selectors must never be empty
+		tpe := entry.Selectors[0].Type
+		for _, t := range entry.Selectors {
+			if tpe != t.Type {
+				return errors.New("invalid registration entry: selector types must be the same when store SVID is enabled")
+			}
+		}
+	}
+
+	if len(entry.SpiffeId) == 0 {
+		return errors.New("invalid registration entry: missing SPIFFE ID")
+	}
+
+	if entry.Ttl < 0 {
+		return errors.New("invalid registration entry: TTL is not set")
+	}
+
+	if entry.JwtSvidTtl < 0 {
+		return errors.New("invalid registration entry: JWT-SVID TTL is negative")
+	}
+
+	return validateEntryLabels(entry.Labels)
+}
+
+// ValidateRegistrationEntryForUpdate validates the fields of a registration
+// entry that are set in the mask before the entry is updated. A nil mask
+// validates all the fields.
+func ValidateRegistrationEntryForUpdate(entry *common.RegistrationEntry, mask *common.RegistrationEntryMask) error {
+	if entry == nil {
+		return errors.New("invalid request: missing registered entry")
+	}
+
+	if (mask == nil || mask.Selectors) &&
+		len(entry.Selectors) == 0 {
+		return errors.New("invalid registration entry: missing selector list")
+	}
+
+	if (mask == nil || mask.SpiffeId) &&
+		entry.SpiffeId == "" {
+		return errors.New("invalid registration entry: missing SPIFFE ID")
+	}
+
+	if (mask == nil || mask.Ttl) &&
+		(entry.Ttl < 0) {
+		return errors.New("invalid registration entry: TTL is not set")
+	}
+
+	if (mask == nil || mask.JwtSvidTtl) &&
+		(entry.JwtSvidTtl < 0) {
+		return errors.New("invalid registration entry: JWT-SVID TTL is negative")
+	}
+
+	if mask == nil || mask.Labels {
+		return validateEntryLabels(entry.Labels)
+	}
+
+	return nil
+}
+
+func validateEntryLabels(labels map[string]string) error {
+	for name := range labels {
+		if name == "" {
+			return errors.New("invalid registration entry: label name cannot be empty")
+		}
+	}
+	return nil
+}
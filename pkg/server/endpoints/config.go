@@ -12,7 +12,6 @@ import (
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/spire/pkg/common/bundleutil"
 	"github.com/spiffe/spire/pkg/common/telemetry"
-	"github.com/spiffe/spire/pkg/server/api"
 	agentv1 "github.com/spiffe/spire/pkg/server/api/agent/v1"
 	bundlev1 "github.com/spiffe/spire/pkg/server/api/bundle/v1"
 	debugv1 "github.com/spiffe/spire/pkg/server/api/debug/v1"
@@ -111,7 +110,7 @@ func (c *Config) maybeMakeBundleEndpointServer() Server {
 	})
 }
 
-func (c *Config) makeAPIServers(entryFetcher api.AuthorizedEntryFetcher) APIServers {
+func (c *Config) makeAPIServers(entryFetcher *AuthorizedEntryFetcherWithFullCache) APIServers {
 	ds := c.Catalog.GetDataStore()
 	upstreamPublisher := UpstreamPublisher(c.Manager)
 	bundleRefresher := BundleRefresher(c.BundleManager)
@@ -137,9 +136,11 @@ func (c *Config) makeAPIServers(entryFetcher api.AuthorizedEntryFetcher) APIServ
 			Uptime:       c.Uptime,
 		}),
 		EntryServer: entryv1.New(entryv1.Config{
-			TrustDomain:  c.TrustDomain,
-			DataStore:    ds,
-			EntryFetcher: entryFetcher,
+			TrustDomain:          c.TrustDomain,
+			DataStore:            ds,
+			EntryFetcher:         entryFetcher,
+			AgentFetcher:         entryFetcher,
+			MatchingEntryFetcher: entryFetcher,
 		}),
 		HealthServer: healthv1.New(healthv1.Config{
 			TrustDomain: c.TrustDomain,
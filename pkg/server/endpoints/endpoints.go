@@ -103,10 +103,11 @@ func New(ctx context.Context, c Config) (*Endpoints, error) {
 		return nil, errors.New("policy engine not provided for new endpoint")
 	}
 
+	hydrator := entrycache.NewEventHydrator(c.Catalog.GetDataStore(), c.Log, c.Clock)
 	buildCacheFn := func(ctx context.Context) (_ entrycache.Cache, err error) {
 		call := telemetry.StartCall(c.Metrics, telemetry.Entry, telemetry.Cache, telemetry.Reload)
 		defer call.Done(&err)
-		return entrycache.BuildFromDataStore(ctx, c.Catalog.GetDataStore())
+		return hydrator.Hydrate(ctx)
 	}
 
 	if c.CacheReloadInterval == 0 {
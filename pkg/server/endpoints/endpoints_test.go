@@ -577,6 +577,7 @@ func testEntryAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentC
 			"BatchUpdateEntry":     true,
 			"BatchDeleteEntry":     true,
 			"GetAuthorizedEntries": false,
+			"GetMatchingEntries":   true,
 		})
 	})
 
@@ -589,6 +590,7 @@ func testEntryAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentC
 			"BatchUpdateEntry":     false,
 			"BatchDeleteEntry":     false,
 			"GetAuthorizedEntries": false,
+			"GetMatchingEntries":   false,
 		})
 	})
 
@@ -601,6 +603,7 @@ func testEntryAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentC
 			"BatchUpdateEntry":     false,
 			"BatchDeleteEntry":     false,
 			"GetAuthorizedEntries": true,
+			"GetMatchingEntries":   false,
 		})
 	})
 
@@ -613,6 +616,7 @@ func testEntryAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentC
 			"BatchUpdateEntry":     true,
 			"BatchDeleteEntry":     true,
 			"GetAuthorizedEntries": false,
+			"GetMatchingEntries":   true,
 		})
 	})
 
@@ -625,6 +629,7 @@ func testEntryAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentC
 			"BatchUpdateEntry":     false,
 			"BatchDeleteEntry":     false,
 			"GetAuthorizedEntries": false,
+			"GetMatchingEntries":   false,
 		})
 	})
 }
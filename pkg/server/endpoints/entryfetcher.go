@@ -13,7 +13,11 @@ import (
 	"github.com/spiffe/spire/pkg/server/cache/entrycache"
 )
 
-var _ api.AuthorizedEntryFetcher = (*AuthorizedEntryFetcherWithFullCache)(nil)
+var (
+	_ api.AuthorizedEntryFetcher = (*AuthorizedEntryFetcherWithFullCache)(nil)
+	_ api.MatchingEntryFetcher   = (*AuthorizedEntryFetcherWithFullCache)(nil)
+	_ api.AuthorizedAgentFetcher = (*AuthorizedEntryFetcherWithFullCache)(nil)
+)
 
 type entryCacheBuilderFn func(ctx context.Context) (entrycache.Cache, error)
 
@@ -49,6 +53,18 @@ func (a *AuthorizedEntryFetcherWithFullCache) FetchAuthorizedEntries(ctx context
 	return a.cache.GetAuthorizedEntries(agentID), nil
 }
 
+func (a *AuthorizedEntryFetcherWithFullCache) FetchMatchingEntries(ctx context.Context, agentID spiffeid.ID, selectors []*types.Selector) ([]*types.Entry, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.cache.GetMatchingEntries(agentID, selectors), nil
+}
+
+func (a *AuthorizedEntryFetcherWithFullCache) FetchAuthorizedAgents(ctx context.Context, entry *types.Entry) ([]spiffeid.ID, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.cache.GetAuthorizedAgents(entry), nil
+}
+
 // RunRebuildCacheTask starts a ticker which rebuilds the in-memory entry cache.
 func (a *AuthorizedEntryFetcherWithFullCache) RunRebuildCacheTask(ctx context.Context) error {
 	rebuild := func() {
@@ -32,6 +32,45 @@ func (sef *staticEntryCache) GetAuthorizedEntries(agentID spiffeid.ID) []*types.
 	return sef.entries[agentID]
 }
 
+func (sef *staticEntryCache) GetMatchingEntries(agentID spiffeid.ID, selectors []*types.Selector) []*types.Entry {
+	var entries []*types.Entry
+	for _, entry := range sef.entries[agentID] {
+		if isSelectorSubset(entry.Selectors, selectors) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func (sef *staticEntryCache) GetAuthorizedAgents(entry *types.Entry) []spiffeid.ID {
+	var agentIDs []spiffeid.ID
+	for agentID, entries := range sef.entries {
+		for _, e := range entries {
+			if e.Id == entry.Id {
+				agentIDs = append(agentIDs, agentID)
+				break
+			}
+		}
+	}
+	return agentIDs
+}
+
+func isSelectorSubset(sub, whole []*types.Selector) bool {
+	for _, s := range sub {
+		found := false
+		for _, w := range whole {
+			if s.Type == w.Type && s.Value == w.Value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 func newStaticEntryCache(entries map[spiffeid.ID][]*types.Entry) *staticEntryCache {
 	return &staticEntryCache{
 		entries: entries,
@@ -90,6 +129,34 @@ func TestFetchRegistrationEntries(t *testing.T) {
 	assert.Equal(t, expected, entries)
 }
 
+func TestFetchMatchingEntries(t *testing.T) {
+	ctx := context.Background()
+	log, _ := test.NewNullLogger()
+	clk := clock.NewMock(t)
+	agentID := trustDomain.NewID("/root")
+	expected := setupExpectedEntriesData(t, agentID)
+
+	buildCacheFn := func(ctx context.Context) (entrycache.Cache, error) {
+		entries := map[spiffeid.ID][]*types.Entry{
+			agentID: expected,
+		}
+
+		return newStaticEntryCache(entries), nil
+	}
+
+	ef, err := NewAuthorizedEntryFetcherWithFullCache(ctx, buildCacheFn, log, clk, defaultCacheReloadInterval)
+	require.NoError(t, err)
+	require.NotNil(t, ef)
+
+	entries, err := ef.FetchMatchingEntries(ctx, agentID, []*types.Selector{{Type: "foo", Value: "bar"}})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, entries)
+
+	entries, err = ef.FetchMatchingEntries(ctx, agentID, []*types.Selector{{Type: "foo", Value: "baz"}})
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
 func TestRunRebuildCacheTask(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	watchErr := make(chan error, 1)
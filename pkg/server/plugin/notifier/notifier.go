@@ -13,3 +13,11 @@ type Notifier interface {
 	NotifyAndAdviseBundleLoaded(ctx context.Context, bundle *common.Bundle) error
 	NotifyBundleUpdated(ctx context.Context, bundle *common.Bundle) error
 }
+
+// EntryNotifier is implemented by notifiers that want to learn about the
+// registration entries pruned by the server once they expired. Notifiers
+// loaded through the v1 plugin interface do not implement it, since the
+// interface has no event for it.
+type EntryNotifier interface {
+	NotifyEntriesPruned(ctx context.Context, entries []*common.RegistrationEntry) error
+}
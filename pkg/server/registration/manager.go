@@ -9,16 +9,41 @@ import (
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	telemetry_server "github.com/spiffe/spire/pkg/common/telemetry/server"
 	"github.com/spiffe/spire/pkg/server/datastore"
+	"github.com/spiffe/spire/pkg/server/plugin/notifier"
+	"github.com/spiffe/spire/proto/spire/common"
 )
 
 const (
 	_pruningCandence = 5 * time.Minute
+
+	// _eventRetention is how long registration entry and attested node
+	// events are kept. The in-memory entry cache must read them before.
+	_eventRetention = time.Hour
+
+	// _expiringEntriesPageSize is the page size used to list the entries
+	// that expire within the largest window
+	_expiringEntriesPageSize = 1000
 )
 
+// _expiringWindows are the windows the entries expiring within are
+// reported for, sorted by length
+var _expiringWindows = []time.Duration{
+	time.Hour,
+	24 * time.Hour,
+	7 * 24 * time.Hour,
+}
+
 // ManagerConfig is the config for the registration manager
 type ManagerConfig struct {
 	DataStore datastore.DataStore
 
+	// Notifiers are notified about the pruned entries when they implement
+	// notifier.EntryNotifier
+	Notifiers []notifier.Notifier
+
+	// AuditLogEnabled emits an audit log for each pruned entry
+	AuditLogEnabled bool
+
 	Log     logrus.FieldLogger
 	Metrics telemetry.Metrics
 
@@ -61,6 +86,12 @@ func (m *Manager) pruneEvery(ctx context.Context) error {
 			if err := m.prune(ctx); err != nil && ctx.Err() == nil {
 				m.log.WithError(err).Error("Failed pruning registration entries")
 			}
+			if err := m.reportExpiringEntries(ctx); err != nil && ctx.Err() == nil {
+				m.log.WithError(err).Error("Failed reporting expiring registration entries")
+			}
+			if err := m.pruneEvents(ctx); err != nil && ctx.Err() == nil {
+				m.log.WithError(err).Error("Failed pruning registration entry and attested node events")
+			}
 		case <-ctx.Done():
 			return nil
 		}
@@ -71,6 +102,90 @@ func (m *Manager) prune(ctx context.Context) (err error) {
 	counter := telemetry_server.StartRegistrationManagerPruneEntryCall(m.c.Metrics)
 	defer counter.Done(&err)
 
-	err = m.c.DataStore.PruneRegistrationEntries(ctx, m.c.Clock.Now())
-	return err
+	pruned, err := m.c.DataStore.PruneRegistrationEntries(ctx, m.c.Clock.Now())
+	if err != nil {
+		return err
+	}
+	if len(pruned) == 0 {
+		return nil
+	}
+
+	telemetry_server.IncrRegistrationManagerPrunedEntryCounter(m.c.Metrics, len(pruned))
+	if m.c.AuditLogEnabled {
+		for _, entry := range pruned {
+			m.c.Log.WithFields(logrus.Fields{
+				telemetry.Type:           "audit",
+				telemetry.Status:         "success",
+				telemetry.RegistrationID: entry.EntryId,
+				telemetry.SPIFFEID:       entry.SpiffeId,
+				telemetry.ParentID:       entry.ParentId,
+				telemetry.ExpiresAt:      entry.EntryExpiry,
+			}).Info("Registration entry pruned")
+		}
+	}
+	m.notifyEntriesPruned(ctx, pruned)
+	return nil
+}
+
+// notifyEntriesPruned notifies the pruned entries to the notifiers that
+// implement notifier.EntryNotifier. Failures are logged, since the entries
+// are already gone.
+func (m *Manager) notifyEntriesPruned(ctx context.Context, pruned []*common.RegistrationEntry) {
+	for _, n := range m.c.Notifiers {
+		entryNotifier, ok := n.(notifier.EntryNotifier)
+		if !ok {
+			continue
+		}
+		if err := entryNotifier.NotifyEntriesPruned(ctx, pruned); err != nil {
+			m.log.WithError(err).WithField(telemetry.Notifier, n.Name()).Warn("Notifier failed to handle pruned entries")
+		}
+	}
+}
+
+// reportExpiringEntries sets the number of entries that expire within each
+// of the windows. Entries that already expired but are not pruned yet are
+// counted in every window.
+func (m *Manager) reportExpiringEntries(ctx context.Context) error {
+	now := m.c.Clock.Now()
+	req := &datastore.ListRegistrationEntriesRequest{
+		ByExpiresBefore: now.Add(_expiringWindows[len(_expiringWindows)-1]),
+		Pagination: &datastore.Pagination{
+			PageSize: _expiringEntriesPageSize,
+		},
+	}
+
+	counts := make([]int, len(_expiringWindows))
+	for {
+		resp, err := m.c.DataStore.ListRegistrationEntries(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, entry := range resp.Entries {
+			for i, window := range _expiringWindows {
+				if entry.EntryExpiry < now.Add(window).Unix() {
+					counts[i]++
+				}
+			}
+		}
+		if resp.Pagination == nil || resp.Pagination.Token == "" {
+			break
+		}
+		req.Pagination = resp.Pagination
+	}
+
+	for i, window := range _expiringWindows {
+		telemetry_server.SetRegistrationManagerExpiringEntriesGauge(m.c.Metrics, window.String(), float32(counts[i]))
+	}
+	return nil
+}
+
+func (m *Manager) pruneEvents(ctx context.Context) (err error) {
+	counter := telemetry_server.StartRegistrationManagerPruneEventsCall(m.c.Metrics)
+	defer counter.Done(&err)
+
+	createdBefore := m.c.Clock.Now().Add(-_eventRetention)
+	if err := m.c.DataStore.PruneRegistrationEntryEvents(ctx, createdBefore); err != nil {
+		return err
+	}
+	return m.c.DataStore.PruneAttestedNodeEvents(ctx, createdBefore)
 }
@@ -2,12 +2,15 @@ package registration
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/pkg/server/datastore"
+	"github.com/spiffe/spire/pkg/server/plugin/notifier"
 	"github.com/spiffe/spire/proto/spire/common"
 	"github.com/spiffe/spire/test/clock"
 	"github.com/spiffe/spire/test/fakes/fakedatastore"
@@ -126,6 +129,127 @@ func (s *ManagerSuite) TestPruning() {
 	s.Empty(listResp.Entries)
 }
 
+func (s *ManagerSuite) TestPruningReportsPrunedEntries() {
+	entryNotifier := new(fakeEntryNotifier)
+	s.m = NewManager(ManagerConfig{
+		Clock:           s.clock,
+		DataStore:       s.ds,
+		Notifiers:       []notifier.Notifier{entryNotifier},
+		AuditLogEnabled: true,
+		Log:             s.log,
+		Metrics:         s.metrics,
+	})
+
+	expiry := s.clock.Now().Add(time.Minute)
+	entry, err := s.ds.CreateRegistrationEntry(context.Background(), &common.RegistrationEntry{
+		ParentId:    "spiffe://test.test/testA",
+		SpiffeId:    "spiffe://test.test/testA/test1",
+		Selectors:   []*common.Selector{{Type: "type", Value: "value"}},
+		EntryExpiry: expiry.Unix(),
+	})
+	s.Require().NoError(err)
+
+	// nothing is reported when no entry is pruned
+	s.NoError(s.m.prune(context.Background()))
+	s.Empty(entryNotifier.pruned)
+	s.Empty(s.logHook.AllEntries())
+
+	s.clock.Add(2 * time.Minute)
+	s.metrics.Reset()
+	s.NoError(s.m.prune(context.Background()))
+	s.RequireProtoListEqual([]*common.RegistrationEntry{entry}, entryNotifier.pruned)
+	s.Contains(s.metrics.AllMetrics(), fakemetrics.MetricItem{
+		Type: fakemetrics.IncrCounterType,
+		Key:  []string{telemetry.RegistrationEntry, telemetry.Manager, telemetry.Pruned},
+		Val:  1,
+	})
+	spiretest.AssertLogs(s.T(), s.logHook.AllEntries(), []spiretest.LogEntry{
+		{
+			Level:   logrus.InfoLevel,
+			Message: "Registration entry pruned",
+			Data: logrus.Fields{
+				telemetry.Type:           "audit",
+				telemetry.Status:         "success",
+				telemetry.RegistrationID: entry.EntryId,
+				telemetry.SPIFFEID:       "spiffe://test.test/testA/test1",
+				telemetry.ParentID:       "spiffe://test.test/testA",
+				telemetry.ExpiresAt:      fmt.Sprint(expiry.Unix()),
+			},
+		},
+	})
+}
+
+func (s *ManagerSuite) TestReportExpiringEntries() {
+	s.m = NewManager(ManagerConfig{
+		Clock:     s.clock,
+		DataStore: s.ds,
+		Log:       s.log,
+		Metrics:   s.metrics,
+	})
+
+	now := s.clock.Now()
+	for i, expiry := range []time.Time{
+		{},
+		now.Add(-time.Minute),
+		now.Add(30 * time.Minute),
+		now.Add(12 * time.Hour),
+		now.Add(48 * time.Hour),
+		now.Add(30 * 24 * time.Hour),
+	} {
+		entry := &common.RegistrationEntry{
+			ParentId:  "spiffe://test.test/testA",
+			SpiffeId:  fmt.Sprintf("spiffe://test.test/testA/test%d", i),
+			Selectors: []*common.Selector{{Type: "type", Value: "value"}},
+		}
+		if !expiry.IsZero() {
+			entry.EntryExpiry = expiry.Unix()
+		}
+		_, err := s.ds.CreateRegistrationEntry(context.Background(), entry)
+		s.Require().NoError(err)
+	}
+
+	s.NoError(s.m.reportExpiringEntries(context.Background()))
+	s.Equal([]fakemetrics.MetricItem{
+		expiringEntriesGauge("1h0m0s", 2),
+		expiringEntriesGauge("24h0m0s", 3),
+		expiringEntriesGauge("168h0m0s", 4),
+	}, s.metrics.AllMetrics())
+}
+
+func (s *ManagerSuite) TestPruningEvents() {
+	done := s.setupAndRunManager()
+	defer done()
+
+	_, err := s.ds.CreateRegistrationEntry(context.Background(), &common.RegistrationEntry{
+		ParentId:  "spiffe://test.test/testA",
+		SpiffeId:  "spiffe://test.test/testA/test1",
+		Selectors: []*common.Selector{{Type: "type", Value: "value"}},
+	})
+	s.Require().NoError(err)
+	err = s.ds.SetNodeSelectors(context.Background(), "spiffe://test.test/testA", []*common.Selector{{Type: "type", Value: "value"}})
+	s.Require().NoError(err)
+
+	// events are kept during the retention period
+	s.clock.Add(_eventRetention - time.Minute)
+	s.NoError(s.m.pruneEvents(context.Background()))
+	entryEvents, err := s.ds.ListRegistrationEntryEvents(context.Background(), &datastore.ListRegistrationEntryEventsRequest{})
+	s.Require().NoError(err)
+	s.Len(entryEvents.Events, 1)
+	nodeEvents, err := s.ds.ListAttestedNodeEvents(context.Background(), &datastore.ListAttestedNodeEventsRequest{})
+	s.Require().NoError(err)
+	s.Len(nodeEvents.Events, 1)
+
+	// events are pruned after the retention period
+	s.clock.Add(2 * time.Minute)
+	s.NoError(s.m.pruneEvents(context.Background()))
+	entryEvents, err = s.ds.ListRegistrationEntryEvents(context.Background(), &datastore.ListRegistrationEntryEventsRequest{})
+	s.Require().NoError(err)
+	s.Empty(entryEvents.Events)
+	nodeEvents, err = s.ds.ListAttestedNodeEvents(context.Background(), &datastore.ListAttestedNodeEventsRequest{})
+	s.Require().NoError(err)
+	s.Empty(nodeEvents.Events)
+}
+
 func (s *ManagerSuite) setupAndRunManager() func() {
 	s.m = NewManager(ManagerConfig{
 		Clock:     s.clock,
@@ -144,3 +268,27 @@ func (s *ManagerSuite) setupAndRunManager() func() {
 		s.Require().NoError(<-errCh)
 	}
 }
+
+func expiringEntriesGauge(window string, val float32) fakemetrics.MetricItem {
+	return fakemetrics.MetricItem{
+		Type:   fakemetrics.SetGaugeWithLabelsType,
+		Key:    []string{telemetry.RegistrationEntry, telemetry.Manager, telemetry.ExpiringEntries},
+		Val:    val,
+		Labels: []telemetry.Label{{Name: telemetry.Window, Value: window}},
+	}
+}
+
+type fakeEntryNotifier struct {
+	notifier.Notifier
+
+	pruned []*common.RegistrationEntry
+}
+
+func (n *fakeEntryNotifier) Name() string {
+	return "fake"
+}
+
+func (n *fakeEntryNotifier) NotifyEntriesPruned(ctx context.Context, entries []*common.RegistrationEntry) error {
+	n.pruned = append(n.pruned, entries...)
+	return nil
+}
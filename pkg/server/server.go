@@ -282,9 +282,11 @@ func (s *Server) newCAManager(ctx context.Context, cat catalog.Catalog, metrics
 
 func (s *Server) newRegistrationManager(cat catalog.Catalog, metrics telemetry.Metrics) *registration.Manager {
 	registrationManager := registration.NewManager(registration.ManagerConfig{
-		DataStore: cat.GetDataStore(),
-		Log:       s.config.Log.WithField(telemetry.SubsystemName, telemetry.RegistrationManager),
-		Metrics:   metrics,
+		DataStore:       cat.GetDataStore(),
+		Notifiers:       cat.GetNotifiers(),
+		AuditLogEnabled: s.config.AuditLogEnabled,
+		Log:             s.config.Log.WithField(telemetry.SubsystemName, telemetry.RegistrationManager),
+		Metrics:         metrics,
 	})
 	return registrationManager
 }
@@ -159,6 +159,20 @@ func (s *DataStore) DeleteAttestedNode(ctx context.Context, spiffeID string) (*c
 	return s.ds.DeleteAttestedNode(ctx, spiffeID)
 }
 
+func (s *DataStore) ListAttestedNodeEvents(ctx context.Context, req *datastore.ListAttestedNodeEventsRequest) (*datastore.ListAttestedNodeEventsResponse, error) {
+	if err := s.getNextError(); err != nil {
+		return nil, err
+	}
+	return s.ds.ListAttestedNodeEvents(ctx, req)
+}
+
+func (s *DataStore) PruneAttestedNodeEvents(ctx context.Context, createdBefore time.Time) error {
+	if err := s.getNextError(); err != nil {
+		return err
+	}
+	return s.ds.PruneAttestedNodeEvents(ctx, createdBefore)
+}
+
 func (s *DataStore) SetNodeSelectors(ctx context.Context, spiffeID string, selectors []*common.Selector) error {
 	if err := s.getNextError(); err != nil {
 		return err
@@ -239,13 +253,27 @@ func (s *DataStore) DeleteRegistrationEntry(ctx context.Context, entryID string)
 	return s.ds.DeleteRegistrationEntry(ctx, entryID)
 }
 
-func (s *DataStore) PruneRegistrationEntries(ctx context.Context, expiresBefore time.Time) error {
+func (s *DataStore) PruneRegistrationEntries(ctx context.Context, expiresBefore time.Time) ([]*common.RegistrationEntry, error) {
 	if err := s.getNextError(); err != nil {
-		return err
+		return nil, err
 	}
 	return s.ds.PruneRegistrationEntries(ctx, expiresBefore)
 }
 
+func (s *DataStore) ListRegistrationEntryEvents(ctx context.Context, req *datastore.ListRegistrationEntryEventsRequest) (*datastore.ListRegistrationEntryEventsResponse, error) {
+	if err := s.getNextError(); err != nil {
+		return nil, err
+	}
+	return s.ds.ListRegistrationEntryEvents(ctx, req)
+}
+
+func (s *DataStore) PruneRegistrationEntryEvents(ctx context.Context, createdBefore time.Time) error {
+	if err := s.getNextError(); err != nil {
+		return err
+	}
+	return s.ds.PruneRegistrationEntryEvents(ctx, createdBefore)
+}
+
 func (s *DataStore) CreateJoinToken(ctx context.Context, token *datastore.JoinToken) error {
 	if err := s.getNextError(); err != nil {
 		return err
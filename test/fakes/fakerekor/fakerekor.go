@@ -0,0 +1,289 @@
+package fakerekor
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/trillian/merkle/rfc6962"
+	"github.com/sigstore/cosign/pkg/oci"
+	"github.com/sigstore/rekor/pkg/generated/models"
+	"github.com/sigstore/rekor/pkg/util"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/options"
+)
+
+const (
+	entriesPath = "/api/v1/log/entries/"
+)
+
+// Rekor is a fake Rekor transparency log backed by an in-memory RFC 6962
+// merkle tree. It serves signed tree heads, inclusion proofs and consistency
+// proofs like the real Rekor API.
+type Rekor struct {
+	t            testing.TB
+	server       *httptest.Server
+	signer       signature.Signer
+	publicKeyPEM []byte
+	logID        string
+
+	mu      sync.Mutex
+	leaves  [][]byte
+	entries map[string]models.LogEntryAnon
+}
+
+// New starts a new fake Rekor server, which is closed when the test ends.
+func New(t testing.TB) *Rekor {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate rekor key: %v", err)
+	}
+	signer, err := signature.LoadECDSASignerVerifier(key, crypto.SHA256)
+	if err != nil {
+		t.Fatalf("unable to load rekor signer: %v", err)
+	}
+	publicKeyPEM, err := cryptoutils.MarshalPublicKeyToPEM(key.Public())
+	if err != nil {
+		t.Fatalf("unable to marshal rekor public key: %v", err)
+	}
+	publicKeyDER, err := cryptoutils.MarshalPublicKeyToDER(key.Public())
+	if err != nil {
+		t.Fatalf("unable to marshal rekor public key: %v", err)
+	}
+	logID := sha256.Sum256(publicKeyDER)
+
+	r := &Rekor{
+		t:            t,
+		signer:       signer,
+		publicKeyPEM: publicKeyPEM,
+		logID:        hex.EncodeToString(logID[:]),
+		entries:      make(map[string]models.LogEntryAnon),
+	}
+	r.server = httptest.NewServer(http.HandlerFunc(r.serveHTTP))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+// URL returns the URL of the fake Rekor server
+func (r *Rekor) URL() string {
+	return r.server.URL
+}
+
+// PublicKeyPEM returns the PEM encoded public key that signs the tree heads
+func (r *Rekor) PublicKeyPEM() []byte {
+	return r.publicKeyPEM
+}
+
+// AddEntry appends an entry with the given body to the log and returns the
+// bundle payload that cosign would attach to a signature for it.
+func (r *Rekor) AddEntry(body []byte) oci.BundlePayload {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	leafHash := rfc6962.DefaultHasher.HashLeaf(body)
+	logIndex := int64(len(r.leaves))
+	integratedTime := int64(1600000000 + logIndex)
+	r.leaves = append(r.leaves, leafHash)
+
+	encodedBody := base64.StdEncoding.EncodeToString(body)
+	r.entries[hex.EncodeToString(leafHash)] = models.LogEntryAnon{
+		Body:           encodedBody,
+		IntegratedTime: &integratedTime,
+		LogID:          &r.logID,
+		LogIndex:       &logIndex,
+	}
+	return oci.BundlePayload{
+		Body:           encodedBody,
+		IntegratedTime: integratedTime,
+		LogIndex:       logIndex,
+		LogID:          r.logID,
+	}
+}
+
+// Rewrite replaces the history of the log with a different one of the same
+// size, simulating a log presenting a split view to its clients. Entries
+// added before the rewrite are no longer served.
+func (r *Rekor) Rewrite() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.leaves {
+		r.leaves[i] = rfc6962.DefaultHasher.HashLeaf([]byte("rewritten-" + strconv.Itoa(i)))
+	}
+	r.entries = make(map[string]models.LogEntryAnon)
+}
+
+func (r *Rekor) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch {
+	case req.Method != http.MethodGet:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	case req.URL.Path == "/api/v1/log/publicKey":
+		w.Header().Set("Content-Type", "application/x-pem-file")
+		_, _ = w.Write(r.publicKeyPEM)
+	case req.URL.Path == "/api/v1/log":
+		r.serveLogInfo(w)
+	case req.URL.Path == "/api/v1/log/proof":
+		r.serveConsistencyProof(w, req)
+	case strings.HasPrefix(req.URL.Path, entriesPath):
+		r.serveEntry(w, req, strings.TrimPrefix(req.URL.Path, entriesPath))
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+func (r *Rekor) serveLogInfo(w http.ResponseWriter) {
+	treeSize := int64(len(r.leaves))
+	rootHash := treeHash(r.leaves)
+
+	checkpoint, err := util.CreateSignedCheckpoint(util.Checkpoint{
+		Ecosystem: "Rekor",
+		Size:      uint64(treeSize),
+		Hash:      rootHash,
+	})
+	if err != nil {
+		r.t.Errorf("unable to create checkpoint: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	checkpoint.SetTimestamp(uint64(1600000000 + treeSize))
+	if _, err := checkpoint.Sign("rekor.fake", r.signer, options.WithCryptoSignerOpts(crypto.SHA256)); err != nil {
+		r.t.Errorf("unable to sign checkpoint: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	signedTreeHead := checkpoint.SignedNote.String()
+	rootHashHex := hex.EncodeToString(rootHash)
+
+	r.writeJSON(w, models.LogInfo{
+		RootHash:       &rootHashHex,
+		SignedTreeHead: &signedTreeHead,
+		TreeSize:       &treeSize,
+	})
+}
+
+func (r *Rekor) serveConsistencyProof(w http.ResponseWriter, req *http.Request) {
+	firstSize := int64(1)
+	if value := req.URL.Query().Get("firstSize"); value != "" {
+		size, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid firstSize", http.StatusBadRequest)
+			return
+		}
+		firstSize = size
+	}
+	lastSize, err := strconv.ParseInt(req.URL.Query().Get("lastSize"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid lastSize", http.StatusBadRequest)
+		return
+	}
+	if firstSize < 1 || firstSize > lastSize || lastSize > int64(len(r.leaves)) {
+		http.Error(w, "invalid tree sizes", http.StatusBadRequest)
+		return
+	}
+
+	leaves := r.leaves[:lastSize]
+	rootHash := hex.EncodeToString(treeHash(leaves))
+	r.writeJSON(w, models.ConsistencyProof{
+		Hashes:   encodeHashes(consistencyProof(int(firstSize), leaves, true)),
+		RootHash: &rootHash,
+	})
+}
+
+func (r *Rekor) serveEntry(w http.ResponseWriter, req *http.Request, uuid string) {
+	entry, ok := r.entries[uuid]
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+
+	treeSize := int64(len(r.leaves))
+	rootHash := hex.EncodeToString(treeHash(r.leaves))
+	entry.Verification = &models.LogEntryAnonVerification{
+		InclusionProof: &models.InclusionProof{
+			Hashes:   encodeHashes(inclusionProof(int(*entry.LogIndex), r.leaves)),
+			LogIndex: entry.LogIndex,
+			RootHash: &rootHash,
+			TreeSize: &treeSize,
+		},
+	}
+	r.writeJSON(w, models.LogEntry{uuid: entry})
+}
+
+func (r *Rekor) writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		r.t.Errorf("unable to encode response: %v", err)
+	}
+}
+
+func encodeHashes(hashes [][]byte) []string {
+	encoded := make([]string, 0, len(hashes))
+	for _, hash := range hashes {
+		encoded = append(encoded, hex.EncodeToString(hash))
+	}
+	return encoded
+}
+
+// splitPoint returns the largest power of two smaller than n
+func splitPoint(n int) int {
+	k := 1
+	for k<<1 < n {
+		k <<= 1
+	}
+	return k
+}
+
+// treeHash computes the merkle tree hash of the leaf hashes (RFC 6962, 2.1)
+func treeHash(leaves [][]byte) []byte {
+	switch len(leaves) {
+	case 0:
+		return rfc6962.DefaultHasher.EmptyRoot()
+	case 1:
+		return leaves[0]
+	}
+	k := splitPoint(len(leaves))
+	return rfc6962.DefaultHasher.HashChildren(treeHash(leaves[:k]), treeHash(leaves[k:]))
+}
+
+// inclusionProof computes the audit path of leaf m (RFC 6962, 2.1.1)
+func inclusionProof(m int, leaves [][]byte) [][]byte {
+	if len(leaves) <= 1 {
+		return nil
+	}
+	k := splitPoint(len(leaves))
+	if m < k {
+		return append(inclusionProof(m, leaves[:k]), treeHash(leaves[k:]))
+	}
+	return append(inclusionProof(m-k, leaves[k:]), treeHash(leaves[:k]))
+}
+
+// consistencyProof computes the consistency proof between the tree of the
+// first m leaves and the tree of all the leaves (RFC 6962, 2.1.2)
+func consistencyProof(m int, leaves [][]byte, complete bool) [][]byte {
+	if m == len(leaves) {
+		if complete {
+			return nil
+		}
+		return [][]byte{treeHash(leaves)}
+	}
+	k := splitPoint(len(leaves))
+	if m <= k {
+		return append(consistencyProof(m, leaves[:k], complete), treeHash(leaves[k:]))
+	}
+	return append(consistencyProof(m-k, leaves[k:], false), treeHash(leaves[:k]))
+}
@@ -0,0 +1,9 @@
+module github.com/spiffe/spire-api-sdk
+
+go 1.12
+
+require (
+	github.com/golang/protobuf v1.5.2
+	google.golang.org/grpc v1.40.0
+	google.golang.org/protobuf v1.27.1
+)
@@ -0,0 +1,464 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.14.0
+// source: spire/api/types/federationrelationship.proto
+
+package types
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FederationRelationship struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. The trust domain name (e.g., "example.org") to federate with.
+	TrustDomain string `protobuf:"bytes,1,opt,name=trust_domain,json=trustDomain,proto3" json:"trust_domain,omitempty"`
+	// Required. URL of the SPIFFE bundle endpoint that provides the trust
+	// bundle to federate with. Must use the HTTPS protocol.
+	BundleEndpointUrl string `protobuf:"bytes,2,opt,name=bundle_endpoint_url,json=bundleEndpointUrl,proto3" json:"bundle_endpoint_url,omitempty"`
+	// Required. The endpoint profile type.
+	//
+	// Types that are assignable to BundleEndpointProfile:
+	//	*FederationRelationship_HttpsWeb
+	//	*FederationRelationship_HttpsSpiffe
+	BundleEndpointProfile isFederationRelationship_BundleEndpointProfile `protobuf_oneof:"bundle_endpoint_profile"`
+	// Optional. The bundle for the trust domain. This field can be used to
+	// create or replace the referenced trust domains' bundle when the
+	// relationship is created or updated.  When the relationship is retrieved,
+	// it will be set to the referenced trust domain's latest bundle (if
+	// available). Please note that the `https_spiffe` profile requires an
+	// existing trust domain bundle in order to function correctly. The
+	// required bundle must match the trust domain specified in the bundle
+	// endpoint SPIFFE ID. If the bundle endpoint SPIFFE ID resides in the same
+	// trust domain that you're trying to federate with, you may optionally
+	// specify that trust domain bundle here. If the bundle endpoint SPIFFE ID
+	// _does not_ reside in the same trust domain that you're federating with,
+	// please ensure that the trust domain bundle for that trust domain has
+	// been configured separately (e.g. configured via another federation
+	// relationship or manually set via the Bundle API).
+	TrustDomainBundle *Bundle `protobuf:"bytes,5,opt,name=trust_domain_bundle,json=trustDomainBundle,proto3" json:"trust_domain_bundle,omitempty"`
+}
+
+func (x *FederationRelationship) Reset() {
+	*x = FederationRelationship{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_api_types_federationrelationship_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederationRelationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederationRelationship) ProtoMessage() {}
+
+func (x *FederationRelationship) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_types_federationrelationship_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederationRelationship.ProtoReflect.Descriptor instead.
+func (*FederationRelationship) Descriptor() ([]byte, []int) {
+	return file_spire_api_types_federationrelationship_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FederationRelationship) GetTrustDomain() string {
+	if x != nil {
+		return x.TrustDomain
+	}
+	return ""
+}
+
+func (x *FederationRelationship) GetBundleEndpointUrl() string {
+	if x != nil {
+		return x.BundleEndpointUrl
+	}
+	return ""
+}
+
+func (m *FederationRelationship) GetBundleEndpointProfile() isFederationRelationship_BundleEndpointProfile {
+	if m != nil {
+		return m.BundleEndpointProfile
+	}
+	return nil
+}
+
+func (x *FederationRelationship) GetHttpsWeb() *HTTPSWebProfile {
+	if x, ok := x.GetBundleEndpointProfile().(*FederationRelationship_HttpsWeb); ok {
+		return x.HttpsWeb
+	}
+	return nil
+}
+
+func (x *FederationRelationship) GetHttpsSpiffe() *HTTPSSPIFFEProfile {
+	if x, ok := x.GetBundleEndpointProfile().(*FederationRelationship_HttpsSpiffe); ok {
+		return x.HttpsSpiffe
+	}
+	return nil
+}
+
+func (x *FederationRelationship) GetTrustDomainBundle() *Bundle {
+	if x != nil {
+		return x.TrustDomainBundle
+	}
+	return nil
+}
+
+type isFederationRelationship_BundleEndpointProfile interface {
+	isFederationRelationship_BundleEndpointProfile()
+}
+
+type FederationRelationship_HttpsWeb struct {
+	// Use Web PKI endpoint profile.
+	HttpsWeb *HTTPSWebProfile `protobuf:"bytes,3,opt,name=https_web,json=httpsWeb,proto3,oneof"`
+}
+
+type FederationRelationship_HttpsSpiffe struct {
+	// Use SPIFFE Authentication endpoint profile.
+	HttpsSpiffe *HTTPSSPIFFEProfile `protobuf:"bytes,4,opt,name=https_spiffe,json=httpsSpiffe,proto3,oneof"`
+}
+
+func (*FederationRelationship_HttpsWeb) isFederationRelationship_BundleEndpointProfile() {}
+
+func (*FederationRelationship_HttpsSpiffe) isFederationRelationship_BundleEndpointProfile() {}
+
+type HTTPSSPIFFEProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. Specifies the expected SPIFFE ID of the SPIFFE bundle endpoint
+	// server.
+	EndpointSpiffeId string `protobuf:"bytes,1,opt,name=endpoint_spiffe_id,json=endpointSpiffeId,proto3" json:"endpoint_spiffe_id,omitempty"`
+}
+
+func (x *HTTPSSPIFFEProfile) Reset() {
+	*x = HTTPSSPIFFEProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_api_types_federationrelationship_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HTTPSSPIFFEProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPSSPIFFEProfile) ProtoMessage() {}
+
+func (x *HTTPSSPIFFEProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_types_federationrelationship_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPSSPIFFEProfile.ProtoReflect.Descriptor instead.
+func (*HTTPSSPIFFEProfile) Descriptor() ([]byte, []int) {
+	return file_spire_api_types_federationrelationship_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HTTPSSPIFFEProfile) GetEndpointSpiffeId() string {
+	if x != nil {
+		return x.EndpointSpiffeId
+	}
+	return ""
+}
+
+type HTTPSWebProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HTTPSWebProfile) Reset() {
+	*x = HTTPSWebProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_api_types_federationrelationship_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HTTPSWebProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPSWebProfile) ProtoMessage() {}
+
+func (x *HTTPSWebProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_types_federationrelationship_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPSWebProfile.ProtoReflect.Descriptor instead.
+func (*HTTPSWebProfile) Descriptor() ([]byte, []int) {
+	return file_spire_api_types_federationrelationship_proto_rawDescGZIP(), []int{2}
+}
+
+type FederationRelationshipMask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// bundle_endpoint_url field mask.
+	BundleEndpointUrl bool `protobuf:"varint,1,opt,name=bundle_endpoint_url,json=bundleEndpointUrl,proto3" json:"bundle_endpoint_url,omitempty"`
+	// bundle_endpoint_profile field mask.
+	BundleEndpointProfile bool `protobuf:"varint,2,opt,name=bundle_endpoint_profile,json=bundleEndpointProfile,proto3" json:"bundle_endpoint_profile,omitempty"`
+	// trust_domain_bundle field mask.
+	TrustDomainBundle bool `protobuf:"varint,3,opt,name=trust_domain_bundle,json=trustDomainBundle,proto3" json:"trust_domain_bundle,omitempty"`
+}
+
+func (x *FederationRelationshipMask) Reset() {
+	*x = FederationRelationshipMask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_api_types_federationrelationship_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederationRelationshipMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederationRelationshipMask) ProtoMessage() {}
+
+func (x *FederationRelationshipMask) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_types_federationrelationship_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederationRelationshipMask.ProtoReflect.Descriptor instead.
+func (*FederationRelationshipMask) Descriptor() ([]byte, []int) {
+	return file_spire_api_types_federationrelationship_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FederationRelationshipMask) GetBundleEndpointUrl() bool {
+	if x != nil {
+		return x.BundleEndpointUrl
+	}
+	return false
+}
+
+func (x *FederationRelationshipMask) GetBundleEndpointProfile() bool {
+	if x != nil {
+		return x.BundleEndpointProfile
+	}
+	return false
+}
+
+func (x *FederationRelationshipMask) GetTrustDomainBundle() bool {
+	if x != nil {
+		return x.TrustDomainBundle
+	}
+	return false
+}
+
+var File_spire_api_types_federationrelationship_proto protoreflect.FileDescriptor
+
+var file_spire_api_types_federationrelationship_proto_rawDesc = []byte{
+	0x0a, 0x2c, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2f, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
+	0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a,
+	0x1c, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xda, 0x02,
+	0x0a, 0x16, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x62,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x3f, 0x0a, 0x09, 0x68,
+	0x74, 0x74, 0x70, 0x73, 0x5f, 0x77, 0x65, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x48, 0x54, 0x54, 0x50, 0x53, 0x57, 0x65, 0x62, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x48, 0x00, 0x52, 0x08, 0x68, 0x74, 0x74, 0x70, 0x73, 0x57, 0x65, 0x62, 0x12, 0x48, 0x0a, 0x0c,
+	0x68, 0x74, 0x74, 0x70, 0x73, 0x5f, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x53, 0x53, 0x50, 0x49, 0x46, 0x46, 0x45,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x73,
+	0x53, 0x70, 0x69, 0x66, 0x66, 0x65, 0x12, 0x47, 0x0a, 0x13, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x11, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x42,
+	0x19, 0x0a, 0x17, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x42, 0x0a, 0x12, 0x48, 0x54,
+	0x54, 0x50, 0x53, 0x53, 0x50, 0x49, 0x46, 0x46, 0x45, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x2c, 0x0a, 0x12, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x73, 0x70, 0x69,
+	0x66, 0x66, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x70, 0x69, 0x66, 0x66, 0x65, 0x49, 0x64, 0x22, 0x11,
+	0x0a, 0x0f, 0x48, 0x54, 0x54, 0x50, 0x53, 0x57, 0x65, 0x62, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x22, 0xb4, 0x01, 0x0a, 0x1a, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x4d, 0x61, 0x73, 0x6b,
+	0x12, 0x2e, 0x0a, 0x13, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x62,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x55, 0x72, 0x6c,
+	0x12, 0x36, 0x0a, 0x17, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x15, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x74, 0x72, 0x75, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x2f, 0x73, 0x70,
+	0x69, 0x72, 0x65, 0x2d, 0x61, 0x70, 0x69, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_spire_api_types_federationrelationship_proto_rawDescOnce sync.Once
+	file_spire_api_types_federationrelationship_proto_rawDescData = file_spire_api_types_federationrelationship_proto_rawDesc
+)
+
+func file_spire_api_types_federationrelationship_proto_rawDescGZIP() []byte {
+	file_spire_api_types_federationrelationship_proto_rawDescOnce.Do(func() {
+		file_spire_api_types_federationrelationship_proto_rawDescData = protoimpl.X.CompressGZIP(file_spire_api_types_federationrelationship_proto_rawDescData)
+	})
+	return file_spire_api_types_federationrelationship_proto_rawDescData
+}
+
+var file_spire_api_types_federationrelationship_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_spire_api_types_federationrelationship_proto_goTypes = []interface{}{
+	(*FederationRelationship)(nil),     // 0: spire.api.types.FederationRelationship
+	(*HTTPSSPIFFEProfile)(nil),         // 1: spire.api.types.HTTPSSPIFFEProfile
+	(*HTTPSWebProfile)(nil),            // 2: spire.api.types.HTTPSWebProfile
+	(*FederationRelationshipMask)(nil), // 3: spire.api.types.FederationRelationshipMask
+	(*Bundle)(nil),                     // 4: spire.api.types.Bundle
+}
+var file_spire_api_types_federationrelationship_proto_depIdxs = []int32{
+	2, // 0: spire.api.types.FederationRelationship.https_web:type_name -> spire.api.types.HTTPSWebProfile
+	1, // 1: spire.api.types.FederationRelationship.https_spiffe:type_name -> spire.api.types.HTTPSSPIFFEProfile
+	4, // 2: spire.api.types.FederationRelationship.trust_domain_bundle:type_name -> spire.api.types.Bundle
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_spire_api_types_federationrelationship_proto_init() }
+func file_spire_api_types_federationrelationship_proto_init() {
+	if File_spire_api_types_federationrelationship_proto != nil {
+		return
+	}
+	file_spire_api_types_bundle_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_spire_api_types_federationrelationship_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FederationRelationship); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_api_types_federationrelationship_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPSSPIFFEProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_api_types_federationrelationship_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPSWebProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_api_types_federationrelationship_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FederationRelationshipMask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_spire_api_types_federationrelationship_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*FederationRelationship_HttpsWeb)(nil),
+		(*FederationRelationship_HttpsSpiffe)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_spire_api_types_federationrelationship_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_spire_api_types_federationrelationship_proto_goTypes,
+		DependencyIndexes: file_spire_api_types_federationrelationship_proto_depIdxs,
+		MessageInfos:      file_spire_api_types_federationrelationship_proto_msgTypes,
+	}.Build()
+	File_spire_api_types_federationrelationship_proto = out.File
+	file_spire_api_types_federationrelationship_proto_rawDesc = nil
+	file_spire_api_types_federationrelationship_proto_goTypes = nil
+	file_spire_api_types_federationrelationship_proto_depIdxs = nil
+}
@@ -0,0 +1,162 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.14.0
+// source: spire/api/types/spiffeid.proto
+
+package types
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A SPIFFE ID, consisting of the trust domain name and a path portions of
+// the SPIFFE ID URI.
+type SPIFFEID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Trust domain portion the SPIFFE ID (e.g. "example.org")
+	TrustDomain string `protobuf:"bytes,1,opt,name=trust_domain,json=trustDomain,proto3" json:"trust_domain,omitempty"`
+	// The path component of the SPIFFE ID (e.g. "/foo/bar/baz"). The path
+	// SHOULD have a leading slash. Consumers MUST normalize the path before
+	// making any sort of comparison between IDs.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *SPIFFEID) Reset() {
+	*x = SPIFFEID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_api_types_spiffeid_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SPIFFEID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SPIFFEID) ProtoMessage() {}
+
+func (x *SPIFFEID) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_types_spiffeid_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SPIFFEID.ProtoReflect.Descriptor instead.
+func (*SPIFFEID) Descriptor() ([]byte, []int) {
+	return file_spire_api_types_spiffeid_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SPIFFEID) GetTrustDomain() string {
+	if x != nil {
+		return x.TrustDomain
+	}
+	return ""
+}
+
+func (x *SPIFFEID) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+var File_spire_api_types_spiffeid_proto protoreflect.FileDescriptor
+
+var file_spire_api_types_spiffeid_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2f, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x69, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0f, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x22, 0x41, 0x0a, 0x08, 0x53, 0x50, 0x49, 0x46, 0x46, 0x45, 0x49, 0x44, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x75, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x2f, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2d,
+	0x61, 0x70, 0x69, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70,
+	0x69, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_spire_api_types_spiffeid_proto_rawDescOnce sync.Once
+	file_spire_api_types_spiffeid_proto_rawDescData = file_spire_api_types_spiffeid_proto_rawDesc
+)
+
+func file_spire_api_types_spiffeid_proto_rawDescGZIP() []byte {
+	file_spire_api_types_spiffeid_proto_rawDescOnce.Do(func() {
+		file_spire_api_types_spiffeid_proto_rawDescData = protoimpl.X.CompressGZIP(file_spire_api_types_spiffeid_proto_rawDescData)
+	})
+	return file_spire_api_types_spiffeid_proto_rawDescData
+}
+
+var file_spire_api_types_spiffeid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_spire_api_types_spiffeid_proto_goTypes = []interface{}{
+	(*SPIFFEID)(nil), // 0: spire.api.types.SPIFFEID
+}
+var file_spire_api_types_spiffeid_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_spire_api_types_spiffeid_proto_init() }
+func file_spire_api_types_spiffeid_proto_init() {
+	if File_spire_api_types_spiffeid_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_spire_api_types_spiffeid_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SPIFFEID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_spire_api_types_spiffeid_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_spire_api_types_spiffeid_proto_goTypes,
+		DependencyIndexes: file_spire_api_types_spiffeid_proto_depIdxs,
+		MessageInfos:      file_spire_api_types_spiffeid_proto_msgTypes,
+	}.Build()
+	File_spire_api_types_spiffeid_proto = out.File
+	file_spire_api_types_spiffeid_proto_rawDesc = nil
+	file_spire_api_types_spiffeid_proto_goTypes = nil
+	file_spire_api_types_spiffeid_proto_depIdxs = nil
+}